@@ -1,10 +1,13 @@
 package collection
 
 const (
-	UsersCollection     = "users"
-	WorksCollection     = "works"
-	SubTasksCollection  = "subtasks"
-	LabelsCollection    = "labels"
-	GoalsCollection     = "goals"
-	GoalTasksCollection = "goal_tasks"
+	UsersCollection          = "users"
+	WorksCollection          = "works"
+	SubTasksCollection       = "subtasks"
+	LabelsCollection         = "labels"
+	GoalsCollection          = "goals"
+	GoalTasksCollection      = "goal_tasks"
+	RepeatedSeriesCollection = "repeated_series"
+	WorkEventsCollection     = "work_events"
+	OutboxEventsCollection   = "outbox_events"
 )
@@ -23,6 +23,7 @@ type Goal struct {
 	PriorityID          bson.ObjectID `bson:"priority_id" json:"priority_id"`
 	CategoryID          bson.ObjectID `bson:"category_id" json:"category_id"`
 	UserID              string        `bson:"user_id" json:"user_id"`
+	Tags                []string      `bson:"tags,omitempty" json:"tags,omitempty"`
 	CreatedAt           time.Time     `bson:"created_at" json:"created_at"`
 	LastModifiedAt      time.Time     `bson:"last_modified_at" json:"last_modified_at"`
 }
@@ -88,6 +89,11 @@ func createGoalCollection() error {
 					"bsonType":    "string",
 					"description": "Reference to user, required",
 				},
+				"tags": bson.M{
+					"bsonType":    "array",
+					"items":       bson.M{"bsonType": "string"},
+					"description": "Free-form tags, trimmed and lowercased",
+				},
 				"created_at": bson.M{
 					"bsonType":    "date",
 					"description": "Creation timestamp, required",
@@ -101,10 +107,32 @@ func createGoalCollection() error {
 	}
 
 	goalIndexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "name", Value: "text"},
+				{Key: "name_normalized", Value: "text"},
+				{Key: "short_descriptions", Value: "text"},
+			},
+			Options: options.Index().
+				SetName("idx_goal_text").
+				SetDefaultLanguage("none").
+				SetWeights(bson.D{
+					{Key: "name", Value: 10},
+					{Key: "name_normalized", Value: 10},
+					{Key: "short_descriptions", Value: 5},
+				}),
+		},
 		{
 			Keys:    bson.D{{Key: "user_id", Value: 1}},
 			Options: options.Index().SetName("idx_user"),
 		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "tags", Value: 1},
+			},
+			Options: options.Index().SetName("idx_user_tags"),
+		},
 		{
 			Keys:    bson.D{{Key: "status_id", Value: 1}},
 			Options: options.Index().SetName("idx_status"),
@@ -13,6 +13,7 @@ import (
 type GoalTask struct {
 	ID             bson.ObjectID `bson:"_id,omitempty" json:"id"`
 	Name           string        `bson:"name" json:"name"`
+	NameNormalized string        `bson:"name_normalized,omitempty" json:"name_normalized"`
 	IsCompleted    bool          `bson:"is_completed" json:"is_completed"`
 	GoalID         bson.ObjectID `bson:"goal_id" json:"goal_id"`
 	CreatedAt      time.Time     `bson:"created_at" json:"created_at"`
@@ -40,6 +41,10 @@ func createGoalTaskCollection() error {
 					"bsonType":    "string",
 					"description": "Task name, required",
 				},
+				"name_normalized": bson.M{
+					"bsonType":    "string",
+					"description": "Task name without accents, used by the search",
+				},
 				"is_completed": bson.M{
 					"bsonType":    "bool",
 					"description": "Completion status, required",
@@ -65,6 +70,15 @@ func createGoalTaskCollection() error {
 			Keys:    bson.D{{Key: "goal_id", Value: 1}},
 			Options: options.Index().SetName("idx_goal"),
 		},
+		{
+			Keys: bson.D{
+				{Key: "name", Value: "text"},
+				{Key: "name_normalized", Value: "text"},
+			},
+			Options: options.Index().
+				SetName("idx_goal_task_text").
+				SetDefaultLanguage("none"),
+		},
 	}
 
 	return connector.CreateCollection(ctx, GoalTasksCollection, goalTaskValidator, goalTaskIndexes)
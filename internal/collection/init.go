@@ -16,6 +16,9 @@ func CreateCollections() error {
 	err = append(err, createLabelCollection())
 	err = append(err, createWorkCollection())
 	err = append(err, createSubTaskCollection())
+	err = append(err, createRepeatedSeriesCollection())
+	err = append(err, createWorkEventCollection())
+	err = append(err, createOutboxEventCollection())
 
 	for _, e := range err {
 		if e != nil {
@@ -2,6 +2,7 @@ package collection
 
 import (
 	"context"
+	"errors"
 	"personal_schedule_service/global"
 	"time"
 
@@ -11,15 +12,19 @@ import (
 )
 
 type Label struct {
-	ID             bson.ObjectID `bson:"_id,omitempty" json:"id"`
-	Name           string        `bson:"name" json:"name"`
-	Meaning        *string       `bson:"meaning,omitempty" json:"meaning,omitempty"`
-	Note           *string       `bson:"note,omitempty" json:"note,omitempty"`
-	Color          *string       `bson:"color,omitempty" json:"color,omitempty"`
-	LabelType      int           `bson:"label_type" json:"label_type"` // now integer
-	CreatedAt      time.Time     `bson:"created_at" json:"created_at"`
-	LastModifiedAt time.Time     `bson:"last_modified_at" json:"last_modified_at"`
-	Key            string        `bson:"key,omitempty" json:"key"`
+	ID             bson.ObjectID  `bson:"_id,omitempty" json:"id"`
+	Name           string         `bson:"name" json:"name"`
+	Meaning        *string        `bson:"meaning,omitempty" json:"meaning,omitempty"`
+	Note           *string        `bson:"note,omitempty" json:"note,omitempty"`
+	Color          *string        `bson:"color,omitempty" json:"color,omitempty"`
+	LabelType      int            `bson:"label_type" json:"label_type"` // now integer
+	CreatedAt      time.Time      `bson:"created_at" json:"created_at"`
+	LastModifiedAt time.Time      `bson:"last_modified_at" json:"last_modified_at"`
+	Key            string         `bson:"key,omitempty" json:"key"`
+	UserID         *string        `bson:"user_id,omitempty" json:"user_id,omitempty"`
+	Order          int32          `bson:"order" json:"order"`
+	DeprecatedAt   *time.Time     `bson:"deprecated_at,omitempty" json:"deprecated_at,omitempty"`
+	ReplacedByID   *bson.ObjectID `bson:"replaced_by_id,omitempty" json:"replaced_by_id,omitempty"`
 }
 
 func (l *Label) CollectionName() string {
@@ -71,6 +76,22 @@ func createLabelCollection() error {
 					"bsonType":    []string{"string"},
 					"description": "Optional key for additional identification",
 				},
+				"user_id": bson.M{
+					"bsonType":    "string",
+					"description": "Owner of a custom label, unset on the system labels",
+				},
+				"order": bson.M{
+					"bsonType":    "int",
+					"description": "Position among the labels of the same type and owner",
+				},
+				"deprecated_at": bson.M{
+					"bsonType":    "date",
+					"description": "Set when an operator deprecates a system label, hidden from the users since",
+				},
+				"replaced_by_id": bson.M{
+					"bsonType":    "objectId",
+					"description": "System label the works and goals of a deprecated label were moved to",
+				},
 			},
 		},
 	}
@@ -85,10 +106,37 @@ func createLabelCollection() error {
 			Options: options.Index().SetName("idx_name"),
 		},
 		{
-			Keys:    bson.D{{Key: "key", Value: 1}},
-			Options: options.Index().SetName("idx_key").SetUnique(true),
+			// custom labels have no key
+			Keys: bson.D{{Key: "key", Value: 1}},
+			Options: options.Index().
+				SetName("idx_system_key").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"key": bson.M{"$exists": true}}),
 		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "label_type", Value: 1},
+				{Key: "order", Value: 1},
+			},
+			Options: options.Index().SetName("idx_user_type_order"),
+		},
+	}
+
+	// idx_key was unique on every label, it is replaced by idx_system_key
+	err := connector.GetCollection(LabelsCollection).Indexes().DropOne(ctx, "idx_key")
+	if err != nil && !isIndexNotFoundError(err) {
+		return err
 	}
 
 	return connector.CreateCollection(ctx, LabelsCollection, labelValidator, labelIndexes)
 }
+
+// isIndexNotFoundError checks if the error is the IndexNotFound (27) or NamespaceNotFound (26) of a dropped index.
+func isIndexNotFoundError(err error) bool {
+	var ce mongo.CommandError
+	if errors.As(err, &ce) {
+		return ce.Code == 27 || ce.Code == 26
+	}
+	return false
+}
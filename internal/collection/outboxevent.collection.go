@@ -0,0 +1,108 @@
+package collection
+
+import (
+	"context"
+	"personal_schedule_service/global"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// OutboxEvent mirrors common.Outbox. It is written in the same transaction as the change it announces
+// and published afterwards by the outbox relay. NextAttemptAt is when the relay may pick it up,
+// it is pushed back while an event is being published and after each failed attempt.
+type OutboxEvent struct {
+	ID            bson.ObjectID `bson:"_id,omitempty" json:"id"`
+	AggregateType string        `bson:"aggregate_type" json:"aggregate_type"`
+	AggregateID   string        `bson:"aggregate_id" json:"aggregate_id"`
+	EventType     string        `bson:"event_type" json:"event_type"`
+	Payload       []byte        `bson:"payload" json:"payload"`
+	Status        string        `bson:"status" json:"status"`
+	OccurredAt    time.Time     `bson:"occurred_at" json:"occurred_at"`
+	ProcessedAt   *time.Time    `bson:"processed_at,omitempty" json:"processed_at,omitempty"`
+	ErrorMessage  *string       `bson:"error_message,omitempty" json:"error_message,omitempty"`
+	RetryCount    int32         `bson:"retry_count" json:"retry_count"`
+	RequestID     string        `bson:"request_id" json:"request_id"`
+	NextAttemptAt time.Time     `bson:"next_attempt_at" json:"next_attempt_at"`
+}
+
+func (e *OutboxEvent) CollectionName() string {
+	return OutboxEventsCollection
+}
+
+func createOutboxEventCollection() error {
+	connector := global.MongoDbConntector
+	ctx := context.Background()
+
+	outboxValidator := bson.M{
+		"$jsonSchema": bson.M{
+			"bsonType": "object",
+			"required": []string{"aggregate_type", "aggregate_id", "event_type", "payload", "status", "occurred_at", "retry_count", "next_attempt_at"},
+			"properties": bson.M{
+				"_id": bson.M{
+					"bsonType":    "objectId",
+					"description": "Event ID, primary key",
+				},
+				"aggregate_type": bson.M{
+					"bsonType":    "string",
+					"description": "Kind of entity the event is about, required",
+				},
+				"aggregate_id": bson.M{
+					"bsonType":    "string",
+					"description": "ID of the entity the event is about, required",
+				},
+				"event_type": bson.M{
+					"bsonType":    "string",
+					"description": "Event type, decides where the event is published, required",
+				},
+				"payload": bson.M{
+					"bsonType":    "binData",
+					"description": "Serialized message body, required",
+				},
+				"status": bson.M{
+					"bsonType":    "string",
+					"description": "OutboxStatus name, FAILED means dead-lettered, required",
+				},
+				"occurred_at": bson.M{
+					"bsonType":    "date",
+					"description": "Time the change was made, required",
+				},
+				"processed_at": bson.M{
+					"bsonType":    []string{"date", "null"},
+					"description": "Time the event was published, optional",
+				},
+				"error_message": bson.M{
+					"bsonType":    []string{"string", "null"},
+					"description": "Last publish error, optional",
+				},
+				"retry_count": bson.M{
+					"bsonType":    "int",
+					"description": "Number of failed publish attempts, required",
+				},
+				"request_id": bson.M{
+					"bsonType":    "string",
+					"description": "Request which produced the event",
+				},
+				"next_attempt_at": bson.M{
+					"bsonType":    "date",
+					"description": "Earliest time of the next publish attempt, required",
+				},
+			},
+		},
+	}
+
+	outboxIndexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "next_attempt_at", Value: 1},
+				{Key: "occurred_at", Value: 1},
+			},
+			Options: options.Index().SetName("idx_status_next_attempt_at"),
+		},
+	}
+
+	return connector.CreateCollection(ctx, OutboxEventsCollection, outboxValidator, outboxIndexes)
+}
@@ -0,0 +1,137 @@
+package collection
+
+import (
+	"context"
+	"personal_schedule_service/global"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// RepeatedSeries holds the recurrence of a repeated work, its ID is the RepeatedID shared by every instance.
+// Instances are materialized from Template up to MaterializedUntil, the rest are expanded on demand.
+type RepeatedSeries struct {
+	ID                bson.ObjectID          `bson:"_id" json:"id"`
+	UserID            string                 `bson:"user_id" json:"user_id"`
+	RRule             string                 `bson:"rrule" json:"rrule"`
+	DTStart           time.Time              `bson:"dtstart" json:"dtstart"`
+	DurationMs        int64                  `bson:"duration_ms" json:"duration_ms"`
+	ExDates           []time.Time            `bson:"exdates" json:"exdates"`
+	TimeZone          string                 `bson:"time_zone" json:"time_zone"`
+	Template          RepeatedSeriesTemplate `bson:"template" json:"template"`
+	MaterializedUntil time.Time              `bson:"materialized_until" json:"materialized_until"`
+	EndsAt            *time.Time             `bson:"ends_at" json:"ends_at,omitempty"`
+	ExternalUID       *string                `bson:"external_uid,omitempty" json:"external_uid,omitempty"`
+	CreatedAt         time.Time              `bson:"created_at" json:"created_at"`
+	LastModifiedAt    time.Time              `bson:"last_modified_at" json:"last_modified_at"`
+}
+
+// RepeatedSeriesTemplate is copied into every materialized work of a series.
+type RepeatedSeriesTemplate struct {
+	Name                string         `bson:"name" json:"name"`
+	NameNormalized      string         `bson:"name_normalized" json:"name_normalized"`
+	ShortDescriptions   *string        `bson:"short_descriptions,omitempty" json:"short_descriptions,omitempty"`
+	DetailedDescription *string        `bson:"detailed_description,omitempty" json:"detailed_description,omitempty"`
+	StatusID            bson.ObjectID  `bson:"status_id" json:"status_id"`
+	DifficultyID        bson.ObjectID  `bson:"difficulty_id" json:"difficulty_id"`
+	PriorityID          bson.ObjectID  `bson:"priority_id" json:"priority_id"`
+	TypeID              bson.ObjectID  `bson:"type_id" json:"type_id"`
+	CategoryID          bson.ObjectID  `bson:"category_id" json:"category_id"`
+	DraftID             *bson.ObjectID `bson:"draft_id,omitempty" json:"draft_id,omitempty"`
+	GoalID              *bson.ObjectID `bson:"goal_id" json:"goal_id"`
+	SubTasks            []string       `bson:"sub_tasks" json:"sub_tasks"`
+	Tags                []string       `bson:"tags,omitempty" json:"tags,omitempty"`
+}
+
+func (s *RepeatedSeries) CollectionName() string {
+	return RepeatedSeriesCollection
+}
+
+func createRepeatedSeriesCollection() error {
+	connector := global.MongoDbConntector
+	ctx := context.Background()
+
+	seriesValidator := bson.M{
+		"$jsonSchema": bson.M{
+			"bsonType": "object",
+			"required": []string{"user_id", "rrule", "dtstart", "duration_ms", "time_zone", "template", "materialized_until", "created_at", "last_modified_at"},
+			"properties": bson.M{
+				"_id": bson.M{
+					"bsonType":    "objectId",
+					"description": "Series ID, same as repeated_id of its works",
+				},
+				"user_id": bson.M{
+					"bsonType":    "string",
+					"description": "Owner of the series, required",
+				},
+				"rrule": bson.M{
+					"bsonType":    "string",
+					"description": "RFC 5545 RRULE value, required",
+				},
+				"dtstart": bson.M{
+					"bsonType":    "date",
+					"description": "Start of the first occurrence, required",
+				},
+				"duration_ms": bson.M{
+					"bsonType":    "long",
+					"description": "Duration of each occurrence in milliseconds, required",
+				},
+				"exdates": bson.M{
+					"bsonType":    []string{"array", "null"},
+					"items":       bson.M{"bsonType": "date"},
+					"description": "Excluded occurrence starts",
+				},
+				"time_zone": bson.M{
+					"bsonType":    "string",
+					"description": "IANA time zone the rule is expanded in, required",
+				},
+				"template": bson.M{
+					"bsonType":    "object",
+					"required":    []string{"name", "status_id", "difficulty_id", "priority_id", "type_id", "category_id"},
+					"description": "Fields copied into each materialized work, required",
+				},
+				"materialized_until": bson.M{
+					"bsonType":    "date",
+					"description": "Works are materialized for occurrences before this time, required",
+				},
+				"ends_at": bson.M{
+					"bsonType":    []string{"date", "null"},
+					"description": "Start of the last occurrence, null for a series without end",
+				},
+				"external_uid": bson.M{
+					"bsonType":    []string{"string", "null"},
+					"description": "UID of the iCalendar event the series was imported from",
+				},
+				"created_at": bson.M{
+					"bsonType":    "date",
+					"description": "Creation timestamp, required",
+				},
+				"last_modified_at": bson.M{
+					"bsonType":    "date",
+					"description": "Last modification timestamp, required",
+				},
+			},
+		},
+	}
+
+	seriesIndexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("idx_user"),
+		},
+		{
+			Keys:    bson.D{{Key: "materialized_until", Value: 1}},
+			Options: options.Index().SetName("idx_materialized_until"),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "external_uid", Value: 1}},
+			Options: options.Index().
+				SetName("idx_user_external_uid").
+				SetPartialFilterExpression(bson.M{"external_uid": bson.M{"$exists": true}}),
+		},
+	}
+
+	return connector.CreateCollection(ctx, RepeatedSeriesCollection, seriesValidator, seriesIndexes)
+}
@@ -13,6 +13,7 @@ import (
 type SubTask struct {
 	ID             bson.ObjectID `bson:"_id,omitempty" json:"id"`
 	Name           string        `bson:"name" json:"name"`
+	NameNormalized string        `bson:"name_normalized,omitempty" json:"name_normalized"`
 	IsCompleted    bool          `bson:"is_completed" json:"is_completed"`
 	WorkID         bson.ObjectID `bson:"work_id" json:"work_id"`
 	CreatedAt      time.Time     `bson:"created_at" json:"created_at"`
@@ -40,6 +41,10 @@ func createSubTaskCollection() error {
 					"bsonType":    "string",
 					"description": "SubTask name, required",
 				},
+				"name_normalized": bson.M{
+					"bsonType":    "string",
+					"description": "SubTask name without accents, used by the search",
+				},
 				"is_completed": bson.M{
 					"bsonType":    "bool",
 					"description": "Completion status, required",
@@ -65,6 +70,15 @@ func createSubTaskCollection() error {
 			Keys:    bson.D{{Key: "work_id", Value: 1}},
 			Options: options.Index().SetName("idx_work"),
 		},
+		{
+			Keys: bson.D{
+				{Key: "name", Value: "text"},
+				{Key: "name_normalized", Value: "text"},
+			},
+			Options: options.Index().
+				SetName("idx_subtask_text").
+				SetDefaultLanguage("none"),
+		},
 	}
 
 	return connector.CreateCollection(ctx, SubTasksCollection, subTaskValidator, subTaskIndexes)
@@ -6,12 +6,45 @@ import (
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 type User struct {
-	ID             string    `bson:"_id" json:"id"`
-	CreatedAt      time.Time `bson:"created_at" json:"created_at"`
-	LastModifiedAt time.Time `bson:"last_modified_at" json:"last_modified_at"`
+	ID                     string             `bson:"_id" json:"id"`
+	Email                  string             `bson:"email,omitempty" json:"email,omitempty"`
+	TimeZone               string             `bson:"time_zone,omitempty" json:"time_zone,omitempty"`
+	Locale                 string             `bson:"locale,omitempty" json:"locale,omitempty"`
+	WeekStart              *int32             `bson:"week_start,omitempty" json:"week_start,omitempty"`
+	WorkingHours           []UserWorkingHours `bson:"working_hours,omitempty" json:"working_hours,omitempty"`
+	DefaultLabels          *UserDefaultLabels `bson:"default_labels,omitempty" json:"default_labels,omitempty"`
+	ReminderOffsetsMinutes []int32            `bson:"reminder_offsets_minutes,omitempty" json:"reminder_offsets_minutes,omitempty"`
+	DailyCapacityHours     float64            `bson:"daily_capacity_hours,omitempty" json:"daily_capacity_hours,omitempty"`
+	FeedToken              *UserFeedToken     `bson:"feed_token,omitempty" json:"-"`
+	CreatedAt              time.Time          `bson:"created_at" json:"created_at"`
+	LastModifiedAt         time.Time          `bson:"last_modified_at" json:"last_modified_at"`
+}
+
+// UserWorkingHours is a local working window of a weekday, in minutes since midnight.
+type UserWorkingHours struct {
+	Weekday     int32 `bson:"weekday" json:"weekday"`
+	StartMinute int32 `bson:"start_minute" json:"start_minute"`
+	EndMinute   int32 `bson:"end_minute" json:"end_minute"`
+}
+
+// UserDefaultLabels are the labels a new work of the user starts with.
+type UserDefaultLabels struct {
+	TypeID       *bson.ObjectID `bson:"type_id,omitempty" json:"type_id,omitempty"`
+	StatusID     *bson.ObjectID `bson:"status_id,omitempty" json:"status_id,omitempty"`
+	DifficultyID *bson.ObjectID `bson:"difficulty_id,omitempty" json:"difficulty_id,omitempty"`
+	PriorityID   *bson.ObjectID `bson:"priority_id,omitempty" json:"priority_id,omitempty"`
+	CategoryID   *bson.ObjectID `bson:"category_id,omitempty" json:"category_id,omitempty"`
+}
+
+// UserFeedToken authorizes the calendar subscription feed of the user, only the SHA-256 of the token is stored.
+type UserFeedToken struct {
+	TokenHash string    `bson:"token_hash" json:"token_hash"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
 }
 
 func (u *User) CollectionName() string {
@@ -31,6 +64,59 @@ func createUserCollection() error {
 					"bsonType":    "string",
 					"description": "Mã định danh người dùng, bắt buộc và duy nhất",
 				},
+				"email": bson.M{
+					"bsonType":    "string",
+					"description": "Email của người dùng, đồng bộ từ dịch vụ xác thực",
+				},
+				"time_zone": bson.M{
+					"bsonType":    "string",
+					"description": "Múi giờ IANA của người dùng, không bắt buộc",
+				},
+				"locale": bson.M{
+					"bsonType":    "string",
+					"description": "Ngôn ngữ của người dùng, không bắt buộc",
+				},
+				"week_start": bson.M{
+					"bsonType":    []string{"int", "null"},
+					"minimum":     0,
+					"maximum":     6,
+					"description": "Ngày bắt đầu tuần, 0 là chủ nhật",
+				},
+				"working_hours": bson.M{
+					"bsonType":    []string{"array", "null"},
+					"description": "Giờ làm việc theo từng ngày trong tuần",
+					"items": bson.M{
+						"bsonType": "object",
+						"required": []string{"weekday", "start_minute", "end_minute"},
+						"properties": bson.M{
+							"weekday":      bson.M{"bsonType": "int"},
+							"start_minute": bson.M{"bsonType": "int"},
+							"end_minute":   bson.M{"bsonType": "int"},
+						},
+					},
+				},
+				"default_labels": bson.M{
+					"bsonType":    []string{"object", "null"},
+					"description": "Nhãn mặc định cho công việc mới",
+				},
+				"reminder_offsets_minutes": bson.M{
+					"bsonType":    []string{"array", "null"},
+					"description": "Số phút nhắc trước khi công việc bắt đầu",
+					"items":       bson.M{"bsonType": "int"},
+				},
+				"daily_capacity_hours": bson.M{
+					"bsonType":    []string{"double", "int"},
+					"description": "Số giờ làm việc tối đa mỗi ngày, 0 là không giới hạn",
+				},
+				"feed_token": bson.M{
+					"bsonType":    []string{"object", "null"},
+					"required":    []string{"token_hash", "created_at"},
+					"description": "Mã truy cập lịch đăng ký của người dùng, chỉ lưu giá trị băm",
+					"properties": bson.M{
+						"token_hash": bson.M{"bsonType": "string"},
+						"created_at": bson.M{"bsonType": "date"},
+					},
+				},
 				"created_at": bson.M{
 					"bsonType":    "date",
 					"description": "Thời điểm tạo bản ghi, bắt buộc",
@@ -43,5 +129,15 @@ func createUserCollection() error {
 		},
 	}
 
-	return connector.CreateCollection(ctx, UsersCollection, userValidator, nil)
+	userIndexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "feed_token.token_hash", Value: 1}},
+			Options: options.Index().
+				SetName("idx_feed_token_hash").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"feed_token.token_hash": bson.M{"$exists": true}}),
+		},
+	}
+
+	return connector.CreateCollection(ctx, UsersCollection, userValidator, userIndexes)
 }
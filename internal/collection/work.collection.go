@@ -27,6 +27,10 @@ type Work struct {
 	UserID              string         `bson:"user_id" json:"user_id"`
 	GoalID              *bson.ObjectID `bson:"goal_id" json:"goal_id"`
 	RepeatedID          *bson.ObjectID `bson:"repeated_id,omitempty" json:"repeated_id,omitempty"`
+	RecurrenceID        *time.Time     `bson:"recurrence_id,omitempty" json:"recurrence_id,omitempty"`
+	ScheduledFromID     *bson.ObjectID `bson:"scheduled_from_id,omitempty" json:"scheduled_from_id,omitempty"`
+	ExternalUID         *string        `bson:"external_uid,omitempty" json:"external_uid,omitempty"`
+	Tags                []string       `bson:"tags,omitempty" json:"tags,omitempty"`
 	CreatedAt           time.Time      `bson:"created_at" json:"created_at"`
 	LastModifiedAt      time.Time      `bson:"last_modified_at" json:"last_modified_at"`
 }
@@ -72,15 +76,25 @@ func createWorkCollection() error {
 					"bsonType":    "date",
 					"description": "End date, required",
 				},
-				"status_id":        bson.M{"bsonType": "objectId"},
-				"difficulty_id":    bson.M{"bsonType": "objectId"},
-				"priority_id":      bson.M{"bsonType": "objectId"},
-				"type_id":          bson.M{"bsonType": "objectId"},
-				"category_id":      bson.M{"bsonType": "objectId"},
-				"draft_id":         bson.M{"bsonType": []string{"objectId", "null"}},
-				"user_id":          bson.M{"bsonType": "string"},
-				"goal_id":          bson.M{"bsonType": []string{"objectId", "null"}},
-				"repeated_id":      bson.M{"bsonType": []string{"objectId", "null"}},
+				"status_id":     bson.M{"bsonType": "objectId"},
+				"difficulty_id": bson.M{"bsonType": "objectId"},
+				"priority_id":   bson.M{"bsonType": "objectId"},
+				"type_id":       bson.M{"bsonType": "objectId"},
+				"category_id":   bson.M{"bsonType": "objectId"},
+				"draft_id":      bson.M{"bsonType": []string{"objectId", "null"}},
+				"user_id":       bson.M{"bsonType": "string"},
+				"goal_id":       bson.M{"bsonType": []string{"objectId", "null"}},
+				"repeated_id":   bson.M{"bsonType": []string{"objectId", "null"}},
+				"recurrence_id": bson.M{"bsonType": []string{"date", "null"}},
+				// draft placement of an existing work, set by the auto scheduler
+				"scheduled_from_id": bson.M{"bsonType": []string{"objectId", "null"}},
+				// UID of the iCalendar event the work was imported from
+				"external_uid": bson.M{"bsonType": []string{"string", "null"}},
+				// free-form tags of the user, trimmed and lowercased
+				"tags": bson.M{
+					"bsonType": "array",
+					"items":    bson.M{"bsonType": "string"},
+				},
 				"created_at":       bson.M{"bsonType": "date"},
 				"last_modified_at": bson.M{"bsonType": "date"},
 			},
@@ -111,6 +125,13 @@ func createWorkCollection() error {
 			},
 			Options: options.Index().SetName("idx_user_end_date"),
 		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "tags", Value: 1},
+			},
+			Options: options.Index().SetName("idx_user_tags"),
+		},
 		// single field indexes
 		{Keys: bson.D{{Key: "status_id", Value: 1}}, Options: options.Index().SetName("idx_status")},
 		{Keys: bson.D{{Key: "difficulty_id", Value: 1}}, Options: options.Index().SetName("idx_difficulty")},
@@ -120,6 +141,26 @@ func createWorkCollection() error {
 		{Keys: bson.D{{Key: "draft_id", Value: 1}}, Options: options.Index().SetName("idx_draft")},
 		{Keys: bson.D{{Key: "goal_id", Value: 1}}, Options: options.Index().SetName("idx_goal")},
 		{Keys: bson.D{{Key: "repeated_id", Value: 1}}, Options: options.Index().SetName("idx_repeated")},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "external_uid", Value: 1},
+			},
+			Options: options.Index().
+				SetName("idx_user_external_uid").
+				SetPartialFilterExpression(bson.M{"external_uid": bson.M{"$exists": true}}),
+		},
+		// one work per occurrence of a series, keeps the materialization idempotent
+		{
+			Keys: bson.D{
+				{Key: "repeated_id", Value: 1},
+				{Key: "recurrence_id", Value: 1},
+			},
+			Options: options.Index().
+				SetName("uniq_repeated_recurrence").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"recurrence_id": bson.M{"$exists": true}}),
+		},
 	}
 
 	return connector.CreateCollection(ctx, WorksCollection, workValidator, workIndexes)
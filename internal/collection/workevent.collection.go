@@ -0,0 +1,130 @@
+package collection
+
+import (
+	"context"
+	"personal_schedule_service/global"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// WorkEvent is an append-only record of a change on a work or a goal, eg. a status transition.
+// Exactly one of WorkID and GoalID is set.
+type WorkEvent struct {
+	ID        bson.ObjectID  `bson:"_id,omitempty" json:"id"`
+	WorkID    *bson.ObjectID `bson:"work_id,omitempty" json:"work_id,omitempty"`
+	GoalID    *bson.ObjectID `bson:"goal_id,omitempty" json:"goal_id,omitempty"`
+	UserID    string         `bson:"user_id" json:"user_id"`
+	EventType string         `bson:"event_type" json:"event_type"`
+	Field     string         `bson:"field" json:"field"`
+	FromID    *bson.ObjectID `bson:"from_id,omitempty" json:"from_id,omitempty"`
+	ToID      *bson.ObjectID `bson:"to_id,omitempty" json:"to_id,omitempty"`
+	ActorID   string         `bson:"actor_id" json:"actor_id"`
+	Reason    *string        `bson:"reason,omitempty" json:"reason,omitempty"`
+	CreatedAt time.Time      `bson:"created_at" json:"created_at"`
+}
+
+func (e *WorkEvent) CollectionName() string {
+	return WorkEventsCollection
+}
+
+func createWorkEventCollection() error {
+	connector := global.MongoDbConntector
+	ctx := context.Background()
+
+	workEventValidator := bson.M{
+		"$jsonSchema": bson.M{
+			"bsonType": "object",
+			"required": []string{"user_id", "event_type", "field", "actor_id", "created_at"},
+			"oneOf": bson.A{
+				bson.M{"required": bson.A{"work_id"}},
+				bson.M{"required": bson.A{"goal_id"}},
+			},
+			"properties": bson.M{
+				"_id": bson.M{
+					"bsonType":    "objectId",
+					"description": "Event ID, primary key",
+				},
+				"work_id": bson.M{
+					"bsonType":    "objectId",
+					"description": "Reference to the changed work, set for work events",
+				},
+				"goal_id": bson.M{
+					"bsonType":    "objectId",
+					"description": "Reference to the changed goal, set for goal events",
+				},
+				"user_id": bson.M{
+					"bsonType":    "string",
+					"description": "Owner of the work, required",
+				},
+				"event_type": bson.M{
+					"bsonType":    "string",
+					"description": "Kind of change, required",
+				},
+				"field": bson.M{
+					"bsonType":    "string",
+					"description": "Changed field, required",
+				},
+				"from_id": bson.M{
+					"bsonType":    []string{"objectId", "null"},
+					"description": "Previous value, optional",
+				},
+				"to_id": bson.M{
+					"bsonType":    []string{"objectId", "null"},
+					"description": "New value, optional",
+				},
+				"actor_id": bson.M{
+					"bsonType":    "string",
+					"description": "User who made the change or system, required",
+				},
+				"reason": bson.M{
+					"bsonType":    []string{"string", "null"},
+					"description": "Why the change was made, optional",
+				},
+				"created_at": bson.M{
+					"bsonType":    "date",
+					"description": "Time of the change, required",
+				},
+			},
+		},
+	}
+
+	workEventIndexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "work_id", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+			Options: options.Index().SetName("idx_work_created_at"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "goal_id", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+			Options: options.Index().SetName("idx_goal_created_at").
+				SetPartialFilterExpression(bson.M{"goal_id": bson.M{"$exists": true}}),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+			Options: options.Index().SetName("idx_user_created_at"),
+		},
+		{
+			// completion times for analytics: the latest event moving a work to a given status
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "field", Value: 1},
+				{Key: "to_id", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+			Options: options.Index().SetName("idx_user_field_to_created_at"),
+		},
+	}
+
+	return connector.CreateCollection(ctx, WorkEventsCollection, workEventValidator, workEventIndexes)
+}
@@ -0,0 +1,11 @@
+package analytics_constant
+
+// Bucket units, as understood by $dateTrunc
+const (
+	BUCKET_UNIT_DAY   = "day"
+	BUCKET_UNIT_WEEK  = "week"
+	BUCKET_UNIT_MONTH = "month"
+)
+
+// MAX_BUCKETS bounds the range of a stats request, eg. a bit more than a year of daily buckets
+const MAX_BUCKETS = 400
@@ -0,0 +1,74 @@
+package calendar_constant
+
+import (
+	labels_constant "personal_schedule_service/internal/constant/labels"
+	"time"
+)
+
+// PRODID identifies the exported calendars
+const PRODID = "-//Personal Schedule//Personal Schedule Service//EN"
+
+// UID_DOMAIN is appended to the ids of works and series to build globally unique UIDs
+const UID_DOMAIN = "personal-schedule"
+
+const EXPORT_FILE_NAME = "personal-schedule.ics"
+
+// MAX_EXPORT_RANGE bounds the range of an export
+const MAX_EXPORT_RANGE = 366 * 24 * time.Hour
+
+// PRIORITIES maps the Eisenhower labels to the RFC 5545 PRIORITY, 1-4 high, 5 medium, 6-9 low
+var PRIORITIES = map[string]int{
+	labels_constant.LabelPriorityImportantUrgent:       1,
+	labels_constant.LabelPriorityImportantNotUrgent:    3,
+	labels_constant.LabelPriorityNotImportantUrgent:    5,
+	labels_constant.LabelPriorityNotImportantNotUrgent: 9,
+}
+
+// Status of the exported VEVENTs and VTODOs
+const (
+	EVENT_STATUS_CONFIRMED = "CONFIRMED"
+	EVENT_STATUS_CANCELLED = "CANCELLED"
+
+	TODO_STATUS_NEEDS_ACTION = "NEEDS-ACTION"
+	TODO_STATUS_IN_PROCESS   = "IN-PROCESS"
+	TODO_STATUS_COMPLETED    = "COMPLETED"
+	TODO_STATUS_CANCELLED    = "CANCELLED"
+)
+
+// MAX_IMPORT_EVENTS bounds the VEVENTs of an imported calendar
+const MAX_IMPORT_EVENTS = 500
+
+// MAX_IMPORT_SIZE bounds the imported calendar text, in bytes
+const MAX_IMPORT_SIZE = 2 << 20
+
+// Reasons of the imported events which are not created
+const (
+	IMPORT_REASON_MISSING_UID        = "event has no UID"
+	IMPORT_REASON_MISSING_START      = "event has no DTSTART"
+	IMPORT_REASON_INVALID_END        = "event must end after it starts"
+	IMPORT_REASON_EMPTY_SUMMARY      = "event summary cannot be empty or only contain special characters"
+	IMPORT_REASON_CANCELLED          = "event is cancelled"
+	IMPORT_REASON_UNKNOWN_MASTER     = "modified occurrence of an event which is not in the calendar"
+	IMPORT_REASON_DUPLICATE_EVENT    = "event appears more than once in the calendar"
+	IMPORT_REASON_PAST_OCCURRENCE    = "occurrence before today is not materialized"
+	IMPORT_REASON_NO_OCCURRENCE      = "recurrence rule does not produce any occurrence"
+	IMPORT_REASON_OVERLAPS_WORKS     = "event overlaps existing works"
+	IMPORT_REASON_SERIES_NOT_CREATED = "recurring event was not created"
+)
+
+// FEED_PATH is the HTTP path of the subscription feeds, followed by the token and FEED_EXTENSION
+const (
+	FEED_PATH      = "/calendar/"
+	FEED_EXTENSION = ".ics"
+)
+
+// FEED_TOKEN_BYTES is the entropy of a feed token, it is sent base64url encoded
+const FEED_TOKEN_BYTES = 32
+
+// Default range of a feed around the current day, when the config does not set one
+const (
+	DEFAULT_FEED_PAST_DAYS   = 30
+	DEFAULT_FEED_FUTURE_DAYS = 180
+)
+
+const FEED_CONTENT_TYPE = "text/calendar; charset=utf-8"
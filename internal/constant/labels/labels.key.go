@@ -38,3 +38,11 @@ const (
 	// Draft
 	LabelDraft = "DRAFT"
 )
+
+// PriorityOrder ranks the priorities from the most important
+var PriorityOrder = []string{
+	LabelPriorityImportantUrgent,
+	LabelPriorityImportantNotUrgent,
+	LabelPriorityNotImportantUrgent,
+	LabelPriorityNotImportantNotUrgent,
+}
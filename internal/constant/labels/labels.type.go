@@ -9,3 +9,32 @@ const (
 	LabelTypeCategory   = 5
 	LabelTypeDraft      = 6
 )
+
+// CustomLabelTypes are the types users can add their own labels to, the others drive the scheduling
+var CustomLabelTypes = []int{
+	LabelTypeStatus,
+	LabelTypeDifficulty,
+	LabelTypePriority,
+	LabelTypeCategory,
+}
+
+// LabelTypeFields is the field of the works and goals referencing a label of the type, goals have no work type
+var LabelTypeFields = map[int]string{
+	LabelTypeWorkType:   "type_id",
+	LabelTypeStatus:     "status_id",
+	LabelTypeDifficulty: "difficulty_id",
+	LabelTypePriority:   "priority_id",
+	LabelTypeCategory:   "category_id",
+}
+
+// MaxCustomLabelsPerType bounds the custom labels of a user for one type
+const MaxCustomLabelsPerType = 50
+
+// Custom labels without a color use DefaultCustomLabelColor, names are at most MaxLabelNameLength runes
+const (
+	DefaultCustomLabelColor = "#9E9E9E"
+	MaxLabelNameLength      = 50
+)
+
+// LabelReassignBatchSize is the number of documents moved per update when a label is deprecated or deleted
+const LabelReassignBatchSize = 500
@@ -0,0 +1,48 @@
+package lifecycle_constant
+
+import (
+	notifications_constant "personal_schedule_service/internal/constant/notifications"
+
+	"github.com/thanvuc/go-core-lib/eventbus"
+)
+
+// service
+const (
+	PERSONAL_SCHEDULE_SERVICE = "personal_schedule"
+)
+
+// base
+const (
+	LIFECYCLE_EVENT = "_lifecycle_event"
+)
+
+// exchanges full names, consumers bind with routing keys like "work.*" or "#"
+const (
+	LIFECYCLE_EXCHANGE eventbus.ExchangeName = PERSONAL_SCHEDULE_SERVICE + LIFECYCLE_EVENT + notifications_constant.EXCHANGE
+)
+
+// SCHEMA_VERSION is the version of the common.ScheduleEvent payloads published by this service.
+const SCHEMA_VERSION int32 = 1
+
+// event types, also used as routing keys
+const (
+	WORK_CREATED   = "work.created"
+	WORK_UPDATED   = "work.updated"
+	WORK_COMPLETED = "work.completed"
+	WORK_DELETED   = "work.deleted"
+
+	GOAL_CREATED   = "goal.created"
+	GOAL_UPDATED   = "goal.updated"
+	GOAL_COMPLETED = "goal.completed"
+	GOAL_DELETED   = "goal.deleted"
+
+	SERIES_CREATED = "series.created"
+	SERIES_UPDATED = "series.updated"
+	SERIES_SPLIT   = "series.split"
+)
+
+var EVENT_TYPES = []string{
+	WORK_CREATED, WORK_UPDATED, WORK_COMPLETED, WORK_DELETED,
+	GOAL_CREATED, GOAL_UPDATED, GOAL_COMPLETED, GOAL_DELETED,
+	SERIES_CREATED, SERIES_UPDATED, SERIES_SPLIT,
+}
@@ -1,8 +1,33 @@
 package outbox_constant
 
+import "time"
+
 const (
 	EventTypeCreate = "create"
 	EventTypeUpdate = "update"
 	EventTypeDelete = "delete"
 	EventTypeUpsert = "upsert"
 )
+
+// aggregate types of the outbox events
+const (
+	AggregateTypeWork   = "work"
+	AggregateTypeGoal   = "goal"
+	AggregateTypeSeries = "series"
+	AggregateTypeUser   = "user"
+)
+
+// event types relayed from the outbox
+const (
+	EventTypeScheduledNotification = "notification.scheduled"
+)
+
+// relay
+const (
+	RelayBatchSize     = 100
+	RelayPollInterval  = 2 * time.Second
+	RelayClaimLease    = 30 * time.Second
+	DefaultMaxRetries  = 10
+	DefaultBaseBackoff = 5 * time.Second
+	DefaultMaxBackoff  = 30 * time.Minute
+)
@@ -0,0 +1,22 @@
+package search_constant
+
+// Hits returned per entity type
+const (
+	DEFAULT_SEARCH_LIMIT = 10
+	MAX_SEARCH_LIMIT     = 50
+)
+
+// Highlighted fields, named as in the collections
+const (
+	HIGHLIGHT_FIELD_NAME               = "name"
+	HIGHLIGHT_FIELD_SHORT_DESCRIPTIONS = "short_descriptions"
+)
+
+// A longer highlight is cut to SNIPPET_RUNES, starting SNIPPET_LEAD_RUNES before its first match
+const (
+	SNIPPET_RUNES      = 160
+	SNIPPET_LEAD_RUNES = 40
+)
+
+// BACKFILL_BATCH_SIZE is the number of documents normalized per bulk write
+const BACKFILL_BATCH_SIZE = 500
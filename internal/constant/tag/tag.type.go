@@ -0,0 +1,7 @@
+package tag_constant
+
+// Limits of the free-form tags of a work or goal
+const (
+	MAX_TAGS_PER_ITEM = 20
+	MAX_TAG_LENGTH    = 50
+)
@@ -0,0 +1,25 @@
+package user_constant
+
+import "time"
+
+// Preference defaults, used while the user has not chosen otherwise
+const (
+	DEFAULT_LOCALE     = "vi"
+	DEFAULT_WEEK_START = time.Monday
+)
+
+// SUPPORTED_LOCALES are the locales the service has messages for
+var SUPPORTED_LOCALES = []string{"vi", "en"}
+
+// Preference limits
+const (
+	MAX_REMINDER_OFFSETS        = 10
+	MAX_REMINDER_OFFSET_MINUTES = 7 * 24 * 60
+	MAX_DAILY_CAPACITY_HOURS    = 24
+)
+
+// Locale cache of the requests, a preference change shows on the other replicas within the TTL
+const (
+	LOCALE_CACHE_TTL  = time.Minute
+	LOCALE_CACHE_SIZE = 10000
+)
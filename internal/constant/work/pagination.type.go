@@ -0,0 +1,4 @@
+package workgeneration_constant
+
+// DEFAULT_WORKS_PAGE_SIZE is the page size of a GetWorks cursor without a page size
+const DEFAULT_WORKS_PAGE_SIZE = 10
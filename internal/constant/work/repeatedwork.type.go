@@ -0,0 +1,5 @@
+package workgeneration_constant
+
+// REPEATED_WORK_HORIZON_DAYS is how many days ahead the works of a repeated series are materialized,
+// later occurrences are created by the daily cronjob or when they are queried.
+const REPEATED_WORK_HORIZON_DAYS = 30
@@ -0,0 +1,36 @@
+package workgeneration_constant
+
+import "time"
+
+// Conflict check
+const (
+	// free slots are suggested at most this far from the conflicting interval
+	CONFLICT_SUGGESTION_WINDOW    = 7 * 24 * time.Hour
+	DEFAULT_CONFLICT_SUGGESTIONS  = 3
+	MAX_CONFLICT_SUGGESTIONS      = 10
+	MAX_CONFLICT_CHECK_CANDIDATES = 100
+)
+
+// Free time search
+const (
+	MAX_FREE_TIME_RANGE     = 62 * 24 * time.Hour
+	DEFAULT_FREE_TIME_LIMIT = 20
+	MAX_FREE_TIME_LIMIT     = 100
+	MINUTES_PER_DAY         = 24 * 60
+)
+
+// Auto scheduling
+const (
+	MAX_AUTO_SCHEDULE_WORKS = 200
+	// hard works are placed before noon and easy ones after it when possible
+	AUTO_SCHEDULE_MORNING_END_HOUR     = 12
+	AUTO_SCHEDULE_REASON_PAST_DEADLINE = "deadline is before the scheduling range"
+	AUTO_SCHEDULE_REASON_NO_FREE_SLOT  = "no free slot before the deadline"
+	AUTO_SCHEDULE_REASON_NOT_FOUND     = "work not found"
+	AUTO_SCHEDULE_REASON_NOT_ALLOWED   = "completed, given up or draft works are not scheduled"
+)
+
+// Bulk operations
+const (
+	MAX_BULK_WORKS = 200
+)
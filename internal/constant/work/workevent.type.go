@@ -0,0 +1,23 @@
+package workgeneration_constant
+
+// Work event types
+const (
+	WORK_EVENT_LABEL_CHANGED = "label_changed"
+)
+
+// Work event actors, user changes are recorded with the user id
+const (
+	WORK_EVENT_ACTOR_SYSTEM = "system"
+)
+
+// Work event reasons
+const (
+	WORK_EVENT_REASON_OVERDUE      = "overdue"
+	WORK_EVENT_REASON_GRACE_EXPIRE = "grace_period_expired"
+)
+
+// Status transition job
+const (
+	DEFAULT_GIVE_UP_GRACE_HOURS  = 72
+	STATUS_TRANSITION_BATCH_SIZE = 500
+)
@@ -8,6 +8,7 @@ const (
 const (
 	CREATE_DAILY_WORK_CRONJOB = SERIVCE + "_create_daily_work_cronjob"
 	DELETE_DRAFT_WORK_CRONJOB = SERIVCE + "_delete_draft_work_cronjob"
+	WORK_STATUS_CRONJOB       = SERIVCE + "_work_status_cronjob"
 )
 
 // Location constants
@@ -30,17 +30,22 @@ func NewWorkCronJob(
 }
 
 func (c *WorkCronJob) CreateDailyWorkCronJob(ctx context.Context) {
-	// Define the cron schedule (every day at midnight)
+	// Define the cron schedule (every hour)
 	jobScheduler := cronjob.NewCronScheduler(global.RedisDb, cronjob_constant.CREATE_DAILY_WORK_CRONJOB, cron.WithLocation(time.UTC))
 
 	c.cronJobManager.AddScheduler(jobScheduler)
 
 	// add schedule string for scheduling cronjob. eg. "0 0 * * *": every day at midnight
-	// Use OTC, so minus 7 hours from UTC (get Vietnam time)
-	err := jobScheduler.ScheduleCronJob("0 17 * * *", func() {
-		// Logic to create daily work entries
-		// call to work service to create daily work
+	// Hourly, so every series reaches the next local day soon after its own midnight
+	err := jobScheduler.ScheduleCronJob("0 * * * *", func() {
+		// Materialize the works of repeated series up to the rolling horizon,
+		// the scheduler lock keeps a single replica running it
 		c.logger.Info("Executing CreateDailyWorkCronJob", "")
+
+		err := c.workService.MaterializeRepeatedWorks(context.Background())
+		if err != nil {
+			c.logger.Error("MaterializeRepeatedWorks failed", "", zap.Error(err))
+		}
 	})
 	if err != nil {
 		c.logger.Error("Failed to handle CreateDailyWorkCronJob", "", zap.Error(err))
@@ -49,12 +54,13 @@ func (c *WorkCronJob) CreateDailyWorkCronJob(ctx context.Context) {
 }
 
 func (c *WorkCronJob) DeleteDraftWorkCronJob(ctx context.Context) {
-	// Define the cron schedule (every day at midnight)
+	// Define the cron schedule (every hour)
 	jobschedule := cronjob.NewCronScheduler(global.RedisDb, cronjob_constant.DELETE_DRAFT_WORK_CRONJOB, cron.WithLocation(time.UTC))
 
 	c.cronJobManager.AddScheduler(jobschedule)
 
-	err := jobschedule.ScheduleCronJob("0 17 * * *", func() {
+	// Hourly, the drafts of every user expire at the user's local midnight
+	err := jobschedule.ScheduleCronJob("0 * * * *", func() {
 		// Logic to delete expired draft work entries
 		c.logger.Info("Executing DeleteDraftWorkCronJob", "")
 
@@ -70,3 +76,26 @@ func (c *WorkCronJob) DeleteDraftWorkCronJob(ctx context.Context) {
 
 	jobschedule.Start()
 }
+
+func (c *WorkCronJob) WorkStatusCronJob(ctx context.Context) {
+	// Define the cron schedule (every 10 minutes)
+	jobScheduler := cronjob.NewCronScheduler(global.RedisDb, cronjob_constant.WORK_STATUS_CRONJOB, cron.WithLocation(time.UTC))
+
+	c.cronJobManager.AddScheduler(jobScheduler)
+
+	err := jobScheduler.ScheduleCronJob("*/10 * * * *", func() {
+		// Move ended works to OVER_DUE, and to GIVE_UP after the grace period
+		c.logger.Info("Executing WorkStatusCronJob", "")
+
+		err := c.workService.TransitionOverdueWorks(context.Background())
+		if err != nil {
+			c.logger.Error("TransitionOverdueWorks failed", "", zap.Error(err))
+		}
+	})
+
+	if err != nil {
+		c.logger.Error("Failed to handle WorkStatusCronJob", "", zap.Error(err))
+	}
+
+	jobScheduler.Start()
+}
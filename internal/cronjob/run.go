@@ -10,5 +10,6 @@ func RunCronJob(ctx context.Context) {
 	workCronJob := wire.InjectWorkCronJob()
 	workCronJob.CreateDailyWorkCronJob(ctx)
 	workCronJob.DeleteDraftWorkCronJob(ctx)
+	workCronJob.WorkStatusCronJob(ctx)
 	global.Logger.Info("Cron jobs started", "")
 }
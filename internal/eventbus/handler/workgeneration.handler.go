@@ -10,6 +10,7 @@ import (
 	event_models "personal_schedule_service/internal/eventbus/models"
 	"personal_schedule_service/internal/grpc/utils"
 	"personal_schedule_service/internal/grpc/validation"
+	"personal_schedule_service/internal/i18n"
 	"personal_schedule_service/internal/repos"
 	"strings"
 	"time"
@@ -28,6 +29,7 @@ type WorkGenerationHandler struct {
 	logger            log.Logger
 	workRepo          repos.WorkRepo
 	labelRepo         repos.LabelRepo
+	userRepo          repos.UserRepo
 	workValidator     validation.WorkValidator
 	eventbusConnector *eventbus.RabbitMQConnector
 	mongoConnector    *mongolib.MongoConnector
@@ -38,6 +40,7 @@ func NewWorkGenerationHandler(
 	workRepo repos.WorkRepo,
 	workValidator validation.WorkValidator,
 	labelRepo repos.LabelRepo,
+	userRepo repos.UserRepo,
 ) *WorkGenerationHandler {
 	publisher := eventbus.NewPublisher(
 		global.EventBusConnector,
@@ -53,6 +56,7 @@ func NewWorkGenerationHandler(
 		eventbusConnector: global.EventBusConnector,
 		workValidator:     workValidator,
 		labelRepo:         labelRepo,
+		userRepo:          userRepo,
 		mongoConnector:    global.MongoDbConntector,
 		publisher:         publisher,
 	}
@@ -79,7 +83,8 @@ func (n *WorkGenerationHandler) ConsumeWorks(ctx context.Context, d rabbitmq.Del
 	}
 
 	labelMap := make(map[string]collection.Label)
-	labels, err := n.labelRepo.GetLabels(ctx)
+	// the generated works reference the system labels by key
+	labels, err := n.labelRepo.GetLabels(ctx, "")
 	if err != nil {
 		n.logger.Error("Failed to get labels", "")
 		n.PublishErrorNotification(ctx, userId, messageId)
@@ -99,19 +104,28 @@ func (n *WorkGenerationHandler) ConsumeWorks(ctx context.Context, d rabbitmq.Del
 		return rabbitmq.NackDiscard
 	}
 
+	// the generated times are local to the user, as the request was
+	timeZone, err := n.userRepo.GetUserTimeZone(ctx, userId)
+	if err != nil {
+		n.logger.Error("Failed to get time zone of user", "", zap.Error(err))
+		n.PublishErrorNotification(ctx, userId, messageId)
+		return rabbitmq.NackDiscard
+	}
+	loc := utils.LoadLocationOrDefault(timeZone)
+
 	works := make([]*collection.Work, 0, len(workMessages))
 	subTasks := make([]*collection.SubTask, 0)
 	now := time.Now().UTC()
 	draftId := labelMap[labels_constant.LabelDraft].ID
 
 	for _, wm := range workMessages {
-		startDate, err := utils.ParseLocalTimePtrToUTC(wm.StartDate, "2006-01-02 15:04")
+		startDate, err := utils.ParseLocalTimePtrToUTC(wm.StartDate, "2006-01-02 15:04", loc)
 		if err != nil {
 			n.PublishErrorNotification(ctx, userId, messageId)
 			return rabbitmq.NackDiscard
 		}
 
-		endDate, err := utils.ParseLocalTimeToUTC(wm.EndDate, "2006-01-02 15:04")
+		endDate, err := utils.ParseLocalTimeToUTC(wm.EndDate, "2006-01-02 15:04", loc)
 		if err != nil {
 			n.PublishErrorNotification(ctx, userId, messageId)
 			return rabbitmq.NackDiscard
@@ -148,6 +162,7 @@ func (n *WorkGenerationHandler) ConsumeWorks(ctx context.Context, d rabbitmq.Del
 			subTask := collection.SubTask{
 				ID:             bson.NewObjectID(),
 				Name:           stm,
+				NameNormalized: utils.RemoveAccent(stm),
 				IsCompleted:    false,
 				WorkID:         work.ID,
 				CreatedAt:      now,
@@ -223,9 +238,10 @@ func (n *WorkGenerationHandler) DecodeWorkMessage(body []byte) ([]event_models.W
 
 func (n *WorkGenerationHandler) PublishErrorNotification(ctx context.Context, userId string, messageId string) error {
 	logger := global.Logger
+	locale := n.userLocale(ctx, userId)
 	notification := event_models.Notification{
-		Title:   "Tạo công việc với AI thất bại",
-		Message: "Hệ thống gặp lỗi khi tạo công việc cho bạn. Vui lòng thử lại sau.",
+		Title:   i18n.Message(locale, i18n.MsgWorkGenerationFailedTitle),
+		Message: i18n.Message(locale, i18n.MsgWorkGenerationFailedMessage),
 
 		SenderID:    "system",
 		ReceiverIDs: []string{userId},
@@ -256,9 +272,10 @@ func (n *WorkGenerationHandler) PublishErrorNotification(ctx context.Context, us
 }
 
 func (n *WorkGenerationHandler) PublishSuccessNotification(ctx context.Context, userId string, messageId string) error {
+	locale := n.userLocale(ctx, userId)
 	notification := event_models.Notification{
-		Title:           "Tạo công việc với AI thành công",
-		Message:         "Hệ thống đã tạo công việc cho bạn thành công. Vui lòng kiểm tra trong ứng dụng.",
+		Title:           i18n.Message(locale, i18n.MsgWorkGenerationSuccessTitle),
+		Message:         i18n.Message(locale, i18n.MsgWorkGenerationSuccessMessage),
 		SenderID:        "system",
 		ReceiverIDs:     []string{userId},
 		CorrelationID:   messageId,
@@ -282,3 +299,12 @@ func (n *WorkGenerationHandler) PublishSuccessNotification(ctx context.Context,
 
 	return err
 }
+
+// userLocale returns the locale the notifications of the user are written in, the default one when it can not be read.
+func (n *WorkGenerationHandler) userLocale(ctx context.Context, userId string) string {
+	preference, err := n.userRepo.GetUserLocale(ctx, userId)
+	if err != nil {
+		n.logger.Error("Failed to get locale of user", "", zap.Error(err))
+	}
+	return i18n.UserLocale(preference)
+}
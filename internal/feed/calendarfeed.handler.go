@@ -0,0 +1,100 @@
+package feed
+
+import (
+	"net/http"
+	"personal_schedule_service/global"
+	calendar_constant "personal_schedule_service/internal/constant/calendar"
+	"personal_schedule_service/internal/grpc/models"
+	"personal_schedule_service/internal/grpc/services"
+	"strconv"
+	"strings"
+
+	"github.com/thanvuc/go-core-lib/log"
+	"go.uber.org/zap"
+)
+
+// CalendarFeedHandler serves the read-only subscription feeds at FEED_PATH<token>FEED_EXTENSION.
+// The token is the only authorization, an unknown or revoked token is answered as not found.
+type CalendarFeedHandler struct {
+	logger          log.Logger
+	calendarService services.CalendarService
+}
+
+func NewCalendarFeedHandler(calendarService services.CalendarService) *CalendarFeedHandler {
+	return &CalendarFeedHandler{
+		logger:          global.Logger,
+		calendarService: calendarService,
+	}
+}
+
+func (h *CalendarFeedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, calendar_constant.FEED_PATH)
+	token, ok := strings.CutSuffix(name, calendar_constant.FEED_EXTENSION)
+	if !ok || token == "" || strings.Contains(token, "/") {
+		http.NotFound(w, r)
+		return
+	}
+
+	feed, err := h.calendarService.GetCalendarFeed(r.Context(), token)
+	if err != nil {
+		h.logger.Error("Failed to get calendar feed", "", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if feed == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	header := w.Header()
+	header.Set("ETag", feed.ETag)
+	header.Set("Last-Modified", feed.LastModified.Format(http.TimeFormat))
+	// clients may keep the feed but have to revalidate it on every refresh
+	header.Set("Cache-Control", "private, no-cache")
+	if notModified(r, feed) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	body, err := h.calendarService.RenderCalendarFeed(r.Context(), feed)
+	if err != nil {
+		h.logger.Error("Failed to render calendar feed", "", zap.String("user_id", feed.UserID), zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	header.Set("Content-Type", calendar_constant.FEED_CONTENT_TYPE)
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := w.Write([]byte(body)); err != nil {
+		h.logger.Warn("Failed to write calendar feed", "", zap.Error(err))
+	}
+}
+
+// notModified evaluates the conditional headers of RFC 9110, If-None-Match takes precedence over If-Modified-Since.
+func notModified(r *http.Request, feed *models.CalendarFeed) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, etag := range strings.Split(inm, ",") {
+			etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+			if etag == "*" || etag == feed.ETag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" {
+		since, err := http.ParseTime(ims)
+		return err == nil && !feed.LastModified.After(since)
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"context"
+	"personal_schedule_service/internal/grpc/services"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/proto/personal_schedule"
+)
+
+type AnalyticsController struct {
+	personal_schedule.UnimplementedAnalyticsServiceServer
+	analyticsService services.AnalyticsService
+}
+
+func NewAnalyticsController(
+	analyticsService services.AnalyticsService,
+) *AnalyticsController {
+	return &AnalyticsController{
+		analyticsService: analyticsService,
+	}
+}
+
+func (a *AnalyticsController) GetProductivityStats(ctx context.Context, req *personal_schedule.GetProductivityStatsRequest) (*personal_schedule.GetProductivityStatsResponse, error) {
+	return utils.WithSafePanic(ctx, req, a.analyticsService.GetProductivityStats)
+}
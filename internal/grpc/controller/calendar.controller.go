@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"context"
+	"personal_schedule_service/internal/grpc/services"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/proto/personal_schedule"
+)
+
+type CalendarController struct {
+	personal_schedule.UnimplementedCalendarServiceServer
+	calendarService services.CalendarService
+	workService     services.WorkService
+}
+
+func NewCalendarController(
+	calendarService services.CalendarService,
+	workService services.WorkService,
+) *CalendarController {
+	return &CalendarController{
+		calendarService: calendarService,
+		workService:     workService,
+	}
+}
+
+func (c *CalendarController) ExportCalendar(ctx context.Context, req *personal_schedule.ExportCalendarRequest) (*personal_schedule.ExportCalendarResponse, error) {
+	return utils.WithSafePanic(ctx, req, c.calendarService.ExportCalendar)
+}
+
+// ImportCalendar creates works, so it is served by the work service.
+func (c *CalendarController) ImportCalendar(ctx context.Context, req *personal_schedule.ImportCalendarRequest) (*personal_schedule.ImportCalendarResponse, error) {
+	return utils.WithSafePanic(ctx, req, c.workService.ImportCalendar)
+}
+
+func (c *CalendarController) CreateCalendarFeedToken(ctx context.Context, req *personal_schedule.CreateCalendarFeedTokenRequest) (*personal_schedule.CreateCalendarFeedTokenResponse, error) {
+	return utils.WithSafePanic(ctx, req, c.calendarService.CreateCalendarFeedToken)
+}
+
+func (c *CalendarController) RotateCalendarFeedToken(ctx context.Context, req *personal_schedule.RotateCalendarFeedTokenRequest) (*personal_schedule.RotateCalendarFeedTokenResponse, error) {
+	return utils.WithSafePanic(ctx, req, c.calendarService.RotateCalendarFeedToken)
+}
+
+func (c *CalendarController) RevokeCalendarFeedToken(ctx context.Context, req *personal_schedule.RevokeCalendarFeedTokenRequest) (*personal_schedule.RevokeCalendarFeedTokenResponse, error) {
+	return utils.WithSafePanic(ctx, req, c.calendarService.RevokeCalendarFeedToken)
+}
@@ -21,14 +21,34 @@ func NewLabelController(
 	}
 }
 
-func (lc *LabelController) GetLabelPerTypes(ctx context.Context, req *common.EmptyRequest) (*personal_schedule.GetLabelPerTypesResponse, error) {
+func (lc *LabelController) GetLabelPerTypes(ctx context.Context, req *personal_schedule.GetLabelPerTypesRequest) (*personal_schedule.GetLabelPerTypesResponse, error) {
 	return utils.WithSafePanic(ctx, req, lc.labelService.GetLabelPerTypes)
 }
 
-func (lc *LabelController) GetLabelsByTypeIDs(ctx context.Context, req *common.IDRequest) (*personal_schedule.GetLabelsByTypeIDsResponse, error) {
+func (lc *LabelController) GetLabelsByTypeIDs(ctx context.Context, req *personal_schedule.GetLabelsByTypeIDsRequest) (*personal_schedule.GetLabelsByTypeIDsResponse, error) {
 	return utils.WithSafePanic(ctx, req, lc.labelService.GetLabelsByTypeIDs)
 }
 
 func (lc *LabelController) GetDefaultLabel(ctx context.Context, req *common.EmptyRequest) (*personal_schedule.GetDefaultLabelResponse, error) {
 	return utils.WithSafePanic(ctx, req, lc.labelService.GetDefaultLabel)
 }
+
+func (lc *LabelController) GetUserDefaultLabel(ctx context.Context, req *personal_schedule.GetDefaultLabelRequest) (*personal_schedule.GetDefaultLabelResponse, error) {
+	return utils.WithSafePanic(ctx, req, lc.labelService.GetUserDefaultLabel)
+}
+
+func (lc *LabelController) CreateLabel(ctx context.Context, req *personal_schedule.CreateLabelRequest) (*personal_schedule.CreateLabelResponse, error) {
+	return utils.WithSafePanic(ctx, req, lc.labelService.CreateLabel)
+}
+
+func (lc *LabelController) UpdateLabel(ctx context.Context, req *personal_schedule.UpdateLabelRequest) (*personal_schedule.UpdateLabelResponse, error) {
+	return utils.WithSafePanic(ctx, req, lc.labelService.UpdateLabel)
+}
+
+func (lc *LabelController) DeleteLabel(ctx context.Context, req *personal_schedule.DeleteLabelRequest) (*personal_schedule.DeleteLabelResponse, error) {
+	return utils.WithSafePanic(ctx, req, lc.labelService.DeleteLabel)
+}
+
+func (lc *LabelController) ReorderLabels(ctx context.Context, req *personal_schedule.ReorderLabelsRequest) (*personal_schedule.ReorderLabelsResponse, error) {
+	return utils.WithSafePanic(ctx, req, lc.labelService.ReorderLabels)
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"context"
+	"personal_schedule_service/internal/grpc/services"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/proto/personal_schedule"
+)
+
+type LabelAdminController struct {
+	personal_schedule.UnimplementedLabelAdminServiceServer
+	labelAdminService services.LabelAdminService
+}
+
+func NewLabelAdminController(
+	labelAdminService services.LabelAdminService,
+) *LabelAdminController {
+	return &LabelAdminController{
+		labelAdminService: labelAdminService,
+	}
+}
+
+func (lc *LabelAdminController) ListSystemLabels(ctx context.Context, req *personal_schedule.ListSystemLabelsRequest) (*personal_schedule.ListSystemLabelsResponse, error) {
+	return utils.WithSafePanic(ctx, req, lc.labelAdminService.ListSystemLabels)
+}
+
+func (lc *LabelAdminController) CreateSystemLabel(ctx context.Context, req *personal_schedule.CreateSystemLabelRequest) (*personal_schedule.CreateSystemLabelResponse, error) {
+	return utils.WithSafePanic(ctx, req, lc.labelAdminService.CreateSystemLabel)
+}
+
+func (lc *LabelAdminController) UpdateSystemLabel(ctx context.Context, req *personal_schedule.UpdateSystemLabelRequest) (*personal_schedule.UpdateSystemLabelResponse, error) {
+	return utils.WithSafePanic(ctx, req, lc.labelAdminService.UpdateSystemLabel)
+}
+
+func (lc *LabelAdminController) DeprecateSystemLabel(ctx context.Context, req *personal_schedule.DeprecateSystemLabelRequest) (*personal_schedule.DeprecateSystemLabelResponse, error) {
+	return utils.WithSafePanic(ctx, req, lc.labelAdminService.DeprecateSystemLabel)
+}
+
+func (lc *LabelAdminController) DeleteSystemLabel(ctx context.Context, req *personal_schedule.DeleteSystemLabelRequest) (*personal_schedule.DeleteSystemLabelResponse, error) {
+	return utils.WithSafePanic(ctx, req, lc.labelAdminService.DeleteSystemLabel)
+}
@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"context"
+	"personal_schedule_service/internal/grpc/services"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/proto/personal_schedule"
+)
+
+type SearchController struct {
+	personal_schedule.UnimplementedSearchServiceServer
+	searchService services.SearchService
+}
+
+func NewSearchController(
+	searchService services.SearchService,
+) *SearchController {
+	return &SearchController{
+		searchService: searchService,
+	}
+}
+
+func (s *SearchController) Search(ctx context.Context, req *personal_schedule.SearchRequest) (*personal_schedule.SearchResponse, error) {
+	return utils.WithSafePanic(ctx, req, s.searchService.Search)
+}
@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"context"
+	"personal_schedule_service/internal/grpc/services"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/proto/personal_schedule"
+)
+
+type TagController struct {
+	personal_schedule.UnimplementedTagServiceServer
+	tagService services.TagService
+}
+
+func NewTagController(
+	tagService services.TagService,
+) *TagController {
+	return &TagController{
+		tagService: tagService,
+	}
+}
+
+func (tc *TagController) ListTags(ctx context.Context, req *personal_schedule.ListTagsRequest) (*personal_schedule.ListTagsResponse, error) {
+	return utils.WithSafePanic(ctx, req, tc.tagService.ListTags)
+}
+
+func (tc *TagController) RenameTag(ctx context.Context, req *personal_schedule.RenameTagRequest) (*personal_schedule.RenameTagResponse, error) {
+	return utils.WithSafePanic(ctx, req, tc.tagService.RenameTag)
+}
+
+func (tc *TagController) MergeTags(ctx context.Context, req *personal_schedule.MergeTagsRequest) (*personal_schedule.MergeTagsResponse, error) {
+	return utils.WithSafePanic(ctx, req, tc.tagService.MergeTags)
+}
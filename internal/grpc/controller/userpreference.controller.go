@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+	"personal_schedule_service/internal/grpc/services"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/proto/personal_schedule"
+)
+
+type UserPreferenceController struct {
+	personal_schedule.UnimplementedUserPreferenceServiceServer
+	userPreferenceService services.UserPreferenceService
+}
+
+func NewUserPreferenceController(
+	userPreferenceService services.UserPreferenceService,
+) *UserPreferenceController {
+	return &UserPreferenceController{
+		userPreferenceService: userPreferenceService,
+	}
+}
+
+func (u *UserPreferenceController) GetUserPreference(ctx context.Context, req *personal_schedule.GetUserPreferenceRequest) (*personal_schedule.GetUserPreferenceResponse, error) {
+	return utils.WithSafePanic(ctx, req, u.userPreferenceService.GetUserPreference)
+}
+
+func (u *UserPreferenceController) UpdateUserPreference(ctx context.Context, req *personal_schedule.UpdateUserPreferenceRequest) (*personal_schedule.UpdateUserPreferenceResponse, error) {
+	return utils.WithSafePanic(ctx, req, u.userPreferenceService.UpdateUserPreference)
+}
@@ -56,3 +56,27 @@ func (wc *WorkController) DeleteAllDraftWorks(ctx context.Context, req *personal
 func (wc *WorkController) GenerateWorksByAI(ctx context.Context, req *personal_schedule.GenerateWorksByAIRequest) (*common.EmptyResponse, error) {
 	return utils.WithSafePanic(ctx, req, wc.workService.GenerateWorksFromAI)
 }
+
+func (wc *WorkController) GetWorkHistory(ctx context.Context, req *personal_schedule.GetWorkHistoryRequest) (*personal_schedule.GetWorkHistoryResponse, error) {
+	return utils.WithSafePanic(ctx, req, wc.workService.GetWorkHistory)
+}
+
+func (wc *WorkController) CheckScheduleConflicts(ctx context.Context, req *personal_schedule.CheckScheduleConflictsRequest) (*personal_schedule.CheckScheduleConflictsResponse, error) {
+	return utils.WithSafePanic(ctx, req, wc.workService.CheckScheduleConflicts)
+}
+
+func (wc *WorkController) FindFreeTime(ctx context.Context, req *personal_schedule.FindFreeTimeRequest) (*personal_schedule.FindFreeTimeResponse, error) {
+	return utils.WithSafePanic(ctx, req, wc.workService.FindFreeTime)
+}
+
+func (wc *WorkController) AutoSchedule(ctx context.Context, req *personal_schedule.AutoScheduleRequest) (*personal_schedule.AutoScheduleResponse, error) {
+	return utils.WithSafePanic(ctx, req, wc.workService.AutoSchedule)
+}
+
+func (wc *WorkController) BulkUpdateWorks(ctx context.Context, req *personal_schedule.BulkUpdateWorksRequest) (*personal_schedule.BulkUpdateWorksResponse, error) {
+	return utils.WithSafePanic(ctx, req, wc.workService.BulkUpdateWorks)
+}
+
+func (wc *WorkController) BulkDeleteWorks(ctx context.Context, req *personal_schedule.BulkDeleteWorksRequest) (*personal_schedule.BulkDeleteWorksResponse, error) {
+	return utils.WithSafePanic(ctx, req, wc.workService.BulkDeleteWorks)
+}
@@ -0,0 +1,355 @@
+package helper
+
+import (
+	"fmt"
+	"personal_schedule_service/internal/collection"
+	calendar_constant "personal_schedule_service/internal/constant/calendar"
+	labels_constant "personal_schedule_service/internal/constant/labels"
+	"personal_schedule_service/internal/grpc/models"
+	"personal_schedule_service/internal/ical"
+	"personal_schedule_service/internal/recurrence"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type calendarHelper struct{}
+
+// BuildCalendar renders the works as a VCALENDAR. Dated works become VEVENTs and undated ones VTODOs due at their
+// end date, every sub task is a VTODO related to its work. Every series is exported once with its RRULE and the
+// sub tasks of its template, only the instances moved or renamed are added as overrides of their RECURRENCE-ID.
+func (h *calendarHelper) BuildCalendar(
+	works []collection.Work,
+	series []collection.RepeatedSeries,
+	subTasks []collection.SubTask,
+	labels map[bson.ObjectID]collection.Label,
+	loc *time.Location,
+) *ical.Component {
+	now := time.Now().UTC()
+	cal := ical.NewCalendar(calendar_constant.PRODID)
+	cal.AddText("X-WR-TIMEZONE", loc.String())
+
+	seriesByID := make(map[bson.ObjectID]collection.RepeatedSeries, len(series))
+	for _, s := range series {
+		seriesByID[s.ID] = s
+	}
+	subTasksByWork := make(map[bson.ObjectID][]collection.SubTask)
+	for _, subTask := range subTasks {
+		subTasksByWork[subTask.WorkID] = append(subTasksByWork[subTask.WorkID], subTask)
+	}
+
+	var components []*ical.Component
+	zones := make(map[string]*ical.Component)
+	for _, s := range series {
+		seriesLoc := seriesLocation(s, loc)
+		components = append(components, h.seriesEvent(s, seriesLoc, labels, now))
+		addZone(zones, seriesLoc, s.DTStart)
+		for i, name := range s.Template.SubTasks {
+			components = append(components, h.templateSubTaskTodo(s, i, name, now))
+		}
+	}
+
+	for _, work := range works {
+		uid := calendarUID(work.ID)
+		s, isSeries := collection.RepeatedSeries{}, false
+		if work.RepeatedID != nil && work.StartDate != nil {
+			s, isSeries = seriesByID[*work.RepeatedID]
+		}
+
+		switch {
+		case isSeries:
+			if isSeriesOverride(work, s) {
+				event := h.workEvent(work, calendarUID(s.ID), labels, now)
+				event.AddTime("RECURRENCE-ID", *work.RecurrenceID, seriesLocation(s, loc))
+				components = append(components, event)
+			}
+			continue
+		case work.StartDate == nil:
+			components = append(components, h.workTodo(work, uid, labels, now))
+		default:
+			components = append(components, h.workEvent(work, uid, labels, now))
+		}
+
+		for _, subTask := range subTasksByWork[work.ID] {
+			components = append(components, h.subTaskTodo(subTask, uid, work.EndDate, now))
+		}
+	}
+
+	zoneIDs := make([]string, 0, len(zones))
+	for zoneID := range zones {
+		zoneIDs = append(zoneIDs, zoneID)
+	}
+	sort.Strings(zoneIDs)
+	for _, zoneID := range zoneIDs {
+		cal.AddComponent(zones[zoneID])
+	}
+	for _, component := range components {
+		cal.AddComponent(component)
+	}
+	return cal
+}
+
+// ParseEvents reads the VEVENTs of a calendar. Floating and all day times are read in loc, a recurrence follows
+// the TZID of its DTSTART, or UTC for a UTC DTSTART. An event without DTEND lasts its DURATION, or a day when it
+// is all day.
+func (h *calendarHelper) ParseEvents(cal *ical.Component, loc *time.Location) []models.CalendarEvent {
+	children := cal.Children("VEVENT")
+	events := make([]models.CalendarEvent, 0, len(children))
+	for _, component := range children {
+		events = append(events, parseEvent(component, loc))
+	}
+	return events
+}
+
+func parseEvent(component *ical.Component, loc *time.Location) models.CalendarEvent {
+	event := models.CalendarEvent{
+		UID:         strings.TrimSpace(component.Text("UID")),
+		Summary:     strings.TrimSpace(component.Text("SUMMARY")),
+		Description: strings.TrimSpace(component.Text("DESCRIPTION")),
+		Categories:  component.Texts("CATEGORIES"),
+		Cancelled:   strings.EqualFold(component.Text("STATUS"), calendar_constant.EVENT_STATUS_CANCELLED),
+		Location:    loc,
+	}
+	if event.UID == "" {
+		event.Invalid = calendar_constant.IMPORT_REASON_MISSING_UID
+		return event
+	}
+
+	if p, ok := component.Property("RECURRENCE-ID"); ok {
+		recurrenceID, _, err := p.Time(loc)
+		if err != nil {
+			event.Invalid = err.Error()
+			return event
+		}
+		recurrenceID = recurrenceID.UTC()
+		event.RecurrenceID = &recurrenceID
+	}
+
+	dtStart, ok := component.Property("DTSTART")
+	if !ok {
+		event.Invalid = calendar_constant.IMPORT_REASON_MISSING_START
+		return event
+	}
+	start, isDate, err := dtStart.Time(loc)
+	if err != nil {
+		event.Invalid = err.Error()
+		return event
+	}
+	event.Start = start.UTC()
+	event.Location = recurrenceLocation(dtStart, isDate, loc)
+
+	if p, ok := component.Property("DTEND"); ok {
+		end, _, err := p.Time(loc)
+		if err != nil {
+			event.Invalid = err.Error()
+			return event
+		}
+		event.End = end.UTC()
+	} else if p, ok := component.Property("DURATION"); ok {
+		duration, err := ical.ParseDuration(p.Value)
+		if err != nil {
+			event.Invalid = err.Error()
+			return event
+		}
+		event.End = event.Start.Add(duration)
+	} else if isDate {
+		event.End = start.AddDate(0, 0, 1).UTC()
+	} else {
+		event.End = event.Start
+	}
+	if !event.End.After(event.Start) {
+		event.Invalid = calendar_constant.IMPORT_REASON_INVALID_END
+		return event
+	}
+
+	if p, ok := component.Property("RRULE"); ok && event.RecurrenceID == nil {
+		event.RRule = strings.TrimSpace(p.Value)
+		for _, p := range component.Properties {
+			if p.Name != "EXDATE" {
+				continue
+			}
+			exDates, _, err := p.Times(event.Location)
+			if err != nil {
+				event.Invalid = err.Error()
+				return event
+			}
+			for _, exDate := range exDates {
+				event.ExDates = append(event.ExDates, exDate.UTC())
+			}
+		}
+	}
+	return event
+}
+
+// recurrenceLocation returns the zone whose wall clock the occurrences of an event keep.
+func recurrenceLocation(dtStart ical.Property, isDate bool, loc *time.Location) *time.Location {
+	if isDate {
+		return loc
+	}
+	if tzid := dtStart.Param("TZID"); tzid != "" {
+		if l, err := time.LoadLocation(strings.TrimPrefix(tzid, "/")); err == nil {
+			return l
+		}
+		return loc
+	}
+	if strings.HasSuffix(dtStart.Value, "Z") {
+		return time.UTC
+	}
+	return loc
+}
+
+func (h *calendarHelper) workEvent(work collection.Work, uid string, labels map[bson.ObjectID]collection.Label, now time.Time) *ical.Component {
+	event := ical.NewComponent("VEVENT")
+	event.Add("UID", uid)
+	event.AddTime("DTSTAMP", now, nil)
+	event.AddTime("DTSTART", *work.StartDate, nil)
+	event.AddTime("DTEND", work.EndDate, nil)
+	addWorkProperties(event, work.Name, work.ShortDescriptions, work.DetailedDescription, work.CategoryID, work.PriorityID, labels)
+	event.Add("STATUS", eventStatus(labels[work.StatusID].Key))
+	addTimestamps(event, work.CreatedAt, work.LastModifiedAt)
+	return event
+}
+
+func (h *calendarHelper) workTodo(work collection.Work, uid string, labels map[bson.ObjectID]collection.Label, now time.Time) *ical.Component {
+	todo := ical.NewComponent("VTODO")
+	todo.Add("UID", uid)
+	todo.AddTime("DTSTAMP", now, nil)
+	todo.AddTime("DUE", work.EndDate, nil)
+	addWorkProperties(todo, work.Name, work.ShortDescriptions, work.DetailedDescription, work.CategoryID, work.PriorityID, labels)
+	todo.Add("STATUS", todoStatus(labels[work.StatusID].Key))
+	addTimestamps(todo, work.CreatedAt, work.LastModifiedAt)
+	return todo
+}
+
+func (h *calendarHelper) seriesEvent(s collection.RepeatedSeries, loc *time.Location, labels map[bson.ObjectID]collection.Label, now time.Time) *ical.Component {
+	event := ical.NewComponent("VEVENT")
+	event.Add("UID", calendarUID(s.ID))
+	event.AddTime("DTSTAMP", now, nil)
+	event.AddTime("DTSTART", s.DTStart, loc)
+	event.AddTime("DTEND", s.DTStart.Add(time.Duration(s.DurationMs)*time.Millisecond), loc)
+	if rule, err := recurrence.Parse(s.RRule); err == nil {
+		event.Add("RRULE", rule.String())
+	}
+	for _, exDate := range s.ExDates {
+		event.AddTime("EXDATE", exDate, loc)
+	}
+	t := s.Template
+	addWorkProperties(event, t.Name, t.ShortDescriptions, t.DetailedDescription, t.CategoryID, t.PriorityID, labels)
+	event.Add("STATUS", calendar_constant.EVENT_STATUS_CONFIRMED)
+	addTimestamps(event, s.CreatedAt, s.LastModifiedAt)
+	return event
+}
+
+func (h *calendarHelper) subTaskTodo(subTask collection.SubTask, parentUID string, due time.Time, now time.Time) *ical.Component {
+	todo := ical.NewComponent("VTODO")
+	todo.Add("UID", calendarUID(subTask.ID))
+	todo.AddTime("DTSTAMP", now, nil)
+	todo.AddTime("DUE", due, nil)
+	todo.AddText("SUMMARY", subTask.Name)
+	todo.Add("RELATED-TO", parentUID)
+	if subTask.IsCompleted {
+		todo.Add("STATUS", calendar_constant.TODO_STATUS_COMPLETED)
+	} else {
+		todo.Add("STATUS", calendar_constant.TODO_STATUS_NEEDS_ACTION)
+	}
+	addTimestamps(todo, subTask.CreatedAt, subTask.LastModifiedAt)
+	return todo
+}
+
+// templateSubTaskTodo renders a sub task of the series template, shared by every occurrence so it has no due date.
+func (h *calendarHelper) templateSubTaskTodo(s collection.RepeatedSeries, index int, name string, now time.Time) *ical.Component {
+	todo := ical.NewComponent("VTODO")
+	todo.Add("UID", fmt.Sprintf("%s-%d@%s", s.ID.Hex(), index, calendar_constant.UID_DOMAIN))
+	todo.AddTime("DTSTAMP", now, nil)
+	todo.AddText("SUMMARY", name)
+	todo.Add("RELATED-TO", calendarUID(s.ID))
+	todo.Add("STATUS", calendar_constant.TODO_STATUS_NEEDS_ACTION)
+	addTimestamps(todo, s.CreatedAt, s.LastModifiedAt)
+	return todo
+}
+
+func addWorkProperties(
+	c *ical.Component,
+	name string,
+	shortDescriptions, detailedDescription *string,
+	categoryID, priorityID bson.ObjectID,
+	labels map[bson.ObjectID]collection.Label,
+) {
+	c.AddText("SUMMARY", name)
+	if detailedDescription != nil && *detailedDescription != "" {
+		c.AddText("DESCRIPTION", *detailedDescription)
+	} else if shortDescriptions != nil && *shortDescriptions != "" {
+		c.AddText("DESCRIPTION", *shortDescriptions)
+	}
+	if category, ok := labels[categoryID]; ok {
+		c.AddText("CATEGORIES", category.Name)
+	}
+	if priority, ok := calendar_constant.PRIORITIES[labels[priorityID].Key]; ok {
+		c.Add("PRIORITY", strconv.Itoa(priority))
+	}
+}
+
+func addTimestamps(c *ical.Component, createdAt, lastModifiedAt time.Time) {
+	if !createdAt.IsZero() {
+		c.AddTime("CREATED", createdAt, nil)
+	}
+	if !lastModifiedAt.IsZero() {
+		c.AddTime("LAST-MODIFIED", lastModifiedAt, nil)
+	}
+}
+
+// addZone adds the VTIMEZONE of a location used by local times, once per location.
+func addZone(zones map[string]*ical.Component, loc *time.Location, at time.Time) {
+	if loc == time.UTC || zones[loc.String()] != nil {
+		return
+	}
+	zones[loc.String()] = ical.TimeZone(loc, at.In(loc).Year())
+}
+
+// isSeriesOverride reports whether an instance no longer matches its series.
+func isSeriesOverride(work collection.Work, s collection.RepeatedSeries) bool {
+	if work.RecurrenceID == nil {
+		return false
+	}
+	return !work.StartDate.Equal(*work.RecurrenceID) ||
+		work.EndDate.Sub(*work.StartDate).Milliseconds() != s.DurationMs ||
+		work.Name != s.Template.Name
+}
+
+func seriesLocation(s collection.RepeatedSeries, fallback *time.Location) *time.Location {
+	if s.TimeZone == "" {
+		return fallback
+	}
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return fallback
+	}
+	return loc
+}
+
+func calendarUID(id bson.ObjectID) string {
+	return id.Hex() + "@" + calendar_constant.UID_DOMAIN
+}
+
+func eventStatus(statusKey string) string {
+	if statusKey == labels_constant.LabelGiveUp {
+		return calendar_constant.EVENT_STATUS_CANCELLED
+	}
+	return calendar_constant.EVENT_STATUS_CONFIRMED
+}
+
+func todoStatus(statusKey string) string {
+	switch statusKey {
+	case labels_constant.LabelCompleted:
+		return calendar_constant.TODO_STATUS_COMPLETED
+	case labels_constant.LabelInProgress:
+		return calendar_constant.TODO_STATUS_IN_PROCESS
+	case labels_constant.LabelGiveUp:
+		return calendar_constant.TODO_STATUS_CANCELLED
+	default:
+		return calendar_constant.TODO_STATUS_NEEDS_ACTION
+	}
+}
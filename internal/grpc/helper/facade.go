@@ -1,13 +1,56 @@
 package helper
 
-import "personal_schedule_service/internal/collection"
+import (
+	"personal_schedule_service/internal/collection"
+	"personal_schedule_service/internal/grpc/models"
+	"personal_schedule_service/internal/ical"
+	"personal_schedule_service/internal/recurrence"
+	"personal_schedule_service/proto/personal_schedule"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
 
 type (
 	LabelHelper interface {
 		GenerateLabel() []collection.Label
 	}
+
+	RecurrenceHelper interface {
+		BuildFromUpsertRequest(req *personal_schedule.UpsertWorkRequest) (*recurrence.Recurrence, error)
+		BuildFromSeries(series *collection.RepeatedSeries) (*recurrence.Recurrence, error)
+		Occurrences(rec *recurrence.Recurrence) ([]time.Time, error)
+		HorizonEnd(loc *time.Location) time.Time
+		EndsAt(rec *recurrence.Recurrence) *time.Time
+	}
+
+	ScheduleHelper interface {
+		BusyIntervals(works []collection.Work, bufferMs int64) []models.TimeRange
+		MergeIntervals(ranges []models.TimeRange) []models.TimeRange
+		FreeIntervals(busy []models.TimeRange, from, to int64) []models.TimeRange
+		OverlappingWorks(works []collection.Work, start, end int64) []collection.Work
+		NearestFreeSlots(busy []models.TimeRange, candidate models.TimeRange, windowMs int64, max int) []models.TimeRange
+		WorkingWindows(from, to time.Time, workingHours []*personal_schedule.WorkingHours) []models.TimeRange
+	}
+
+	CalendarHelper interface {
+		BuildCalendar(works []collection.Work, series []collection.RepeatedSeries, subTasks []collection.SubTask, labels map[bson.ObjectID]collection.Label, loc *time.Location) *ical.Component
+		ParseEvents(cal *ical.Component, loc *time.Location) []models.CalendarEvent
+	}
 )
 
 func NewLabelHelper() LabelHelper {
 	return &labelHelper{}
 }
+
+func NewRecurrenceHelper() RecurrenceHelper {
+	return &recurrenceHelper{}
+}
+
+func NewScheduleHelper() ScheduleHelper {
+	return &scheduleHelper{}
+}
+
+func NewCalendarHelper() CalendarHelper {
+	return &calendarHelper{}
+}
@@ -0,0 +1,106 @@
+package helper
+
+import (
+	"fmt"
+	"personal_schedule_service/global"
+	"personal_schedule_service/internal/collection"
+	workgeneration_constant "personal_schedule_service/internal/constant/work"
+	"personal_schedule_service/internal/recurrence"
+	"personal_schedule_service/proto/personal_schedule"
+	"strings"
+	"time"
+)
+
+type recurrenceHelper struct{}
+
+// BuildFromUpsertRequest builds the recurrence of a repeated work.
+// Without a rule it falls back to the legacy form: every day from repeat_start_date to repeat_end_date.
+func (h *recurrenceHelper) BuildFromUpsertRequest(req *personal_schedule.UpsertWorkRequest) (*recurrence.Recurrence, error) {
+	if req.StartDate == nil {
+		return nil, fmt.Errorf("repeated work must have start_date")
+	}
+
+	loc := global.HCMTimeLocation
+	if req.Recurrence != nil && req.Recurrence.TimeZone != nil && *req.Recurrence.TimeZone != "" {
+		l, err := time.LoadLocation(*req.Recurrence.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q", *req.Recurrence.TimeZone)
+		}
+		loc = l
+	}
+
+	dtStart := time.UnixMilli(*req.StartDate).In(loc)
+	if req.RepeatStartDate != nil {
+		d := time.UnixMilli(*req.RepeatStartDate).In(loc)
+		dtStart = time.Date(d.Year(), d.Month(), d.Day(), dtStart.Hour(), dtStart.Minute(), dtStart.Second(), 0, loc)
+	}
+
+	var rule *recurrence.Rule
+	var exDates []time.Time
+	if req.Recurrence != nil && strings.TrimSpace(req.Recurrence.Rrule) != "" {
+		parsed, err := recurrence.Parse(req.Recurrence.Rrule)
+		if err != nil {
+			return nil, err
+		}
+		rule = parsed
+		for _, ex := range req.Recurrence.Exdates {
+			exDates = append(exDates, time.UnixMilli(ex).In(loc))
+		}
+	} else {
+		if req.RepeatStartDate == nil || req.RepeatEndDate == nil {
+			return nil, fmt.Errorf("repeated work must have a rrule or repeat_start_date and repeat_end_date")
+		}
+		rule = &recurrence.Rule{Freq: recurrence.Daily, Interval: 1, WeekStart: time.Monday}
+	}
+
+	// a rule without COUNT or UNTIL repeats forever unless repeat_end_date closes it
+	if !rule.IsBounded() && req.RepeatEndDate != nil {
+		end := time.UnixMilli(*req.RepeatEndDate).In(loc)
+		until := time.Date(end.Year(), end.Month(), end.Day(), 23, 59, 59, 0, loc).UTC()
+		rule.Until = &until
+	}
+
+	return &recurrence.Recurrence{
+		Rule:     rule,
+		DTStart:  dtStart,
+		ExDates:  exDates,
+		Location: loc,
+	}, nil
+}
+
+func (h *recurrenceHelper) BuildFromSeries(series *collection.RepeatedSeries) (*recurrence.Recurrence, error) {
+	loc := global.HCMTimeLocation
+	if series.TimeZone != "" {
+		l, err := time.LoadLocation(series.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+		loc = l
+	}
+	return recurrence.New(series.RRule, series.DTStart, series.ExDates, loc)
+}
+
+// Occurrences returns the first recurrence.MaxOccurrences occurrences, failing when the rule produces none.
+func (h *recurrenceHelper) Occurrences(rec *recurrence.Recurrence) ([]time.Time, error) {
+	occurrences := rec.All(0)
+	if len(occurrences) == 0 {
+		return nil, fmt.Errorf("recurrence rule does not produce any occurrence")
+	}
+	return occurrences, nil
+}
+
+// HorizonEnd is the end of the materialization window: midnight after the last horizon day in loc.
+func (h *recurrenceHelper) HorizonEnd(loc *time.Location) time.Time {
+	now := time.Now().In(loc)
+	return time.Date(now.Year(), now.Month(), now.Day()+workgeneration_constant.REPEATED_WORK_HORIZON_DAYS+1, 0, 0, 0, 0, loc)
+}
+
+// EndsAt returns the start of the last occurrence, nil when the recurrence never ends.
+func (h *recurrenceHelper) EndsAt(rec *recurrence.Recurrence) *time.Time {
+	last, ok := rec.Last()
+	if !ok {
+		return nil
+	}
+	last = last.UTC()
+	return &last
+}
@@ -0,0 +1,159 @@
+package helper
+
+import (
+	"personal_schedule_service/internal/collection"
+	"personal_schedule_service/internal/grpc/models"
+	"personal_schedule_service/proto/personal_schedule"
+	"sort"
+	"time"
+)
+
+type scheduleHelper struct{}
+
+// BusyIntervals merges the time of the works, each widened by buffer on both sides, in sorted disjoint ranges.
+// Works without start date do not take any time.
+func (h *scheduleHelper) BusyIntervals(works []collection.Work, bufferMs int64) []models.TimeRange {
+	ranges := make([]models.TimeRange, 0, len(works))
+	for _, work := range works {
+		if work.StartDate == nil || work.EndDate.IsZero() {
+			continue
+		}
+		ranges = append(ranges, models.TimeRange{
+			StartTime: work.StartDate.UnixMilli() - bufferMs,
+			EndTime:   work.EndDate.UnixMilli() + bufferMs,
+		})
+	}
+	return h.MergeIntervals(ranges)
+}
+
+// MergeIntervals sorts the ranges and merges the overlapping or touching ones.
+func (h *scheduleHelper) MergeIntervals(ranges []models.TimeRange) []models.TimeRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sorted := make([]models.TimeRange, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].StartTime < sorted[j].StartTime })
+
+	merged := []models.TimeRange{sorted[0]}
+	for _, r := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if r.StartTime <= last.EndTime {
+			if r.EndTime > last.EndTime {
+				last.EndTime = r.EndTime
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// FreeIntervals returns the gaps of busy, which must be merged, inside [from, to).
+func (h *scheduleHelper) FreeIntervals(busy []models.TimeRange, from, to int64) []models.TimeRange {
+	var free []models.TimeRange
+	cursor := from
+	for _, b := range busy {
+		if b.EndTime <= cursor {
+			continue
+		}
+		if b.StartTime >= to {
+			break
+		}
+		if b.StartTime > cursor {
+			free = append(free, models.TimeRange{StartTime: cursor, EndTime: b.StartTime})
+		}
+		cursor = b.EndTime
+	}
+	if cursor < to {
+		free = append(free, models.TimeRange{StartTime: cursor, EndTime: to})
+	}
+	return free
+}
+
+// OverlappingWorks returns the works intersecting [start, end).
+func (h *scheduleHelper) OverlappingWorks(works []collection.Work, start, end int64) []collection.Work {
+	var overlapping []collection.Work
+	for _, work := range works {
+		if work.StartDate == nil {
+			continue
+		}
+		if work.StartDate.UnixMilli() < end && work.EndDate.UnixMilli() > start {
+			overlapping = append(overlapping, work)
+		}
+	}
+	return overlapping
+}
+
+// NearestFreeSlots places a slot of the candidate duration in every free gap of busy within window of the candidate,
+// as close as possible to it, and returns the max nearest ones.
+func (h *scheduleHelper) NearestFreeSlots(busy []models.TimeRange, candidate models.TimeRange, windowMs int64, max int) []models.TimeRange {
+	duration := candidate.EndTime - candidate.StartTime
+	if duration <= 0 || max <= 0 {
+		return nil
+	}
+
+	type slot struct {
+		models.TimeRange
+		distance int64
+	}
+	var slots []slot
+	for _, gap := range h.FreeIntervals(busy, candidate.StartTime-windowMs, candidate.EndTime+windowMs) {
+		if gap.EndTime-gap.StartTime < duration {
+			continue
+		}
+		start := candidate.StartTime
+		if start < gap.StartTime {
+			start = gap.StartTime
+		}
+		if start+duration > gap.EndTime {
+			start = gap.EndTime - duration
+		}
+		distance := start - candidate.StartTime
+		if distance < 0 {
+			distance = -distance
+		}
+		slots = append(slots, slot{TimeRange: models.TimeRange{StartTime: start, EndTime: start + duration}, distance: distance})
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool { return slots[i].distance < slots[j].distance })
+	if len(slots) > max {
+		slots = slots[:max]
+	}
+	result := make([]models.TimeRange, 0, len(slots))
+	for _, s := range slots {
+		result = append(result, s.TimeRange)
+	}
+	return result
+}
+
+// WorkingWindows cuts [from, to) in the local working hours of every day, in the location of from.
+// Without working hours the whole range is a single window.
+func (h *scheduleHelper) WorkingWindows(from, to time.Time, workingHours []*personal_schedule.WorkingHours) []models.TimeRange {
+	if len(workingHours) == 0 {
+		return []models.TimeRange{{StartTime: from.UnixMilli(), EndTime: to.UnixMilli()}}
+	}
+
+	loc := from.Location()
+	var windows []models.TimeRange
+	y, m, d := from.Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, loc); day.Before(to); day = day.AddDate(0, 0, 1) {
+		for _, hours := range workingHours {
+			if time.Weekday(hours.Weekday) != day.Weekday() {
+				continue
+			}
+			start := time.Date(day.Year(), day.Month(), day.Day(), 0, int(hours.StartMinute), 0, 0, loc)
+			end := time.Date(day.Year(), day.Month(), day.Day(), 0, int(hours.EndMinute), 0, 0, loc)
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if end.After(start) {
+				windows = append(windows, models.TimeRange{StartTime: start.UnixMilli(), EndTime: end.UnixMilli()})
+			}
+		}
+	}
+	return h.MergeIntervals(windows)
+}
@@ -0,0 +1,46 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/subtle"
+	"personal_schedule_service/global"
+	"personal_schedule_service/internal/grpc/utils"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AdminTokenMetadataKey is the metadata carrying the shared secret of the operator tools.
+const AdminTokenMetadataKey = "x-admin-token"
+
+// NewAdminInterceptor only lets the requests carrying the admin token reach the LabelAdminService,
+// the system labels it edits are shared by every user.
+func NewAdminInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !strings.HasPrefix(info.FullMethod, labelAdminServicePrefix) {
+			return handler(ctx, req)
+		}
+		if token == "" || !hasAdminToken(ctx, token) {
+			global.Logger.Warn("Rejected label admin request without a valid admin token", utils.GetRequestIDFromOutgoingContext(ctx), zap.String("method", info.FullMethod))
+			return nil, status.Error(codes.PermissionDenied, "admin token required")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func hasAdminToken(ctx context.Context, token string) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, value := range md.Get(AdminTokenMetadataKey) {
+		if subtle.ConstantTimeCompare([]byte(value), []byte(token)) == 1 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package interceptor
+
+import (
+	"context"
+	"personal_schedule_service/global"
+	user_constant "personal_schedule_service/internal/constant/user"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/i18n"
+	"personal_schedule_service/internal/repos"
+	"personal_schedule_service/proto/personal_schedule"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// labelAdminServicePrefix marks the methods editing the stored text of the system labels, which is returned as is.
+var labelAdminServicePrefix = "/" + personal_schedule.LabelAdminService_ServiceDesc.ServiceName + "/"
+
+// userRequest is a request made on behalf of a user.
+type userRequest interface {
+	GetUserId() string
+}
+
+// NewLocaleInterceptor resolves the locale of each request, from its metadata, else the preference of its user,
+// else the default one. The locale is put in the context and the system labels of the response are localized.
+// The preferences are cached, an update of the preference through this replica evicts it.
+func NewLocaleInterceptor(userRepo repos.UserRepo) grpc.UnaryServerInterceptor {
+	cache := newLocaleCache()
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		locale := resolveLocale(ctx, userRepo, cache, req)
+		resp, err := handler(i18n.WithLocale(ctx, locale), req)
+
+		if r, ok := req.(userRequest); ok && info.FullMethod == personal_schedule.UserPreferenceService_UpdateUserPreference_FullMethodName {
+			cache.delete(r.GetUserId())
+		}
+		if m, ok := resp.(proto.Message); ok && !strings.HasPrefix(info.FullMethod, labelAdminServicePrefix) {
+			i18n.LocalizeLabels(m, locale)
+		}
+		return resp, err
+	}
+}
+
+func resolveLocale(ctx context.Context, userRepo repos.UserRepo, cache *localeCache, req any) string {
+	if locale, ok := i18n.LocaleFromMetadata(ctx); ok {
+		return locale
+	}
+
+	r, ok := req.(userRequest)
+	if !ok || r.GetUserId() == "" {
+		return i18n.UserLocale("")
+	}
+	if preference, ok := cache.get(r.GetUserId()); ok {
+		return i18n.UserLocale(preference)
+	}
+	preference, err := userRepo.GetUserLocale(ctx, r.GetUserId())
+	if err != nil {
+		// the request is still served, in the default locale
+		global.Logger.Warn("Failed to get locale of user", utils.GetRequestIDFromOutgoingContext(ctx), zap.Error(err))
+		return i18n.UserLocale("")
+	}
+	cache.set(r.GetUserId(), preference)
+	return i18n.UserLocale(preference)
+}
+
+type cachedLocale struct {
+	locale    string
+	expiresAt time.Time
+}
+
+// localeCache keeps the locale preference of the users for LOCALE_CACHE_TTL,
+// so the requests without locale metadata do not read the user each time.
+type localeCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedLocale
+}
+
+func newLocaleCache() *localeCache {
+	return &localeCache{entries: make(map[string]cachedLocale)}
+}
+
+func (c *localeCache) get(userID string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[userID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return "", false
+	}
+	return entry.locale, true
+}
+
+func (c *localeCache) set(userID string, locale string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.entries) >= user_constant.LOCALE_CACHE_SIZE {
+		for id, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, id)
+			}
+		}
+		// every entry is still fresh, starting over is cheaper than tracking their age
+		if len(c.entries) >= user_constant.LOCALE_CACHE_SIZE {
+			clear(c.entries)
+		}
+	}
+	c.entries[userID] = cachedLocale{locale: locale, expiresAt: now.Add(user_constant.LOCALE_CACHE_TTL)}
+}
+
+func (c *localeCache) delete(userID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, userID)
+}
@@ -2,6 +2,7 @@ package mapper
 
 import (
 	"personal_schedule_service/internal/collection"
+	"personal_schedule_service/internal/grpc/models"
 	"personal_schedule_service/internal/repos"
 	"personal_schedule_service/proto/personal_schedule"
 )
@@ -11,6 +12,7 @@ type (
 		MapLabelsToLabelTypesProto(labels []collection.Label) []*personal_schedule.LabelPerType
 		MapLabelsToLabelsProto(labels []collection.Label) []*personal_schedule.Label
 		MapLabelToProto(labels *collection.Label) *personal_schedule.LabelInfo
+		MapLabelToLabelProto(labels collection.Label) *personal_schedule.Label
 	}
 
 	GoalMapper interface {
@@ -23,6 +25,15 @@ type (
 		MapUpsertProtoToModels(req *personal_schedule.UpsertWorkRequest) (*collection.Work, []collection.SubTask, error)
 		ConvertAggregatedWorksToProto(aggWorks []repos.AggregatedWork) []*personal_schedule.Work
 		MapAggregatedToWorkDetailProto(aggWork repos.AggregatedWork, subTasks []collection.SubTask) *personal_schedule.WorkDetail
+		MapSeriesToRecurrenceProto(series *collection.RepeatedSeries) *personal_schedule.RecurrenceRule
+		MapWorkEventsToProto(events []repos.AggregatedWorkEvent) []*personal_schedule.WorkHistoryEvent
+		MapConflictingWorksToProto(works []collection.Work) []*personal_schedule.ConflictingWork
+		MapTimeRangesToProto(ranges []models.TimeRange) []*personal_schedule.TimeInterval
+	}
+
+	UserPreferenceMapper interface {
+		MapUserToPreferenceProto(userID string, user *collection.User) *personal_schedule.UserPreference
+		MapUpdateRequestToUser(req *personal_schedule.UpdateUserPreferenceRequest) *collection.User
 	}
 )
 
@@ -37,3 +48,7 @@ func NewGoalMapper() GoalMapper {
 func NewWorkMapper() WorkMapper {
 	return &workMapper{}
 }
+
+func NewUserPreferenceMapper() UserPreferenceMapper {
+	return &userPreferenceMapper{}
+}
@@ -55,6 +55,21 @@ func (m *goalMapper) MapAggregatedGoalToProto(aggGoal repos.AggregatedGoal) *per
 		},
 		Category: m.mapLabelsToProto(aggGoal.Category),
 		Overdue:  m.mapLabelsToProto(aggGoal.Overdue),
+		Progress: m.mapProgressToProto(aggGoal.Progress),
+		Tags:     aggGoal.Tags,
+	}
+}
+
+func (m *goalMapper) mapProgressToProto(progress repos.GoalProgress) *personal_schedule.GoalProgress {
+	return &personal_schedule.GoalProgress{
+		TotalTasks:           progress.TotalTasks,
+		CompletedTasks:       progress.CompletedTasks,
+		TotalWorks:           progress.TotalWorks,
+		CompletedWorks:       progress.CompletedWorks,
+		TotalWorkTimeMs:      progress.TotalWorkTimeMs,
+		CompletedWorkTimeMs:  progress.CompletedWorkTimeMs,
+		CompletionPercentage: progress.CompletionPercentage,
+		TimeRemainingMs:      progress.TimeRemainingMs,
 	}
 }
 
@@ -129,6 +144,7 @@ func (m *goalMapper) mapProtoGoalToDB(req *personal_schedule.UpsertGoalRequest)
 		DifficultyID:        difficultyID,
 		PriorityID:          priorityID,
 		CategoryID:          categoryID,
+		Tags:                utils.NormalizeTags(req.Tags),
 	}, nil
 }
 
@@ -141,9 +157,10 @@ func (m *goalMapper) mapGoalTasktoDB(pt []*personal_schedule.GoalTaskPayload) ([
 			taskId, _ = bson.ObjectIDFromHex(*task.Id)
 		}
 		taskDB[i] = collection.GoalTask{
-			ID:          taskId,
-			Name:        task.Name,
-			IsCompleted: task.IsCompleted,
+			ID:             taskId,
+			Name:           task.Name,
+			NameNormalized: utils.RemoveAccent(task.Name),
+			IsCompleted:    task.IsCompleted,
 		}
 	}
 	return taskDB, nil
@@ -178,6 +195,8 @@ func (m *goalMapper) MapAggregatedToDetailProto(aggGoal repos.AggregatedGoal, db
 			Priority:   goalBaseProto.GoalLabels.Priority,
 			Category:   goalBaseProto.Category,
 		},
-		Tasks: tasksProto,
+		Tasks:    tasksProto,
+		Progress: goalBaseProto.Progress,
+		Tags:     goalBaseProto.Tags,
 	}
 }
@@ -2,6 +2,7 @@ package mapper
 
 import (
 	"personal_schedule_service/internal/collection"
+	"personal_schedule_service/internal/grpc/utils"
 	"personal_schedule_service/proto/personal_schedule"
 	"sort"
 )
@@ -43,13 +44,16 @@ func (m *labelMapper) MapLabelsToLabelsProto(labels []collection.Label) []*perso
 
 func (m *labelMapper) MapLabelToLabelProto(labels collection.Label) *personal_schedule.Label {
 	return &personal_schedule.Label{
-		Id:        labels.ID.Hex(),
-		Name:      labels.Name,
-		Color:     *labels.Color,
-		Key:       labels.Key,
-		Meaning:   *labels.Meaning,
-		Note:      *labels.Note,
-		LabelType: int32(labels.LabelType),
+		Id:           labels.ID.Hex(),
+		Name:         labels.Name,
+		Color:        utils.SafeString(labels.Color),
+		Key:          labels.Key,
+		Meaning:      utils.SafeString(labels.Meaning),
+		Note:         utils.SafeString(labels.Note),
+		LabelType:    int32(labels.LabelType),
+		IsCustom:     labels.UserID != nil,
+		Order:        labels.Order,
+		IsDeprecated: labels.DeprecatedAt != nil,
 	}
 }
 
@@ -0,0 +1,105 @@
+package mapper
+
+import (
+	"personal_schedule_service/global"
+	"personal_schedule_service/internal/collection"
+	user_constant "personal_schedule_service/internal/constant/user"
+	"personal_schedule_service/proto/personal_schedule"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type userPreferenceMapper struct{}
+
+// MapUserToPreferenceProto maps the preferences of the user, the defaults fill what the user has not chosen.
+// user is nil when the user is not synced yet.
+func (m *userPreferenceMapper) MapUserToPreferenceProto(userID string, user *collection.User) *personal_schedule.UserPreference {
+	preference := &personal_schedule.UserPreference{
+		UserId:        userID,
+		TimeZone:      global.HCMTimeLocation.String(),
+		Locale:        user_constant.DEFAULT_LOCALE,
+		WeekStart:     int32(user_constant.DEFAULT_WEEK_START),
+		DefaultLabels: &personal_schedule.DefaultLabelPreference{},
+	}
+	if user == nil {
+		return preference
+	}
+
+	if user.TimeZone != "" {
+		preference.TimeZone = user.TimeZone
+	}
+	if user.Locale != "" {
+		preference.Locale = user.Locale
+	}
+	if user.WeekStart != nil {
+		preference.WeekStart = *user.WeekStart
+	}
+	for _, hours := range user.WorkingHours {
+		preference.WorkingHours = append(preference.WorkingHours, &personal_schedule.WorkingHours{
+			Weekday:     hours.Weekday,
+			StartMinute: hours.StartMinute,
+			EndMinute:   hours.EndMinute,
+		})
+	}
+	if labels := user.DefaultLabels; labels != nil {
+		preference.DefaultLabels = &personal_schedule.DefaultLabelPreference{
+			TypeId:       hexOrNil(labels.TypeID),
+			StatusId:     hexOrNil(labels.StatusID),
+			DifficultyId: hexOrNil(labels.DifficultyID),
+			PriorityId:   hexOrNil(labels.PriorityID),
+			CategoryId:   hexOrNil(labels.CategoryID),
+		}
+	}
+	preference.ReminderOffsetsMinutes = user.ReminderOffsetsMinutes
+	preference.DailyCapacityHours = user.DailyCapacityHours
+	return preference
+}
+
+// MapUpdateRequestToUser maps a validated update request, the empty values are stored as not chosen.
+func (m *userPreferenceMapper) MapUpdateRequestToUser(req *personal_schedule.UpdateUserPreferenceRequest) *collection.User {
+	weekStart := req.WeekStart
+	user := &collection.User{
+		ID:                     req.UserId,
+		TimeZone:               req.TimeZone,
+		Locale:                 req.Locale,
+		WeekStart:              &weekStart,
+		ReminderOffsetsMinutes: req.ReminderOffsetsMinutes,
+		DailyCapacityHours:     req.DailyCapacityHours,
+	}
+	for _, hours := range req.WorkingHours {
+		user.WorkingHours = append(user.WorkingHours, collection.UserWorkingHours{
+			Weekday:     hours.Weekday,
+			StartMinute: hours.StartMinute,
+			EndMinute:   hours.EndMinute,
+		})
+	}
+	if labels := req.DefaultLabels; labels != nil {
+		user.DefaultLabels = &collection.UserDefaultLabels{
+			TypeID:       objectIDOrNil(labels.TypeId),
+			StatusID:     objectIDOrNil(labels.StatusId),
+			DifficultyID: objectIDOrNil(labels.DifficultyId),
+			PriorityID:   objectIDOrNil(labels.PriorityId),
+			CategoryID:   objectIDOrNil(labels.CategoryId),
+		}
+	}
+	return user
+}
+
+func hexOrNil(id *bson.ObjectID) *string {
+	if id == nil {
+		return nil
+	}
+	hex := id.Hex()
+	return &hex
+}
+
+func objectIDOrNil(hex *string) *bson.ObjectID {
+	if hex == nil || *hex == "" {
+		return nil
+	}
+	id, err := bson.ObjectIDFromHex(*hex)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
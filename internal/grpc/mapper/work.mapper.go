@@ -2,6 +2,7 @@ package mapper
 
 import (
 	"personal_schedule_service/internal/collection"
+	"personal_schedule_service/internal/grpc/models"
 	"personal_schedule_service/internal/grpc/utils"
 	"personal_schedule_service/internal/repos"
 	"personal_schedule_service/proto/personal_schedule"
@@ -84,6 +85,7 @@ func (m *workMapper) mapProtoWorkToDB(req *personal_schedule.UpsertWorkRequest)
 		DraftID:             &draftID,
 		UserID:              req.UserId,
 		GoalID:              goalID,
+		Tags:                utils.NormalizeTags(req.Tags),
 	}, nil
 }
 
@@ -96,9 +98,10 @@ func (m *workMapper) mapSubTaskToDB(payload []*personal_schedule.SubTaskPayload)
 		}
 
 		taskDB[i] = collection.SubTask{
-			ID:          taskID,
-			Name:        task.Name,
-			IsCompleted: task.IsCompleted,
+			ID:             taskID,
+			Name:           task.Name,
+			NameNormalized: utils.RemoveAccent(task.Name),
+			IsCompleted:    task.IsCompleted,
 		}
 	}
 	return taskDB, nil
@@ -153,6 +156,7 @@ func (m *workMapper) MapAggregatedWorkToProto(aggWork repos.AggregatedWork) *per
 		},
 		Category: m.mapLabelsToProto(aggWork.Category),
 		Overdue:  m.mapLabelsToProto(aggWork.Overdue),
+		Tags:     aggWork.Tags,
 	}
 }
 
@@ -209,5 +213,70 @@ func (m *workMapper) MapAggregatedToWorkDetailProto(aggWork repos.AggregatedWork
 		SubTasks:              subTasksProto,
 		RepeatSeriesStartDate: nil,
 		RepeatSeriesEndDate:   nil,
+		Tags:                  workBaseProto.Tags,
 	}
 }
+
+func (m *workMapper) MapSeriesToRecurrenceProto(series *collection.RepeatedSeries) *personal_schedule.RecurrenceRule {
+	if series == nil {
+		return nil
+	}
+	exDates := make([]int64, 0, len(series.ExDates))
+	for _, ex := range series.ExDates {
+		exDates = append(exDates, ex.UnixMilli())
+	}
+	timeZone := series.TimeZone
+	return &personal_schedule.RecurrenceRule{
+		Rrule:    series.RRule,
+		Exdates:  exDates,
+		TimeZone: &timeZone,
+	}
+}
+
+func (m *workMapper) MapWorkEventsToProto(events []repos.AggregatedWorkEvent) []*personal_schedule.WorkHistoryEvent {
+	protoEvents := make([]*personal_schedule.WorkHistoryEvent, 0, len(events))
+	for _, event := range events {
+		protoEvents = append(protoEvents, &personal_schedule.WorkHistoryEvent{
+			Id:        event.ID.Hex(),
+			EventType: event.EventType,
+			Field:     event.Field,
+			From:      m.mapLabelsToProto(event.From),
+			To:        m.mapLabelsToProto(event.To),
+			ActorId:   event.ActorID,
+			Reason:    event.Reason,
+			CreatedAt: event.CreatedAt.UnixMilli(),
+		})
+	}
+	return protoEvents
+}
+
+func (m *workMapper) MapConflictingWorksToProto(works []collection.Work) []*personal_schedule.ConflictingWork {
+	protoWorks := make([]*personal_schedule.ConflictingWork, 0, len(works))
+	for _, work := range works {
+		protoWork := &personal_schedule.ConflictingWork{
+			Id:      work.ID.Hex(),
+			Name:    work.Name,
+			EndDate: work.EndDate.UnixMilli(),
+		}
+		if work.StartDate != nil {
+			protoWork.StartDate = work.StartDate.UnixMilli()
+		}
+		if work.RepeatedID != nil {
+			repeatedID := work.RepeatedID.Hex()
+			protoWork.RepeatedId = &repeatedID
+		}
+		protoWorks = append(protoWorks, protoWork)
+	}
+	return protoWorks
+}
+
+func (m *workMapper) MapTimeRangesToProto(ranges []models.TimeRange) []*personal_schedule.TimeInterval {
+	intervals := make([]*personal_schedule.TimeInterval, 0, len(ranges))
+	for _, r := range ranges {
+		intervals = append(intervals, &personal_schedule.TimeInterval{
+			Start: r.StartTime,
+			End:   r.EndTime,
+		})
+	}
+	return intervals
+}
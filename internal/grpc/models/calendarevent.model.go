@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// CalendarEvent is a VEVENT read from an imported calendar.
+// Invalid holds the reason the event cannot be imported, the other fields may then be partial.
+type CalendarEvent struct {
+	UID          string
+	RecurrenceID *time.Time
+	Summary      string
+	Description  string
+	Categories   []string
+	Start        time.Time
+	End          time.Time
+	RRule        string
+	ExDates      []time.Time
+	Location     *time.Location
+	Cancelled    bool
+	Invalid      string
+}
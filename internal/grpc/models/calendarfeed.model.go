@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// CalendarFeed is the current version of the subscription feed of a user, the feed is rendered
+// for the works overlapping [From, To) in Location, with the labels named in Locale.
+type CalendarFeed struct {
+	UserID       string
+	Location     *time.Location
+	Locale       string
+	From         time.Time
+	To           time.Time
+	ETag         string
+	LastModified time.Time
+}
@@ -4,6 +4,7 @@ type GenerationWorksModel struct {
 	UserID            string `bson:"user_id" json:"user_id"`
 	Prompts           string `bson:"prompts" json:"prompts"`
 	LocalDate         string `bson:"local_date" json:"local_date"`
+	TimeZone          string `bson:"time_zone" json:"time_zone"`
 	AdditionalContext string `bson:"additional_context" json:"additional_context"`
 	Constraints       string `bson:"constraints" json:"constraints"`
 	UserPersonality   string `bson:"user_personality" json:"user_personality"`
@@ -1,6 +1,18 @@
 package models
 
+import "go.mongodb.org/mongo-driver/v2/bson"
+
 type Pagination struct {
 	Limit  int32 `json:"limit"`
 	Offset int32 `json:"offset"`
 }
+
+// WorkCursor is the position of the last work of a page in the sort it was read with.
+// Value is the sort field of the work, in ms for the dates, Null is set when the work has no value for it.
+type WorkCursor struct {
+	SortKey    int32         `json:"k"`
+	Descending bool          `json:"d"`
+	Value      int64         `json:"v"`
+	Null       bool          `json:"n,omitempty"`
+	ID         bson.ObjectID `json:"id"`
+}
@@ -3,5 +3,6 @@ package models
 type UserOutboxPayload struct {
 	UserID    string `json:"user_id"`
 	Email     string `json:"email"`
+	TimeZone  string `json:"time_zone,omitempty"`
 	CreatedAt int64  `json:"created_at"`
 }
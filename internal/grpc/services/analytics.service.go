@@ -0,0 +1,311 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"personal_schedule_service/internal/collection"
+	analytics_constant "personal_schedule_service/internal/constant/analytics"
+	labels_constant "personal_schedule_service/internal/constant/labels"
+	"personal_schedule_service/internal/grpc/mapper"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/repos"
+	"personal_schedule_service/proto/personal_schedule"
+	"sort"
+	"time"
+
+	"github.com/thanvuc/go-core-lib/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.uber.org/zap"
+)
+
+type analyticsService struct {
+	logger        log.Logger
+	analyticsRepo repos.AnalyticsRepo
+	workRepo      repos.WorkRepo
+	labelRepo     repos.LabelRepo
+	userRepo      repos.UserRepo
+	labelMapper   mapper.LabelMapper
+}
+
+// bucketRange is a local bucket [start, end) with the stats accumulated on it.
+type bucketRange struct {
+	start time.Time
+	end   time.Time
+	proto *personal_schedule.ProductivityBucket
+}
+
+func (s *analyticsService) GetProductivityStats(ctx context.Context, req *personal_schedule.GetProductivityStatsRequest) (*personal_schedule.GetProductivityStatsResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	loc, err := userLocation(ctx, s.userRepo, req.UserId, req.TimeZone)
+	if err != nil {
+		s.logger.Warn("Failed to resolve time zone", requestID, zap.Error(err))
+		return &personal_schedule.GetProductivityStatsResponse{
+			Error: timeZoneError(ctx, err),
+		}, nil
+	}
+
+	from := time.UnixMilli(req.From).In(loc)
+	to := time.UnixMilli(req.To).In(loc)
+	if !to.After(from) {
+		return &personal_schedule.GetProductivityStatsResponse{
+			Error: utils.InternalServerError(ctx, fmt.Errorf("to must be after from")),
+		}, nil
+	}
+
+	user, err := userPreferences(ctx, s.userRepo, req.UserId)
+	if err != nil {
+		s.logger.Error("Failed to get preferences of user", requestID, zap.Error(err))
+		return &personal_schedule.GetProductivityStatsResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	weekStart := preferredWeekStart(user)
+
+	unit := bucketUnit(req.BucketSize)
+	buckets := buildBuckets(from, to, unit, loc, weekStart)
+	if len(buckets) > analytics_constant.MAX_BUCKETS {
+		return &personal_schedule.GetProductivityStatsResponse{
+			Error: utils.InternalServerError(ctx, fmt.Errorf("range too large: %d buckets, at most %d", len(buckets), analytics_constant.MAX_BUCKETS)),
+		}, nil
+	}
+
+	// the buckets are whole, so works of the first and last partial buckets are counted as well
+	rangeStart, rangeEnd := buckets[0].start, buckets[len(buckets)-1].end
+	stats, err := s.analyticsRepo.GetWorkStats(ctx, req.UserId, rangeStart.UTC(), rangeEnd.UTC(), unit, loc.String(), weekStart)
+	if err != nil {
+		s.logger.Error("Failed to get work stats", requestID, zap.Error(err))
+		return &personal_schedule.GetProductivityStatsResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	labels, err := s.labelRepo.GetLabels(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error("Failed to get labels", requestID, zap.Error(err))
+		return &personal_schedule.GetProductivityStatsResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	labelByID := make(map[bson.ObjectID]*collection.Label, len(labels))
+	for i := range labels {
+		labelByID[labels[i].ID] = &labels[i]
+	}
+
+	bucketByStart := make(map[int64]*personal_schedule.ProductivityBucket, len(buckets))
+	for _, b := range buckets {
+		bucketByStart[b.start.UnixMilli()] = b.proto
+	}
+	summary := &personal_schedule.ProductivityBucket{
+		Start: rangeStart.UnixMilli(),
+		End:   rangeEnd.UnixMilli(),
+	}
+
+	for _, group := range stats.Status {
+		bucket, ok := bucketByStart[group.Bucket.UnixMilli()]
+		if !ok {
+			continue
+		}
+		for _, target := range []*personal_schedule.ProductivityBucket{bucket, summary} {
+			target.TotalWorks += group.Count
+			target.ScheduledMs += group.DurationMs
+			if label, ok := labelByID[group.LabelID]; ok {
+				switch label.Key {
+				case labels_constant.LabelCompleted:
+					target.CompletedWorks += group.Count
+				case labels_constant.LabelOverDue:
+					target.OverDueWorks += group.Count
+				case labels_constant.LabelGiveUp:
+					target.GiveUpWorks += group.Count
+				}
+			}
+		}
+	}
+	s.accumulateLabelGroups(stats.Status, bucketByStart, summary, labelByID, func(b *personal_schedule.ProductivityBucket) *[]*personal_schedule.LabelCount { return &b.ByStatus })
+	s.accumulateLabelGroups(stats.Category, bucketByStart, summary, labelByID, func(b *personal_schedule.ProductivityBucket) *[]*personal_schedule.LabelCount { return &b.ByCategory })
+	s.accumulateLabelGroups(stats.Priority, bucketByStart, summary, labelByID, func(b *personal_schedule.ProductivityBucket) *[]*personal_schedule.LabelCount { return &b.ByPriority })
+	s.accumulateLabelGroups(stats.Difficulty, bucketByStart, summary, labelByID, func(b *personal_schedule.ProductivityBucket) *[]*personal_schedule.LabelCount { return &b.ByDifficulty })
+
+	for _, group := range stats.SubTasks {
+		bucket, ok := bucketByStart[group.Bucket.UnixMilli()]
+		if !ok {
+			continue
+		}
+		bucket.TotalSubTasks += group.Total
+		bucket.CompletedSubTasks += group.Completed
+		summary.TotalSubTasks += group.Total
+		summary.CompletedSubTasks += group.Completed
+	}
+
+	streak, err := s.completionStreak(ctx, req.UserId, rangeStart, rangeEnd, loc)
+	if err != nil {
+		s.logger.Error("Failed to compute completion streak", requestID, zap.Error(err))
+		return &personal_schedule.GetProductivityStatsResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	protoBuckets := make([]*personal_schedule.ProductivityBucket, 0, len(buckets))
+	for _, b := range buckets {
+		protoBuckets = append(protoBuckets, b.proto)
+	}
+
+	return &personal_schedule.GetProductivityStatsResponse{
+		Buckets:  protoBuckets,
+		Summary:  summary,
+		Streak:   streak,
+		TimeZone: loc.String(),
+	}, nil
+}
+
+// accumulateLabelGroups adds the label counts of the groups to their bucket and to the summary.
+func (s *analyticsService) accumulateLabelGroups(
+	groups []repos.AnalyticsLabelGroup,
+	bucketByStart map[int64]*personal_schedule.ProductivityBucket,
+	summary *personal_schedule.ProductivityBucket,
+	labelByID map[bson.ObjectID]*collection.Label,
+	field func(b *personal_schedule.ProductivityBucket) *[]*personal_schedule.LabelCount,
+) {
+	summaryCounts := make(map[bson.ObjectID]*personal_schedule.LabelCount)
+	for _, group := range groups {
+		bucket, ok := bucketByStart[group.Bucket.UnixMilli()]
+		if !ok {
+			continue
+		}
+		label, ok := labelByID[group.LabelID]
+		if !ok {
+			continue
+		}
+		counts := field(bucket)
+		*counts = append(*counts, &personal_schedule.LabelCount{
+			Label:      s.labelMapper.MapLabelToProto(label),
+			Count:      group.Count,
+			DurationMs: group.DurationMs,
+		})
+
+		total, ok := summaryCounts[group.LabelID]
+		if !ok {
+			total = &personal_schedule.LabelCount{Label: s.labelMapper.MapLabelToProto(label)}
+			summaryCounts[group.LabelID] = total
+			*field(summary) = append(*field(summary), total)
+		}
+		total.Count += group.Count
+		total.DurationMs += group.DurationMs
+	}
+
+	sortCounts := func(counts []*personal_schedule.LabelCount) {
+		sort.SliceStable(counts, func(i, j int) bool { return counts[i].Count > counts[j].Count })
+	}
+	for _, bucket := range bucketByStart {
+		sortCounts(*field(bucket))
+	}
+	sortCounts(*field(summary))
+}
+
+// completionStreak counts the consecutive local days with a completed work, the current streak
+// still holds when nothing has been completed yet on the last day of the range.
+func (s *analyticsService) completionStreak(ctx context.Context, userID string, from, to time.Time, loc *time.Location) (*personal_schedule.ProductivityStreak, error) {
+	completedLabel, err := s.labelRepo.GetLabelByKey(ctx, labels_constant.LabelCompleted)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().In(loc)
+	if to.After(now) {
+		to = now
+	}
+	streak := &personal_schedule.ProductivityStreak{}
+	if !to.After(from) {
+		return streak, nil
+	}
+
+	completionTimes, err := s.workRepo.GetCompletionTimes(ctx, userID, completedLabel.ID, from.UTC(), to.UTC())
+	if err != nil {
+		return nil, err
+	}
+	days := make(map[string]bool, len(completionTimes))
+	for _, completedAt := range completionTimes {
+		days[completedAt.In(loc).Format(time.DateOnly)] = true
+	}
+
+	var run int32
+	var runStart time.Time
+	lastDay := startOfDay(to.Add(-time.Nanosecond), loc)
+	for day := startOfDay(from, loc); !day.After(lastDay); day = day.AddDate(0, 0, 1) {
+		if !days[day.Format(time.DateOnly)] {
+			run = 0
+			continue
+		}
+		if run == 0 {
+			runStart = day
+		}
+		run++
+		if run > streak.Longest {
+			streak.Longest = run
+			start := runStart.UnixMilli()
+			end := day.AddDate(0, 0, 1).UnixMilli()
+			streak.LongestStart = &start
+			streak.LongestEnd = &end
+		}
+	}
+
+	streak.Current = run
+	if run == 0 && lastDay.Equal(startOfDay(now, loc)) {
+		// today is not over, the streak of the days before still counts
+		for day := lastDay.AddDate(0, 0, -1); !day.Before(startOfDay(from, loc)) && days[day.Format(time.DateOnly)]; day = day.AddDate(0, 0, -1) {
+			streak.Current++
+		}
+	}
+	return streak, nil
+}
+
+func bucketUnit(size personal_schedule.AnalyticsBucketSize) string {
+	switch size {
+	case personal_schedule.AnalyticsBucketSize_ANALYTICS_BUCKET_SIZE_WEEK:
+		return analytics_constant.BUCKET_UNIT_WEEK
+	case personal_schedule.AnalyticsBucketSize_ANALYTICS_BUCKET_SIZE_MONTH:
+		return analytics_constant.BUCKET_UNIT_MONTH
+	default:
+		return analytics_constant.BUCKET_UNIT_DAY
+	}
+}
+
+// buildBuckets cuts [from, to) in local buckets, the same way $dateTrunc does, weeks starting on weekStart.
+func buildBuckets(from, to time.Time, unit string, loc *time.Location, weekStart time.Weekday) []bucketRange {
+	start := startOfDay(from, loc)
+	switch unit {
+	case analytics_constant.BUCKET_UNIT_WEEK:
+		offset := (int(start.Weekday()) - int(weekStart) + 7) % 7
+		start = start.AddDate(0, 0, -offset)
+	case analytics_constant.BUCKET_UNIT_MONTH:
+		start = time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, loc)
+	}
+
+	var buckets []bucketRange
+	for start.Before(to) && len(buckets) <= analytics_constant.MAX_BUCKETS {
+		var end time.Time
+		switch unit {
+		case analytics_constant.BUCKET_UNIT_WEEK:
+			end = start.AddDate(0, 0, 7)
+		case analytics_constant.BUCKET_UNIT_MONTH:
+			end = start.AddDate(0, 1, 0)
+		default:
+			end = start.AddDate(0, 0, 1)
+		}
+		buckets = append(buckets, bucketRange{
+			start: start,
+			end:   end,
+			proto: &personal_schedule.ProductivityBucket{
+				Start: start.UnixMilli(),
+				End:   end.UnixMilli(),
+			},
+		})
+		start = end
+	}
+	return buckets
+}
+
+func startOfDay(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
@@ -0,0 +1,405 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"personal_schedule_service/internal/collection"
+	labels_constant "personal_schedule_service/internal/constant/labels"
+	workgeneration_constant "personal_schedule_service/internal/constant/work"
+	"personal_schedule_service/internal/grpc/models"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/grpc/validation"
+	app_error "personal_schedule_service/pkg/settings/error"
+	"personal_schedule_service/proto/common"
+	"personal_schedule_service/proto/personal_schedule"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.uber.org/zap"
+)
+
+// autoScheduleItem is a work waiting for a slot with the ranks it is ordered by.
+type autoScheduleItem struct {
+	work           collection.Work
+	durationMs     int64
+	priorityRank   int
+	difficultyRank int
+	difficultyKey  string
+}
+
+var autoSchedulePriorityRanks = map[string]int{
+	labels_constant.LabelPriorityImportantUrgent:       0,
+	labels_constant.LabelPriorityImportantNotUrgent:    1,
+	labels_constant.LabelPriorityNotImportantUrgent:    2,
+	labels_constant.LabelPriorityNotImportantNotUrgent: 3,
+}
+
+var autoScheduleDifficultyRanks = map[string]int{
+	labels_constant.LabelDifficultyHard:   0,
+	labels_constant.LabelDifficultyMedium: 1,
+	labels_constant.LabelDifficultyEasy:   2,
+}
+
+// AutoSchedule places the requested works, or every unscheduled work ending in the range, into the free working time.
+// Urgent and important works come first, then the nearest deadlines, then the hardest ones. Every placement is stored
+// as a DRAFT copy of the work, SaveDraftAsRealWork applies it to the work and DeleteAllDraftWorks discards it.
+func (s *workService) AutoSchedule(ctx context.Context, req *personal_schedule.AutoScheduleRequest) (*personal_schedule.AutoScheduleResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	loc, err := userLocation(ctx, s.userRepo, req.UserId, req.TimeZone)
+	if err != nil {
+		return &personal_schedule.AutoScheduleResponse{
+			Error: timeZoneError(ctx, err),
+		}, nil
+	}
+
+	from := time.UnixMilli(req.From).In(loc)
+	to := time.UnixMilli(req.To).In(loc)
+	if !to.After(from) {
+		return &personal_schedule.AutoScheduleResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.EndDateBeforeStart, fmt.Errorf("to must be after from")),
+		}, nil
+	}
+	if to.Sub(from) > workgeneration_constant.MAX_FREE_TIME_RANGE {
+		return &personal_schedule.AutoScheduleResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.RangeTooLong, fmt.Errorf("range must not exceed %s", workgeneration_constant.MAX_FREE_TIME_RANGE)),
+		}, nil
+	}
+	if req.DefaultDurationMs <= 0 || req.BufferMs < 0 {
+		return &personal_schedule.AutoScheduleResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.ZeroDuration, fmt.Errorf("default duration must be positive and buffer not negative")),
+		}, nil
+	}
+	for workID, durationMs := range req.DurationsMs {
+		if durationMs <= 0 {
+			return &personal_schedule.AutoScheduleResponse{
+				Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.ZeroDuration, fmt.Errorf("duration of work %s must be positive", workID)),
+			}, nil
+		}
+	}
+	if err := validation.ValidateWorkingHours(req.WorkingHours); err != nil {
+		return &personal_schedule.AutoScheduleResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidWorkingHours, err),
+		}, nil
+	}
+	if len(req.WorkIds) > workgeneration_constant.MAX_AUTO_SCHEDULE_WORKS {
+		return &personal_schedule.AutoScheduleResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.TooManyWorks, fmt.Errorf("at most %d works can be scheduled at once", workgeneration_constant.MAX_AUTO_SCHEDULE_WORKS)),
+		}, nil
+	}
+
+	user, err := userPreferences(ctx, s.userRepo, req.UserId)
+	if err != nil {
+		s.logger.Error("Failed to get preferences of user", requestID, zap.Error(err))
+		return &personal_schedule.AutoScheduleResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+
+	draftLabel, err := s.workRepo.GetLabelByKey(ctx, labels_constant.LabelDraft)
+	if err != nil {
+		s.logger.Error("Failed to get draft label", requestID, zap.Error(err))
+		return &personal_schedule.AutoScheduleResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+	labelKeys := make(map[bson.ObjectID]string)
+	for _, typeID := range []int32{labels_constant.LabelTypeStatus, labels_constant.LabelTypeDifficulty, labels_constant.LabelTypePriority} {
+		labels, err := s.workRepo.GetLabelsByTypeIDs(ctx, typeID)
+		if err != nil {
+			s.logger.Error("Failed to get labels", requestID, zap.Int32("type", typeID), zap.Error(err))
+			return &personal_schedule.AutoScheduleResponse{Error: utils.DatabaseError(ctx, err)}, nil
+		}
+		for _, label := range labels {
+			labelKeys[label.ID] = label.Key
+		}
+	}
+
+	works, unplaced, err := s.autoScheduleWorks(ctx, req, from, to, labelKeys)
+	if err != nil {
+		s.logger.Error("Failed to get works to schedule", requestID, zap.Error(err))
+		return &personal_schedule.AutoScheduleResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+	if len(works) == 0 {
+		return &personal_schedule.AutoScheduleResponse{Unplaced: unplaced}, nil
+	}
+
+	items := make([]autoScheduleItem, 0, len(works))
+	sourceIDs := make(map[bson.ObjectID]bool, len(works))
+	for _, work := range works {
+		durationMs := req.DefaultDurationMs
+		if d, ok := req.DurationsMs[work.ID.Hex()]; ok {
+			durationMs = d
+		} else if work.StartDate != nil && work.EndDate.After(*work.StartDate) {
+			durationMs = work.EndDate.Sub(*work.StartDate).Milliseconds()
+		}
+		difficultyKey := labelKeys[work.DifficultyID]
+		items = append(items, autoScheduleItem{
+			work:           work,
+			durationMs:     durationMs,
+			priorityRank:   rankOrLast(autoSchedulePriorityRanks, labelKeys[work.PriorityID]),
+			difficultyRank: rankOrLast(autoScheduleDifficultyRanks, difficultyKey),
+			difficultyKey:  difficultyKey,
+		})
+		sourceIDs[work.ID] = true
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].priorityRank != items[j].priorityRank {
+			return items[i].priorityRank < items[j].priorityRank
+		}
+		if !items[i].work.EndDate.Equal(items[j].work.EndDate) {
+			return items[i].work.EndDate.Before(items[j].work.EndDate)
+		}
+		return items[i].difficultyRank < items[j].difficultyRank
+	})
+
+	if err := s.expandRepeatedSeries(ctx, req.UserId, to.UTC()); err != nil {
+		s.logger.Error("Failed to expand repeated series", requestID, zap.Error(err))
+		return &personal_schedule.AutoScheduleResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+	existing, err := s.workRepo.GetWorksInRange(ctx, req.UserId, req.From-req.BufferMs, req.To+req.BufferMs, nil)
+	if err != nil {
+		s.logger.Error("Failed to get works in range", requestID, zap.Error(err))
+		return &personal_schedule.AutoScheduleResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+	// the occurrences beyond the horizon are busy as well, without being materialized
+	virtual, err := s.virtualOccurrences(ctx, req.UserId, time.UnixMilli(req.From-req.BufferMs).UTC(), time.UnixMilli(req.To+req.BufferMs).UTC())
+	if err != nil {
+		s.logger.Error("Failed to expand repeated series", requestID, zap.Error(err))
+		return &personal_schedule.AutoScheduleResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+	existing = append(existing, virtual...)
+	// the works being scheduled and their previous drafts give their time back
+	others := existing[:0:0]
+	for _, work := range existing {
+		if sourceIDs[work.ID] || (work.ScheduledFromID != nil && sourceIDs[*work.ScheduledFromID]) {
+			continue
+		}
+		others = append(others, work)
+	}
+	busy := s.scheduleHelper.BusyIntervals(others, req.BufferMs)
+	windows := s.scheduleHelper.WorkingWindows(from, to, preferredWorkingHours(req.WorkingHours, user))
+	capacity := autoScheduleCapacity{limitMs: dailyCapacityMs(user), loc: loc, loadMs: make(map[string]int64)}
+	for _, work := range others {
+		if work.StartDate != nil {
+			capacity.add(work.StartDate.UnixMilli(), work.EndDate.Sub(*work.StartDate).Milliseconds())
+		}
+	}
+
+	searchFrom := max(req.From, time.Now().UnixMilli())
+	now := time.Now().UTC()
+	var drafts []collection.Work
+	var scheduled []*personal_schedule.AutoScheduledWork
+	for _, item := range items {
+		deadline := req.To
+		if item.work.StartDate == nil {
+			// the end date of an unscheduled work is its deadline
+			deadline = min(deadline, item.work.EndDate.UnixMilli())
+		}
+		if deadline-searchFrom < item.durationMs {
+			unplaced = append(unplaced, &personal_schedule.UnplacedWork{
+				WorkId: item.work.ID.Hex(),
+				Reason: workgeneration_constant.AUTO_SCHEDULE_REASON_PAST_DEADLINE,
+			})
+			continue
+		}
+
+		slot, ok := s.placeAutoScheduleItem(busy, windows, item, searchFrom, deadline, capacity)
+		if !ok {
+			unplaced = append(unplaced, &personal_schedule.UnplacedWork{
+				WorkId: item.work.ID.Hex(),
+				Reason: workgeneration_constant.AUTO_SCHEDULE_REASON_NO_FREE_SLOT,
+			})
+			continue
+		}
+		capacity.add(slot.StartTime, item.durationMs)
+		busy = s.scheduleHelper.MergeIntervals(append(busy, models.TimeRange{
+			StartTime: slot.StartTime - req.BufferMs,
+			EndTime:   slot.EndTime + req.BufferMs,
+		}))
+
+		sourceID := item.work.ID
+		start := time.UnixMilli(slot.StartTime).UTC()
+		draft := item.work
+		draft.ID = bson.NewObjectID()
+		draft.StartDate = &start
+		draft.EndDate = time.UnixMilli(slot.EndTime).UTC()
+		draft.DraftID = &draftLabel.ID
+		draft.ScheduledFromID = &sourceID
+		draft.RepeatedID = nil
+		draft.RecurrenceID = nil
+		draft.CreatedAt = now
+		draft.LastModifiedAt = now
+		drafts = append(drafts, draft)
+
+		scheduled = append(scheduled, &personal_schedule.AutoScheduledWork{
+			WorkId:      sourceID.Hex(),
+			DraftWorkId: draft.ID.Hex(),
+			Start:       slot.StartTime,
+			End:         slot.EndTime,
+		})
+	}
+
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		ids := make([]bson.ObjectID, 0, len(sourceIDs))
+		for id := range sourceIDs {
+			ids = append(ids, id)
+		}
+		previous, err := s.workRepo.GetDraftsScheduledFrom(txCtx, req.UserId, ids)
+		if err != nil {
+			return err
+		}
+		previousIDs := make([]bson.ObjectID, 0, len(previous))
+		for _, draft := range previous {
+			previousIDs = append(previousIDs, draft.ID)
+		}
+		if err := s.workRepo.DeleteSubTasksByWorkIDs(txCtx, previousIDs); err != nil {
+			return err
+		}
+		if err := s.workRepo.DeleteWorksByIDs(txCtx, previousIDs); err != nil {
+			return err
+		}
+
+		docs := make([]interface{}, 0, len(drafts))
+		for _, draft := range drafts {
+			docs = append(docs, draft)
+		}
+		return s.workRepo.BulkInsertWorks(txCtx, docs)
+	})
+	if err != nil {
+		s.logger.Error("Failed to save auto scheduled drafts", requestID, zap.Error(err))
+		return &personal_schedule.AutoScheduleResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+
+	return &personal_schedule.AutoScheduleResponse{
+		Scheduled: scheduled,
+		Unplaced:  unplaced,
+	}, nil
+}
+
+// autoScheduleWorks returns the requested works, reporting the unknown and finished ones,
+// or the unscheduled works ending in the range when none is requested.
+func (s *workService) autoScheduleWorks(ctx context.Context, req *personal_schedule.AutoScheduleRequest, from, to time.Time, labelKeys map[bson.ObjectID]string) ([]collection.Work, []*personal_schedule.UnplacedWork, error) {
+	var excludedStatusIDs []bson.ObjectID
+	for id, key := range labelKeys {
+		if key == labels_constant.LabelCompleted || key == labels_constant.LabelGiveUp {
+			excludedStatusIDs = append(excludedStatusIDs, id)
+		}
+	}
+
+	if len(req.WorkIds) == 0 {
+		works, err := s.workRepo.GetUnscheduledWorks(ctx, req.UserId, from.UTC(), to.UTC(), excludedStatusIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(works) > workgeneration_constant.MAX_AUTO_SCHEDULE_WORKS {
+			works = works[:workgeneration_constant.MAX_AUTO_SCHEDULE_WORKS]
+		}
+		return works, nil, nil
+	}
+
+	var unplaced []*personal_schedule.UnplacedWork
+	ids := make([]bson.ObjectID, 0, len(req.WorkIds))
+	for _, hex := range req.WorkIds {
+		id, err := bson.ObjectIDFromHex(hex)
+		if err != nil {
+			unplaced = append(unplaced, &personal_schedule.UnplacedWork{WorkId: hex, Reason: workgeneration_constant.AUTO_SCHEDULE_REASON_NOT_FOUND})
+			continue
+		}
+		ids = append(ids, id)
+	}
+	found, err := s.workRepo.GetWorksByIDs(ctx, req.UserId, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[bson.ObjectID]collection.Work, len(found))
+	for _, work := range found {
+		byID[work.ID] = work
+	}
+
+	var works []collection.Work
+	seen := make(map[bson.ObjectID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		work, ok := byID[id]
+		if !ok {
+			unplaced = append(unplaced, &personal_schedule.UnplacedWork{WorkId: id.Hex(), Reason: workgeneration_constant.AUTO_SCHEDULE_REASON_NOT_FOUND})
+			continue
+		}
+		key := labelKeys[work.StatusID]
+		if work.DraftID != nil || key == labels_constant.LabelCompleted || key == labels_constant.LabelGiveUp {
+			unplaced = append(unplaced, &personal_schedule.UnplacedWork{WorkId: id.Hex(), Reason: workgeneration_constant.AUTO_SCHEDULE_REASON_NOT_ALLOWED})
+			continue
+		}
+		works = append(works, work)
+	}
+	return works, unplaced, nil
+}
+
+// placeAutoScheduleItem returns the earliest free slot of the item in [searchFrom, deadline) inside the working windows
+// and the daily capacity, preferring a morning start for hard works and an afternoon start for easy ones.
+func (s *workService) placeAutoScheduleItem(busy, windows []models.TimeRange, item autoScheduleItem, searchFrom, deadline int64, capacity autoScheduleCapacity) (models.TimeRange, bool) {
+	loc := capacity.loc
+	var fallback *models.TimeRange
+	for _, window := range windows {
+		start, end := max(window.StartTime, searchFrom), min(window.EndTime, deadline)
+		if end-start < item.durationMs {
+			continue
+		}
+		for _, gap := range s.scheduleHelper.FreeIntervals(busy, start, end) {
+			if gap.EndTime-gap.StartTime < item.durationMs || !capacity.fits(gap.StartTime, item.durationMs) {
+				continue
+			}
+			if fallback == nil {
+				fallback = &models.TimeRange{StartTime: gap.StartTime, EndTime: gap.StartTime + item.durationMs}
+			}
+
+			gapStart := time.UnixMilli(gap.StartTime).In(loc)
+			noon := time.Date(gapStart.Year(), gapStart.Month(), gapStart.Day(), workgeneration_constant.AUTO_SCHEDULE_MORNING_END_HOUR, 0, 0, 0, loc).UnixMilli()
+			switch item.difficultyKey {
+			case labels_constant.LabelDifficultyHard:
+				if gap.StartTime < noon {
+					return models.TimeRange{StartTime: gap.StartTime, EndTime: gap.StartTime + item.durationMs}, true
+				}
+			case labels_constant.LabelDifficultyEasy:
+				slotStart := max(gap.StartTime, noon)
+				if slotStart+item.durationMs <= gap.EndTime {
+					return models.TimeRange{StartTime: slotStart, EndTime: slotStart + item.durationMs}, true
+				}
+			default:
+				return *fallback, true
+			}
+		}
+	}
+	if fallback == nil {
+		return models.TimeRange{}, false
+	}
+	return *fallback, true
+}
+
+// autoScheduleCapacity tracks the scheduled time of every local day against the daily capacity of the user.
+type autoScheduleCapacity struct {
+	limitMs int64
+	loc     *time.Location
+	loadMs  map[string]int64
+}
+
+func (c autoScheduleCapacity) day(startMs int64) string {
+	return time.UnixMilli(startMs).In(c.loc).Format(time.DateOnly)
+}
+
+func (c autoScheduleCapacity) add(startMs, durationMs int64) {
+	c.loadMs[c.day(startMs)] += durationMs
+}
+
+// fits reports whether a slot starting at startMs keeps its day within the capacity, a zero limit has no capacity.
+func (c autoScheduleCapacity) fits(startMs, durationMs int64) bool {
+	return c.limitMs <= 0 || c.loadMs[c.day(startMs)]+durationMs <= c.limitMs
+}
+
+func rankOrLast(ranks map[string]int, key string) int {
+	if rank, ok := ranks[key]; ok {
+		return rank
+	}
+	return len(ranks)
+}
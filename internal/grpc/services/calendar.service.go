@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"personal_schedule_service/internal/collection"
+	calendar_constant "personal_schedule_service/internal/constant/calendar"
+	"personal_schedule_service/internal/grpc/helper"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/i18n"
+	"personal_schedule_service/internal/ical"
+	"personal_schedule_service/internal/repos"
+	app_error "personal_schedule_service/pkg/settings/error"
+	"personal_schedule_service/proto/common"
+	"personal_schedule_service/proto/personal_schedule"
+	"time"
+
+	"github.com/thanvuc/go-core-lib/log"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.uber.org/zap"
+)
+
+type calendarService struct {
+	logger         log.Logger
+	workRepo       repos.WorkRepo
+	labelRepo      repos.LabelRepo
+	userRepo       repos.UserRepo
+	calendarHelper helper.CalendarHelper
+}
+
+// ExportCalendar renders the works of the user overlapping the range as an RFC 5545 calendar.
+func (s *calendarService) ExportCalendar(ctx context.Context, req *personal_schedule.ExportCalendarRequest) (*personal_schedule.ExportCalendarResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	loc, err := userLocation(ctx, s.userRepo, req.UserId, req.TimeZone)
+	if err != nil {
+		return &personal_schedule.ExportCalendarResponse{
+			Error: timeZoneError(ctx, err),
+		}, nil
+	}
+
+	from := time.UnixMilli(req.From).UTC()
+	to := time.UnixMilli(req.To).UTC()
+	if !to.After(from) {
+		return &personal_schedule.ExportCalendarResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.EndDateBeforeStart, fmt.Errorf("to must be after from")),
+		}, nil
+	}
+	if to.Sub(from) > calendar_constant.MAX_EXPORT_RANGE {
+		return &personal_schedule.ExportCalendarResponse{
+			Error: utils.InternalServerError(ctx, fmt.Errorf("range must not exceed %s", calendar_constant.MAX_EXPORT_RANGE)),
+		}, nil
+	}
+
+	cal, err := s.buildCalendar(ctx, req.UserId, from, to, loc)
+	if err != nil {
+		s.logger.Error("Failed to build calendar", requestID, zap.Error(err))
+		return &personal_schedule.ExportCalendarResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	resp := &personal_schedule.ExportCalendarResponse{
+		Calendar: cal.String(),
+		FileName: calendar_constant.EXPORT_FILE_NAME,
+	}
+	for _, component := range cal.Components {
+		switch component.Name {
+		case "VEVENT":
+			resp.EventCount++
+		case "VTODO":
+			resp.TodoCount++
+		}
+	}
+	return resp, nil
+}
+
+// buildCalendar loads the works of the range with their series, sub tasks and labels and renders them.
+func (s *calendarService) buildCalendar(ctx context.Context, userID string, from, to time.Time, loc *time.Location) (*ical.Component, error) {
+	works, err := s.workRepo.GetWorksForCalendar(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// every series whose rule overlaps the range is exported, with or without a materialized occurrence in it
+	series, err := s.workRepo.GetRepeatedSeriesForCalendar(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[bson.ObjectID]bool, len(series))
+	for _, s := range series {
+		seen[s.ID] = true
+	}
+
+	// an occurrence moved into the range keeps its series
+	var repeatedIDs []bson.ObjectID
+	for _, work := range works {
+		if work.RepeatedID != nil && work.StartDate != nil && !seen[*work.RepeatedID] {
+			seen[*work.RepeatedID] = true
+			repeatedIDs = append(repeatedIDs, *work.RepeatedID)
+		}
+	}
+	others, err := s.workRepo.GetRepeatedSeriesByIDs(ctx, repeatedIDs)
+	if err != nil {
+		return nil, err
+	}
+	series = append(series, others...)
+	exported := make(map[bson.ObjectID]bool, len(series))
+	for _, s := range series {
+		exported[s.ID] = true
+	}
+
+	// the sub tasks of the occurrences are exported once from the template of their series
+	workIDs := make([]bson.ObjectID, 0, len(works))
+	for _, work := range works {
+		if work.RepeatedID == nil || work.StartDate == nil || !exported[*work.RepeatedID] {
+			workIDs = append(workIDs, work.ID)
+		}
+	}
+	subTasks, err := s.workRepo.GetSubTasksByWorkIDs(ctx, workIDs)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := s.labelRepo.GetLabels(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	locale := i18n.LocaleFromContext(ctx)
+	labelsByID := make(map[bson.ObjectID]collection.Label, len(labels))
+	for _, label := range labels {
+		label.Name = i18n.LocalizeLabel(locale, label.Key, i18n.LabelText{Name: label.Name}).Name
+		labelsByID[label.ID] = label
+	}
+
+	return s.calendarHelper.BuildCalendar(works, series, subTasks, labelsByID, loc), nil
+}
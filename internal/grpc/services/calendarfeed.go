@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"personal_schedule_service/global"
+	"personal_schedule_service/internal/collection"
+	calendar_constant "personal_schedule_service/internal/constant/calendar"
+	"personal_schedule_service/internal/grpc/models"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/i18n"
+	"personal_schedule_service/internal/repos"
+	app_error "personal_schedule_service/pkg/settings/error"
+	"personal_schedule_service/proto/common"
+	"personal_schedule_service/proto/personal_schedule"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// CreateCalendarFeedToken issues the first feed token of the user, an existing token has to be rotated instead.
+func (s *calendarService) CreateCalendarFeedToken(ctx context.Context, req *personal_schedule.CreateCalendarFeedTokenRequest) (*personal_schedule.CreateCalendarFeedTokenResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	token, feedToken, err := newFeedToken()
+	if err != nil {
+		s.logger.Error("Failed to generate feed token", requestID, zap.Error(err))
+		return &personal_schedule.CreateCalendarFeedTokenResponse{
+			Error: utils.InternalServerError(ctx, err),
+		}, nil
+	}
+
+	created, err := s.userRepo.CreateFeedToken(ctx, req.UserId, feedToken)
+	if err != nil {
+		s.logger.Error("Failed to create feed token", requestID, zap.Error(err))
+		return &personal_schedule.CreateCalendarFeedTokenResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	if !created {
+		return &personal_schedule.CreateCalendarFeedTokenResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_PERMISSION_DENIED, app_error.FeedTokenExists, fmt.Errorf("calendar feed token already exists, rotate it instead")),
+		}, nil
+	}
+
+	return &personal_schedule.CreateCalendarFeedTokenResponse{
+		Token:     token,
+		Url:       feedURL(token),
+		CreatedAt: feedToken.CreatedAt.UnixMilli(),
+	}, nil
+}
+
+// RotateCalendarFeedToken replaces the feed token of the user, the subscriptions of the previous URL stop working.
+func (s *calendarService) RotateCalendarFeedToken(ctx context.Context, req *personal_schedule.RotateCalendarFeedTokenRequest) (*personal_schedule.RotateCalendarFeedTokenResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	token, feedToken, err := newFeedToken()
+	if err != nil {
+		s.logger.Error("Failed to generate feed token", requestID, zap.Error(err))
+		return &personal_schedule.RotateCalendarFeedTokenResponse{
+			Error: utils.InternalServerError(ctx, err),
+		}, nil
+	}
+
+	replaced, err := s.userRepo.ReplaceFeedToken(ctx, req.UserId, feedToken)
+	if err != nil {
+		s.logger.Error("Failed to rotate feed token", requestID, zap.Error(err))
+		return &personal_schedule.RotateCalendarFeedTokenResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	if !replaced {
+		return &personal_schedule.RotateCalendarFeedTokenResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.FeedTokenNotFound, fmt.Errorf("calendar feed token not found")),
+		}, nil
+	}
+
+	return &personal_schedule.RotateCalendarFeedTokenResponse{
+		Token:     token,
+		Url:       feedURL(token),
+		CreatedAt: feedToken.CreatedAt.UnixMilli(),
+	}, nil
+}
+
+func (s *calendarService) RevokeCalendarFeedToken(ctx context.Context, req *personal_schedule.RevokeCalendarFeedTokenRequest) (*personal_schedule.RevokeCalendarFeedTokenResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	revoked, err := s.userRepo.DeleteFeedToken(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error("Failed to revoke feed token", requestID, zap.Error(err))
+		return &personal_schedule.RevokeCalendarFeedTokenResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	if !revoked {
+		return &personal_schedule.RevokeCalendarFeedTokenResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.FeedTokenNotFound, fmt.Errorf("calendar feed token not found")),
+		}, nil
+	}
+
+	return &personal_schedule.RevokeCalendarFeedTokenResponse{
+		IsSuccess: true,
+		Message:   i18n.T(ctx, i18n.MsgFeedTokenRevoked),
+	}, nil
+}
+
+// GetCalendarFeed resolves the feed of a token, it returns nil when the token is unknown or revoked.
+// The ETag and LastModified of the feed are computed without rendering it.
+func (s *calendarService) GetCalendarFeed(ctx context.Context, token string) (*models.CalendarFeed, error) {
+	user, err := s.userRepo.GetUserByFeedTokenHash(ctx, hashFeedToken(token))
+	if err != nil || user == nil {
+		return nil, err
+	}
+
+	loc := utils.LoadLocationOrDefault(user.TimeZone)
+	pastDays := global.Config.Feed.PastDays
+	if pastDays <= 0 {
+		pastDays = calendar_constant.DEFAULT_FEED_PAST_DAYS
+	}
+	futureDays := global.Config.Feed.FutureDays
+	if futureDays <= 0 {
+		futureDays = calendar_constant.DEFAULT_FEED_FUTURE_DAYS
+	}
+	today := localMidnight(loc)
+	from := today.AddDate(0, 0, -pastDays).UTC()
+	to := today.AddDate(0, 0, futureDays).UTC()
+
+	version, err := s.workRepo.GetCalendarVersion(ctx, user.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	// the label names and the series rules and templates are rendered too
+	labelsVersion, err := s.labelRepo.GetLabelsVersion(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	seriesVersion, err := s.workRepo.GetRepeatedSeriesVersion(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the time zone of the user changes the rendering, so its modification counts too
+	lastModified := user.LastModifiedAt
+	for _, v := range []*repos.CalendarVersion{version, labelsVersion, seriesVersion} {
+		if v.LastModifiedAt != nil && v.LastModifiedAt.After(lastModified) {
+			lastModified = *v.LastModifiedAt
+		}
+	}
+	lastModified = lastModified.UTC().Truncate(time.Second)
+
+	// the counts catch the deleted works, labels and series, which do not move the last modification
+	locale := i18n.UserLocale(user.Locale)
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s|%d|%d|%d|%d|%d|%s|%s", user.ID, from.UnixMilli(), lastModified.UnixMilli(),
+		version.Count, labelsVersion.Count, seriesVersion.Count, loc.String(), locale))
+
+	return &models.CalendarFeed{
+		UserID:       user.ID,
+		Location:     loc,
+		Locale:       locale,
+		From:         from,
+		To:           to,
+		ETag:         `"` + hex.EncodeToString(sum[:16]) + `"`,
+		LastModified: lastModified,
+	}, nil
+}
+
+// RenderCalendarFeed renders the feed returned by GetCalendarFeed as an RFC 5545 calendar, in the locale of its user.
+func (s *calendarService) RenderCalendarFeed(ctx context.Context, feed *models.CalendarFeed) (string, error) {
+	cal, err := s.buildCalendar(i18n.WithLocale(ctx, feed.Locale), feed.UserID, feed.From, feed.To, feed.Location)
+	if err != nil {
+		return "", err
+	}
+	return cal.String(), nil
+}
+
+// newFeedToken returns a random token and what is stored of it.
+func newFeedToken() (string, collection.UserFeedToken, error) {
+	raw := make([]byte, calendar_constant.FEED_TOKEN_BYTES)
+	if _, err := rand.Read(raw); err != nil {
+		return "", collection.UserFeedToken{}, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	return token, collection.UserFeedToken{
+		TokenHash: hashFeedToken(token),
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func feedURL(token string) string {
+	return strings.TrimSuffix(global.Config.Feed.PublicURL, "/") + calendar_constant.FEED_PATH + token + calendar_constant.FEED_EXTENSION
+}
@@ -0,0 +1,476 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"personal_schedule_service/internal/collection"
+	calendar_constant "personal_schedule_service/internal/constant/calendar"
+	labels_constant "personal_schedule_service/internal/constant/labels"
+	workgeneration_constant "personal_schedule_service/internal/constant/work"
+	"personal_schedule_service/internal/grpc/models"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/ical"
+	"personal_schedule_service/internal/recurrence"
+	app_error "personal_schedule_service/pkg/settings/error"
+	"personal_schedule_service/proto/common"
+	"personal_schedule_service/proto/personal_schedule"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.uber.org/zap"
+)
+
+// importLabels are the labels given to the imported works: the defaults of the user, DRAFT, and the type of the work.
+type importLabels struct {
+	draftID      bson.ObjectID
+	repeatedID   bson.ObjectID
+	inDayID      bson.ObjectID
+	statusID     bson.ObjectID
+	difficultyID bson.ObjectID
+	priorityID   bson.ObjectID
+	categoryID   bson.ObjectID
+	// category labels by lower case key and name
+	categories map[string]bson.ObjectID
+}
+
+// ImportCalendar creates DRAFT works from the VEVENTs of an iCalendar. A recurring event becomes a draft series
+// materialized from today on, its modified occurrences replace the matching instances and its cancelled ones are
+// excluded. Events whose UID was imported or exported before are skipped, events overlapping existing works or an
+// event imported before them are not created. The drafts are reviewed with SaveDraftAsRealWork or DeleteAllDraftWorks.
+func (s *workService) ImportCalendar(ctx context.Context, req *personal_schedule.ImportCalendarRequest) (*personal_schedule.ImportCalendarResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	loc, err := userLocation(ctx, s.userRepo, req.UserId, req.TimeZone)
+	if err != nil {
+		return &personal_schedule.ImportCalendarResponse{
+			Error: timeZoneError(ctx, err),
+		}, nil
+	}
+
+	if len(req.Calendar) > calendar_constant.MAX_IMPORT_SIZE {
+		return &personal_schedule.ImportCalendarResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidCalendar, fmt.Errorf("calendar must not exceed %d bytes", calendar_constant.MAX_IMPORT_SIZE)),
+		}, nil
+	}
+	cal, err := ical.Decode(strings.NewReader(req.Calendar))
+	if err == nil && cal.Name != "VCALENDAR" {
+		err = fmt.Errorf("expected a VCALENDAR, got %s", cal.Name)
+	}
+	if err != nil {
+		return &personal_schedule.ImportCalendarResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidCalendar, err),
+		}, nil
+	}
+	events := s.calendarHelper.ParseEvents(cal, loc)
+	if len(events) > calendar_constant.MAX_IMPORT_EVENTS {
+		return &personal_schedule.ImportCalendarResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidCalendar, fmt.Errorf("at most %d events can be imported at once", calendar_constant.MAX_IMPORT_EVENTS)),
+		}, nil
+	}
+
+	user, err := userPreferences(ctx, s.userRepo, req.UserId)
+	if err != nil {
+		s.logger.Error("Failed to get preferences of user", requestID, zap.Error(err))
+		return &personal_schedule.ImportCalendarResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+	labels, err := s.loadImportLabels(ctx, user)
+	if err != nil {
+		s.logger.Error("Failed to get labels", requestID, zap.Error(err))
+		return &personal_schedule.ImportCalendarResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+	known, err := s.knownCalendarUIDs(ctx, req.UserId, events)
+	if err != nil {
+		s.logger.Error("Failed to get imported UIDs", requestID, zap.Error(err))
+		return &personal_schedule.ImportCalendarResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+
+	results := make([]*personal_schedule.CalendarImportResult, len(events))
+	masters := make(map[string]int)
+	overrides := make(map[string][]int)
+	for i, event := range events {
+		result := &personal_schedule.CalendarImportResult{Uid: event.UID, Summary: event.Summary}
+		if event.RecurrenceID != nil {
+			result.RecurrenceId = utils.ToIint64Pointer(event.RecurrenceID.UnixMilli())
+		}
+		results[i] = result
+
+		switch {
+		case event.Invalid != "":
+			setImportStatus(result, personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_INVALID, event.Invalid)
+		case utils.RemoveAccent(event.Summary) == "":
+			setImportStatus(result, personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_INVALID, calendar_constant.IMPORT_REASON_EMPTY_SUMMARY)
+		case known[event.UID]:
+			setImportStatus(result, personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_DUPLICATE, "")
+		case event.RecurrenceID != nil:
+			overrides[event.UID] = append(overrides[event.UID], i)
+		case masters[event.UID] > 0:
+			setImportStatus(result, personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_DUPLICATE, calendar_constant.IMPORT_REASON_DUPLICATE_EVENT)
+		case event.Cancelled:
+			// keeps the cancelled event as the master of its overrides, which are dropped with it
+			masters[event.UID] = i + 1
+			setImportStatus(result, personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_INVALID, calendar_constant.IMPORT_REASON_CANCELLED)
+		default:
+			masters[event.UID] = i + 1
+		}
+	}
+	for uid, indexes := range overrides {
+		master := masters[uid] - 1
+		if master >= 0 && events[master].RRule != "" && !events[master].Cancelled {
+			continue
+		}
+		reason := calendar_constant.IMPORT_REASON_UNKNOWN_MASTER
+		if master >= 0 && events[master].Cancelled {
+			reason = calendar_constant.IMPORT_REASON_CANCELLED
+		}
+		for _, i := range indexes {
+			setImportStatus(results[i], personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_INVALID, reason)
+		}
+	}
+
+	for i, event := range events {
+		if results[i].Status != personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_CREATED || event.RecurrenceID != nil {
+			continue
+		}
+		if event.RRule == "" {
+			err = s.importCalendarEvent(ctx, req.UserId, event, labels, results[i])
+		} else {
+			err = s.importCalendarSeries(ctx, req.UserId, events, i, overrides[event.UID], labels, results)
+		}
+		if err != nil {
+			s.logger.Error("Failed to import calendar event", requestID, zap.String("uid", event.UID), zap.Error(err))
+			return &personal_schedule.ImportCalendarResponse{Error: utils.DatabaseError(ctx, err)}, nil
+		}
+	}
+
+	resp := &personal_schedule.ImportCalendarResponse{Results: results}
+	for _, result := range results {
+		switch result.Status {
+		case personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_CREATED:
+			resp.CreatedCount++
+		case personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_DUPLICATE:
+			resp.DuplicateCount++
+		case personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_CONFLICT:
+			resp.ConflictCount++
+		case personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_INVALID:
+			resp.InvalidCount++
+		}
+	}
+	return resp, nil
+}
+
+// importCalendarEvent creates the DRAFT work of a single event unless it overlaps other works.
+func (s *workService) importCalendarEvent(ctx context.Context, userID string, event models.CalendarEvent, labels importLabels, result *personal_schedule.CalendarImportResult) error {
+	candidate := &personal_schedule.CandidateInterval{
+		RefId: &event.UID,
+		Start: event.Start.UnixMilli(),
+		End:   event.End.UnixMilli(),
+	}
+	conflicts, err := s.buildScheduleConflicts(ctx, userID, []*personal_schedule.CandidateInterval{candidate}, workgeneration_constant.DEFAULT_CONFLICT_SUGGESTIONS)
+	if err != nil {
+		return err
+	}
+	if conflicts = conflictsOnly(conflicts); len(conflicts) > 0 {
+		setImportStatus(result, personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_CONFLICT, calendar_constant.IMPORT_REASON_OVERLAPS_WORKS)
+		result.Conflicts = conflicts
+		return nil
+	}
+
+	work := newImportedWork(userID, event, labels, labels.inDayID)
+	work.ExternalUID = &event.UID
+	if _, err := s.workRepo.CreateWork(ctx, &work); err != nil {
+		return err
+	}
+	result.DraftWorkId = utils.ToStringPointer(work.ID.Hex())
+	return nil
+}
+
+// importCalendarSeries creates the draft series of a recurring event with its modified occurrences, unless one of
+// its instances from today on overlaps other works. Cancelled occurrences are excluded from the series.
+func (s *workService) importCalendarSeries(
+	ctx context.Context,
+	userID string,
+	events []models.CalendarEvent,
+	masterIndex int,
+	overrideIndexes []int,
+	labels importLabels,
+	results []*personal_schedule.CalendarImportResult,
+) error {
+	event, master := events[masterIndex], results[masterIndex]
+	fail := func(status personal_schedule.CalendarImportStatus, reason string) {
+		setImportStatus(master, status, reason)
+		for _, i := range overrideIndexes {
+			setImportStatus(results[i], status, calendar_constant.IMPORT_REASON_SERIES_NOT_CREATED)
+		}
+	}
+
+	exDates := append([]time.Time(nil), event.ExDates...)
+	var modified []int
+	for _, i := range overrideIndexes {
+		if events[i].Cancelled {
+			exDates = append(exDates, *events[i].RecurrenceID)
+			continue
+		}
+		modified = append(modified, i)
+	}
+	rec, err := recurrence.New(event.RRule, event.Start, exDates, event.Location)
+	if err != nil {
+		fail(personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_INVALID, err.Error())
+		return nil
+	}
+	if _, err := s.recurrenceHelper.Occurrences(rec); err != nil {
+		fail(personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_INVALID, calendar_constant.IMPORT_REASON_NO_OCCURRENCE)
+		return nil
+	}
+
+	// past occurrences are not materialized, as a review of years of history is of no use
+	from := localMidnight(rec.Location)
+	if rec.DTStart.After(from) {
+		from = rec.DTStart
+	}
+	horizon := s.recurrenceHelper.HorizonEnd(rec.Location)
+	duration := event.End.Sub(event.Start)
+
+	movedAt := make(map[int64]bool, len(modified))
+	var candidates []*personal_schedule.CandidateInterval
+	for _, i := range modified {
+		if events[i].RecurrenceID.Before(from) {
+			continue
+		}
+		movedAt[events[i].RecurrenceID.Unix()] = true
+		candidates = append(candidates, &personal_schedule.CandidateInterval{
+			RefId: &events[i].UID,
+			Start: events[i].Start.UnixMilli(),
+			End:   events[i].End.UnixMilli(),
+		})
+	}
+	for _, occ := range rec.Between(from, horizon) {
+		if movedAt[occ.Unix()] {
+			continue
+		}
+		candidates = append(candidates, &personal_schedule.CandidateInterval{
+			RefId: &event.UID,
+			Start: occ.UnixMilli(),
+			End:   occ.Add(duration).UnixMilli(),
+		})
+	}
+	conflicts, err := s.buildScheduleConflicts(ctx, userID, candidates, workgeneration_constant.DEFAULT_CONFLICT_SUGGESTIONS)
+	if err != nil {
+		return err
+	}
+	if conflicts = conflictsOnly(conflicts); len(conflicts) > 0 {
+		fail(personal_schedule.CalendarImportStatus_CALENDAR_IMPORT_STATUS_CONFLICT, calendar_constant.IMPORT_REASON_OVERLAPS_WORKS)
+		master.Conflicts = conflicts
+		return nil
+	}
+
+	now := time.Now().UTC()
+	template := newImportedWork(userID, event, labels, labels.repeatedID)
+	series := &collection.RepeatedSeries{
+		ID:                bson.NewObjectID(),
+		UserID:            userID,
+		RRule:             rec.Rule.String(),
+		DTStart:           rec.DTStart.UTC(),
+		DurationMs:        duration.Milliseconds(),
+		ExDates:           exDates,
+		TimeZone:          rec.Location.String(),
+		Template:          newSeriesTemplate(&template, nil),
+		MaterializedUntil: from.UTC(),
+		EndsAt:            s.recurrenceHelper.EndsAt(rec),
+		ExternalUID:       &event.UID,
+		CreatedAt:         now,
+		LastModifiedAt:    now,
+	}
+
+	var moved []collection.Work
+	for _, i := range modified {
+		if events[i].RecurrenceID.Before(from) {
+			continue
+		}
+		recurrenceID := *events[i].RecurrenceID
+		work := newImportedWork(userID, events[i], labels, labels.repeatedID)
+		work.RepeatedID = &series.ID
+		work.RecurrenceID = &recurrenceID
+		moved = append(moved, work)
+	}
+
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		if err := s.workRepo.CreateRepeatedSeries(txCtx, series); err != nil {
+			return err
+		}
+		// the modified occurrences are stored first, the materialization skips the instances which exist
+		if _, err := s.workRepo.UpsertRepeatedWorks(txCtx, moved); err != nil {
+			return err
+		}
+		_, err := s.materializeSeries(txCtx, series, rec, from, horizon)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	master.RepeatedId = utils.ToStringPointer(series.ID.Hex())
+	movedByRecurrence := make(map[int64]bson.ObjectID, len(moved))
+	for _, work := range moved {
+		movedByRecurrence[work.RecurrenceID.Unix()] = work.ID
+	}
+	for _, i := range overrideIndexes {
+		result := results[i]
+		result.RepeatedId = master.RepeatedId
+		if id, ok := movedByRecurrence[events[i].RecurrenceID.Unix()]; ok {
+			result.DraftWorkId = utils.ToStringPointer(id.Hex())
+		} else if events[i].Cancelled {
+			result.Reason = utils.ToStringPointer(calendar_constant.IMPORT_REASON_CANCELLED)
+		} else {
+			result.Reason = utils.ToStringPointer(calendar_constant.IMPORT_REASON_PAST_OCCURRENCE)
+		}
+	}
+	return nil
+}
+
+// newImportedWork maps an event to a DRAFT work with the default labels of the user and the category of the event.
+func newImportedWork(userID string, event models.CalendarEvent, labels importLabels, typeID bson.ObjectID) collection.Work {
+	now := time.Now().UTC()
+	start := event.Start
+	work := collection.Work{
+		ID:             bson.NewObjectID(),
+		Name:           event.Summary,
+		NameNormalized: utils.RemoveAccent(event.Summary),
+		StartDate:      &start,
+		EndDate:        event.End,
+		StatusID:       labels.statusID,
+		DifficultyID:   labels.difficultyID,
+		PriorityID:     labels.priorityID,
+		TypeID:         typeID,
+		CategoryID:     labels.categoryID,
+		DraftID:        &labels.draftID,
+		UserID:         userID,
+		CreatedAt:      now,
+		LastModifiedAt: now,
+	}
+	if event.Description != "" {
+		work.DetailedDescription = utils.ToStringPointer(event.Description)
+	}
+	for _, category := range event.Categories {
+		if id, ok := labels.categories[strings.ToLower(category)]; ok {
+			work.CategoryID = id
+			break
+		}
+	}
+	return work
+}
+
+// loadImportLabels resolves the labels of the imported works among the system and custom labels of the user,
+// the defaults of the user are used while they exist.
+func (s *workService) loadImportLabels(ctx context.Context, user *collection.User) (importLabels, error) {
+	byType := make(map[int32][]collection.Label)
+	for _, typeID := range []int32{
+		labels_constant.LabelTypeWorkType,
+		labels_constant.LabelTypeStatus,
+		labels_constant.LabelTypeDifficulty,
+		labels_constant.LabelTypePriority,
+		labels_constant.LabelTypeCategory,
+		labels_constant.LabelTypeDraft,
+	} {
+		found, err := s.labelRepo.GetLabelsByTypeIDs(ctx, typeID, user.ID)
+		if err != nil {
+			return importLabels{}, err
+		}
+		byType[typeID] = found
+	}
+
+	defaults := &collection.UserDefaultLabels{}
+	if user.DefaultLabels != nil {
+		defaults = user.DefaultLabels
+	}
+	labels := importLabels{
+		draftID:      pickLabel(byType[labels_constant.LabelTypeDraft], nil, labels_constant.LabelDraft),
+		repeatedID:   pickLabel(byType[labels_constant.LabelTypeWorkType], nil, labels_constant.LabelRepeated),
+		inDayID:      pickLabel(byType[labels_constant.LabelTypeWorkType], nil, labels_constant.LabelInDay),
+		statusID:     pickLabel(byType[labels_constant.LabelTypeStatus], defaults.StatusID, labels_constant.LabelPending),
+		difficultyID: pickLabel(byType[labels_constant.LabelTypeDifficulty], defaults.DifficultyID, labels_constant.LabelDifficultyEasy),
+		priorityID:   pickLabel(byType[labels_constant.LabelTypePriority], defaults.PriorityID, labels_constant.LabelPriorityImportantNotUrgent),
+		categoryID:   pickLabel(byType[labels_constant.LabelTypeCategory], defaults.CategoryID, labels_constant.LabelCategoryPersonal),
+		categories:   make(map[string]bson.ObjectID),
+	}
+	if labels.draftID.IsZero() {
+		return importLabels{}, fmt.Errorf("label %s not found", labels_constant.LabelDraft)
+	}
+	for _, category := range byType[labels_constant.LabelTypeCategory] {
+		// custom labels have no key
+		if category.Key != "" {
+			labels.categories[strings.ToLower(category.Key)] = category.ID
+		}
+		labels.categories[strings.ToLower(category.Name)] = category.ID
+	}
+	return labels, nil
+}
+
+// pickLabel returns the preferred label when it is one of the labels, else the label of the fallback key.
+func pickLabel(labels []collection.Label, preferred *bson.ObjectID, fallbackKey string) bson.ObjectID {
+	var fallback bson.ObjectID
+	for _, label := range labels {
+		if preferred != nil && label.ID == *preferred {
+			return label.ID
+		}
+		if label.Key == fallbackKey {
+			fallback = label.ID
+		}
+	}
+	return fallback
+}
+
+// knownCalendarUIDs returns the UIDs of the events which were imported before, or which were exported by this
+// service from a work or a series of the user.
+func (s *workService) knownCalendarUIDs(ctx context.Context, userID string, events []models.CalendarEvent) (map[string]bool, error) {
+	uids := make([]string, 0, len(events))
+	exported := make(map[bson.ObjectID]string)
+	for _, event := range events {
+		if event.UID == "" {
+			continue
+		}
+		uids = append(uids, event.UID)
+		if hex, ok := strings.CutSuffix(event.UID, "@"+calendar_constant.UID_DOMAIN); ok {
+			if id, err := bson.ObjectIDFromHex(hex); err == nil {
+				exported[id] = event.UID
+			}
+		}
+	}
+
+	known, err := s.workRepo.GetImportedUIDs(ctx, userID, uids)
+	if err != nil {
+		return nil, err
+	}
+	if len(exported) == 0 {
+		return known, nil
+	}
+
+	ids := make([]bson.ObjectID, 0, len(exported))
+	for id := range exported {
+		ids = append(ids, id)
+	}
+	works, err := s.workRepo.GetWorksByIDs(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, work := range works {
+		known[exported[work.ID]] = true
+	}
+	series, err := s.workRepo.GetRepeatedSeriesByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range series {
+		if s.UserID == userID {
+			known[exported[s.ID]] = true
+		}
+	}
+	return known, nil
+}
+
+func setImportStatus(result *personal_schedule.CalendarImportResult, status personal_schedule.CalendarImportStatus, reason string) {
+	result.Status = status
+	if reason != "" {
+		result.Reason = &reason
+	}
+}
@@ -0,0 +1,219 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	workgeneration_constant "personal_schedule_service/internal/constant/work"
+	"personal_schedule_service/internal/grpc/models"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/grpc/validation"
+	app_error "personal_schedule_service/pkg/settings/error"
+	"personal_schedule_service/proto/common"
+	"personal_schedule_service/proto/personal_schedule"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func (s *workService) CheckScheduleConflicts(ctx context.Context, req *personal_schedule.CheckScheduleConflictsRequest) (*personal_schedule.CheckScheduleConflictsResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+	if len(req.Candidates) > workgeneration_constant.MAX_CONFLICT_CHECK_CANDIDATES {
+		err := fmt.Errorf("at most %d candidates can be checked at once", workgeneration_constant.MAX_CONFLICT_CHECK_CANDIDATES)
+		return &personal_schedule.CheckScheduleConflictsResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.TooManyWorks, err),
+		}, nil
+	}
+	for _, candidate := range req.Candidates {
+		if candidate.End <= candidate.Start {
+			return &personal_schedule.CheckScheduleConflictsResponse{
+				Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.EndDateBeforeStart, fmt.Errorf("candidate end must be after its start")),
+			}, nil
+		}
+	}
+
+	maxSuggestions := workgeneration_constant.DEFAULT_CONFLICT_SUGGESTIONS
+	if req.MaxSuggestions != nil {
+		maxSuggestions = min(max(int(*req.MaxSuggestions), 0), workgeneration_constant.MAX_CONFLICT_SUGGESTIONS)
+	}
+
+	results, err := s.buildScheduleConflicts(ctx, req.UserId, req.Candidates, maxSuggestions)
+	if err != nil {
+		s.logger.Error("Failed to check schedule conflicts", requestID, zap.Error(err))
+		return &personal_schedule.CheckScheduleConflictsResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	hasConflicts := false
+	for _, result := range results {
+		if len(result.Conflicts) > 0 {
+			hasConflicts = true
+			break
+		}
+	}
+	return &personal_schedule.CheckScheduleConflictsResponse{
+		Results:      results,
+		HasConflicts: hasConflicts,
+	}, nil
+}
+
+// buildScheduleConflicts reports, for every candidate, the works it overlaps and the nearest free slots of the same duration.
+// The works of all the candidates and of their suggestion windows, the series occurrences beyond the horizon included,
+// are read at once.
+func (s *workService) buildScheduleConflicts(ctx context.Context, userID string, candidates []*personal_schedule.CandidateInterval, maxSuggestions int) ([]*personal_schedule.ScheduleConflict, error) {
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	window := workgeneration_constant.CONFLICT_SUGGESTION_WINDOW.Milliseconds()
+	from, to := candidates[0].Start, candidates[0].End
+	for _, candidate := range candidates[1:] {
+		from = min(from, candidate.Start)
+		to = max(to, candidate.End)
+	}
+	works, err := s.workRepo.GetWorksInRange(ctx, userID, from-window, to+window, nil)
+	if err != nil {
+		return nil, err
+	}
+	// the occurrences beyond the horizon are busy as well, without being materialized
+	virtual, err := s.virtualOccurrences(ctx, userID, time.UnixMilli(from-window).UTC(), time.UnixMilli(to+window).UTC())
+	if err != nil {
+		return nil, err
+	}
+	works = append(works, virtual...)
+
+	results := make([]*personal_schedule.ScheduleConflict, 0, len(candidates))
+	for _, candidate := range candidates {
+		others := works
+		if candidate.ExcludeWorkId != nil && *candidate.ExcludeWorkId != "" {
+			// the drafts placing the excluded work are excluded with it
+			others = others[:0:0]
+			for _, work := range works {
+				if work.ID.Hex() == *candidate.ExcludeWorkId {
+					continue
+				}
+				if work.ScheduledFromID != nil && work.ScheduledFromID.Hex() == *candidate.ExcludeWorkId {
+					continue
+				}
+				others = append(others, work)
+			}
+		}
+
+		result := &personal_schedule.ScheduleConflict{Candidate: candidate}
+		conflicting := s.scheduleHelper.OverlappingWorks(others, candidate.Start, candidate.End)
+		if len(conflicting) > 0 {
+			result.Conflicts = s.workMapper.MapConflictingWorksToProto(conflicting)
+			busy := s.scheduleHelper.BusyIntervals(others, 0)
+			suggestions := s.scheduleHelper.NearestFreeSlots(busy, models.TimeRange{StartTime: candidate.Start, EndTime: candidate.End}, window, maxSuggestions)
+			result.Suggestions = s.workMapper.MapTimeRangesToProto(suggestions)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// conflictsOnly keeps the results which have conflicts.
+func conflictsOnly(results []*personal_schedule.ScheduleConflict) []*personal_schedule.ScheduleConflict {
+	var conflicts []*personal_schedule.ScheduleConflict
+	for _, result := range results {
+		if len(result.Conflicts) > 0 {
+			conflicts = append(conflicts, result)
+		}
+	}
+	return conflicts
+}
+
+// FindFreeTime returns the free intervals of at least the requested duration inside the working hours,
+// keeping the buffer around every work, repeated series are expanded over the range first.
+func (s *workService) FindFreeTime(ctx context.Context, req *personal_schedule.FindFreeTimeRequest) (*personal_schedule.FindFreeTimeResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	loc, err := userLocation(ctx, s.userRepo, req.UserId, req.TimeZone)
+	if err != nil {
+		return &personal_schedule.FindFreeTimeResponse{
+			Error: timeZoneError(ctx, err),
+		}, nil
+	}
+
+	from := time.UnixMilli(req.From).In(loc)
+	to := time.UnixMilli(req.To).In(loc)
+	if !to.After(from) {
+		return &personal_schedule.FindFreeTimeResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.EndDateBeforeStart, fmt.Errorf("to must be after from")),
+		}, nil
+	}
+	if to.Sub(from) > workgeneration_constant.MAX_FREE_TIME_RANGE {
+		return &personal_schedule.FindFreeTimeResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.RangeTooLong, fmt.Errorf("range must not exceed %s", workgeneration_constant.MAX_FREE_TIME_RANGE)),
+		}, nil
+	}
+	if req.DurationMs <= 0 || req.BufferMs < 0 {
+		return &personal_schedule.FindFreeTimeResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.ZeroDuration, fmt.Errorf("duration must be positive and buffer not negative")),
+		}, nil
+	}
+	if err := validation.ValidateWorkingHours(req.WorkingHours); err != nil {
+		return &personal_schedule.FindFreeTimeResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidWorkingHours, err),
+		}, nil
+	}
+
+	user, err := userPreferences(ctx, s.userRepo, req.UserId)
+	if err != nil {
+		s.logger.Error("Failed to get preferences of user", requestID, zap.Error(err))
+		return &personal_schedule.FindFreeTimeResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+	workingHours := preferredWorkingHours(req.WorkingHours, user)
+
+	limit := workgeneration_constant.DEFAULT_FREE_TIME_LIMIT
+	if req.Limit != nil && *req.Limit > 0 {
+		limit = min(int(*req.Limit), workgeneration_constant.MAX_FREE_TIME_LIMIT)
+	}
+
+	if err := s.expandRepeatedSeries(ctx, req.UserId, to.UTC()); err != nil {
+		s.logger.Error("Failed to expand repeated series", requestID, zap.Error(err))
+		return &personal_schedule.FindFreeTimeResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+	works, err := s.workRepo.GetWorksInRange(ctx, req.UserId, req.From-req.BufferMs, req.To+req.BufferMs, nil)
+	if err != nil {
+		s.logger.Error("Failed to get works in range", requestID, zap.Error(err))
+		return &personal_schedule.FindFreeTimeResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+	// the occurrences beyond the horizon are busy as well, without being materialized
+	virtual, err := s.virtualOccurrences(ctx, req.UserId, time.UnixMilli(req.From-req.BufferMs).UTC(), time.UnixMilli(req.To+req.BufferMs).UTC())
+	if err != nil {
+		s.logger.Error("Failed to expand repeated series", requestID, zap.Error(err))
+		return &personal_schedule.FindFreeTimeResponse{Error: utils.DatabaseError(ctx, err)}, nil
+	}
+	works = append(works, virtual...)
+
+	busy := s.scheduleHelper.BusyIntervals(works, req.BufferMs)
+	var free []models.TimeRange
+	for _, window := range s.scheduleHelper.WorkingWindows(from, to, workingHours) {
+		for _, gap := range s.scheduleHelper.FreeIntervals(busy, window.StartTime, window.EndTime) {
+			if gap.EndTime-gap.StartTime >= req.DurationMs {
+				free = append(free, gap)
+			}
+		}
+	}
+
+	if req.Order == personal_schedule.FreeTimeOrder_FREE_TIME_ORDER_LONGEST {
+		sort.SliceStable(free, func(i, j int) bool {
+			return free[i].EndTime-free[i].StartTime > free[j].EndTime-free[j].StartTime
+		})
+	}
+	if len(free) > limit {
+		free = free[:limit]
+	}
+
+	intervals := make([]*personal_schedule.FreeInterval, 0, len(free))
+	for _, interval := range free {
+		intervals = append(intervals, &personal_schedule.FreeInterval{
+			Start:      interval.StartTime,
+			End:        interval.EndTime,
+			DurationMs: interval.EndTime - interval.StartTime,
+		})
+	}
+	return &personal_schedule.FindFreeTimeResponse{Intervals: intervals}, nil
+}
@@ -0,0 +1,324 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"personal_schedule_service/internal/collection"
+	labels_constant "personal_schedule_service/internal/constant/labels"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/grpc/validation"
+	"personal_schedule_service/internal/i18n"
+	app_error "personal_schedule_service/pkg/settings/error"
+	"personal_schedule_service/proto/common"
+	"personal_schedule_service/proto/personal_schedule"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+)
+
+var labelColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// CreateLabel adds a custom label of the user after their labels of the same type.
+func (s *labelService) CreateLabel(ctx context.Context, req *personal_schedule.CreateLabelRequest) (*personal_schedule.CreateLabelResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	name := strings.TrimSpace(req.Name)
+	labelType := int(req.LabelType)
+	if err := s.validateCustomLabel(ctx, req.UserId, labelType, name, req.Color, nil); err != nil {
+		s.logger.Warn("Invalid custom label", requestID, zap.Error(err))
+		return &personal_schedule.CreateLabelResponse{
+			Error: customLabelError(ctx, err),
+		}, nil
+	}
+
+	customLabels, err := s.labelRepo.GetCustomLabels(ctx, req.UserId, labelType)
+	if err != nil {
+		s.logger.Error("Failed to get custom labels", requestID, zap.Error(err))
+		return &personal_schedule.CreateLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	if len(customLabels) >= labels_constant.MaxCustomLabelsPerType {
+		return &personal_schedule.CreateLabelResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.CustomLabelLimitExceeded, fmt.Errorf("at most %d custom labels per type", labels_constant.MaxCustomLabelsPerType)),
+		}, nil
+	}
+	var order int32
+	if len(customLabels) > 0 {
+		order = customLabels[len(customLabels)-1].Order + 1
+	}
+
+	color := labels_constant.DefaultCustomLabelColor
+	if req.Color != nil {
+		color = strings.ToUpper(*req.Color)
+	}
+	now := time.Now()
+	label := collection.Label{
+		Name:           name,
+		Meaning:        req.Meaning,
+		Note:           req.Note,
+		Color:          &color,
+		LabelType:      labelType,
+		UserID:         &req.UserId,
+		Order:          order,
+		CreatedAt:      now,
+		LastModifiedAt: now,
+	}
+	label.ID, err = s.labelRepo.CreateLabel(ctx, &label)
+	if err != nil {
+		s.logger.Error("Failed to create custom label", requestID, zap.Error(err))
+		return &personal_schedule.CreateLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	return &personal_schedule.CreateLabelResponse{
+		Label: s.labelMapper.MapLabelToLabelProto(label),
+	}, nil
+}
+
+func (s *labelService) UpdateLabel(ctx context.Context, req *personal_schedule.UpdateLabelRequest) (*personal_schedule.UpdateLabelResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	label, err := s.getCustomLabel(ctx, req.UserId, req.Id)
+	if err != nil {
+		return &personal_schedule.UpdateLabelResponse{
+			Error: customLabelError(ctx, err),
+		}, nil
+	}
+
+	if req.Name != nil {
+		label.Name = strings.TrimSpace(*req.Name)
+	}
+	if err := s.validateCustomLabel(ctx, req.UserId, label.LabelType, label.Name, req.Color, &label.ID); err != nil {
+		s.logger.Warn("Invalid custom label", requestID, zap.Error(err))
+		return &personal_schedule.UpdateLabelResponse{
+			Error: customLabelError(ctx, err),
+		}, nil
+	}
+	if req.Color != nil {
+		color := strings.ToUpper(*req.Color)
+		label.Color = &color
+	}
+	if req.Meaning != nil {
+		label.Meaning = req.Meaning
+	}
+	if req.Note != nil {
+		label.Note = req.Note
+	}
+
+	if err := s.labelRepo.UpdateLabel(ctx, label); err != nil {
+		s.logger.Error("Failed to update custom label", requestID, zap.Error(err))
+		return &personal_schedule.UpdateLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	return &personal_schedule.UpdateLabelResponse{
+		Label: s.labelMapper.MapLabelToLabelProto(*label),
+	}, nil
+}
+
+// DeleteLabel deletes a custom label of the user, the works, goals, series and defaults using it are moved to the replacement label first.
+func (s *labelService) DeleteLabel(ctx context.Context, req *personal_schedule.DeleteLabelRequest) (*personal_schedule.DeleteLabelResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	label, err := s.getCustomLabel(ctx, req.UserId, req.Id)
+	if err != nil {
+		return &personal_schedule.DeleteLabelResponse{
+			Error: customLabelError(ctx, err),
+		}, nil
+	}
+	field := labels_constant.LabelTypeFields[label.LabelType]
+
+	usage, err := s.labelRepo.CountLabelUsage(ctx, req.UserId, field, label.ID)
+	if err != nil {
+		s.logger.Error("Failed to count label usage", requestID, zap.Error(err))
+		return &personal_schedule.DeleteLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	var replacementID *bson.ObjectID
+	if req.ReplacementLabelId != nil && *req.ReplacementLabelId != "" {
+		replacement, err := s.getReplacementLabel(ctx, req.UserId, *req.ReplacementLabelId, label)
+		if err != nil {
+			return &personal_schedule.DeleteLabelResponse{
+				Error: customLabelError(ctx, err),
+			}, nil
+		}
+		replacementID = &replacement.ID
+	} else if usage.Total() > 0 {
+		return &personal_schedule.DeleteLabelResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.LabelInUse, fmt.Errorf("label is used by %d works, %d goals, %d series and %d defaults, a replacement label is required", usage.Works, usage.Goals, usage.SeriesTemplates, usage.DefaultLabels)),
+		}, nil
+	}
+
+	// the works and goals are moved in batches first, deleting the label again resumes an interrupted reassignment
+	var reassignedWorks, reassignedGoals int64
+	if replacementID != nil {
+		reassignedWorks, reassignedGoals, err = s.reassignLabelUsage(ctx, req.UserId, field, label.ID, *replacementID, req.UserId)
+		if err != nil {
+			s.logger.Error("Failed to reassign custom label", requestID, zap.Error(err))
+			return &personal_schedule.DeleteLabelResponse{
+				Error: utils.DatabaseError(ctx, err),
+			}, nil
+		}
+	}
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		if replacementID != nil {
+			if err := s.labelRepo.ReassignLabel(txCtx, req.UserId, field, label.ID, *replacementID); err != nil {
+				return err
+			}
+		}
+		return s.labelRepo.DeleteLabel(txCtx, req.UserId, label.ID)
+	})
+	if err != nil {
+		s.logger.Error("Failed to delete custom label", requestID, zap.Error(err))
+		return &personal_schedule.DeleteLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	return &personal_schedule.DeleteLabelResponse{
+		IsSuccess:       true,
+		Message:         i18n.T(ctx, i18n.MsgLabelDeleted),
+		ReassignedWorks: reassignedWorks,
+		ReassignedGoals: reassignedGoals,
+	}, nil
+}
+
+// ReorderLabels orders the custom labels of a type, the request has to list every one of them.
+func (s *labelService) ReorderLabels(ctx context.Context, req *personal_schedule.ReorderLabelsRequest) (*personal_schedule.ReorderLabelsResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+	labelType := int(req.LabelType)
+
+	customLabels, err := s.labelRepo.GetCustomLabels(ctx, req.UserId, labelType)
+	if err != nil {
+		s.logger.Error("Failed to get custom labels", requestID, zap.Error(err))
+		return &personal_schedule.ReorderLabelsResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	labelIDs := make([]bson.ObjectID, 0, len(req.LabelIds))
+	for _, id := range req.LabelIds {
+		labelID, err := bson.ObjectIDFromHex(id)
+		if err != nil || slices.Contains(labelIDs, labelID) {
+			return &personal_schedule.ReorderLabelsResponse{
+				Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, fmt.Errorf("invalid or repeated label id %q", id)),
+			}, nil
+		}
+		labelIDs = append(labelIDs, labelID)
+	}
+	if len(labelIDs) != len(customLabels) || slices.ContainsFunc(customLabels, func(l collection.Label) bool {
+		return !slices.Contains(labelIDs, l.ID)
+	}) {
+		return &personal_schedule.ReorderLabelsResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, fmt.Errorf("label ids must be every custom label of type %d", labelType)),
+		}, nil
+	}
+
+	if err := s.labelRepo.ReorderLabels(ctx, req.UserId, labelIDs); err != nil {
+		s.logger.Error("Failed to reorder custom labels", requestID, zap.Error(err))
+		return &personal_schedule.ReorderLabelsResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	customLabels, err = s.labelRepo.GetCustomLabels(ctx, req.UserId, labelType)
+	if err != nil {
+		s.logger.Error("Failed to get custom labels", requestID, zap.Error(err))
+		return &personal_schedule.ReorderLabelsResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	return &personal_schedule.ReorderLabelsResponse{
+		Labels: s.labelMapper.MapLabelsToLabelsProto(customLabels),
+	}, nil
+}
+
+// getCustomLabel returns the label when it is a custom label of the user, the system labels can not be changed.
+func (s *labelService) getCustomLabel(ctx context.Context, userID string, id string) (*collection.Label, error) {
+	labelID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.LabelNotFoundCode, "invalid label id")
+	}
+	label, err := s.labelRepo.GetLabelByID(ctx, labelID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.LabelNotFoundCode, fmt.Sprintf("label %s not found", id))
+		}
+		return nil, err
+	}
+	if label.UserID == nil {
+		return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_PERMISSION_DENIED, app_error.InvalidLabel, "system labels can not be changed")
+	}
+	if *label.UserID != userID {
+		return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.LabelNotFoundCode, fmt.Sprintf("label %s not found", id))
+	}
+	return label, nil
+}
+
+// getReplacementLabel returns the label replacing a deleted one, a label of the same type visible to the user.
+func (s *labelService) getReplacementLabel(ctx context.Context, userID string, id string, deleted *collection.Label) (*collection.Label, error) {
+	labelID, err := bson.ObjectIDFromHex(id)
+	if err != nil || labelID == deleted.ID {
+		return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, "invalid replacement label id")
+	}
+	label, err := s.labelRepo.GetLabelByID(ctx, labelID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.LabelNotFoundCode, fmt.Sprintf("replacement label %s not found", id))
+		}
+		return nil, err
+	}
+	if label.UserID != nil && *label.UserID != userID {
+		return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.LabelNotFoundCode, fmt.Sprintf("replacement label %s not found", id))
+	}
+	// a deprecated system label is being moved off itself, its works would be moved again
+	if label.DeprecatedAt != nil {
+		return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, "replacement label must not be deprecated")
+	}
+	if label.LabelType != deleted.LabelType {
+		return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, "replacement label must be of the same type")
+	}
+	return label, nil
+}
+
+func (s *labelService) validateCustomLabel(ctx context.Context, userID string, labelType int, name string, color *string, excludeLabelID *bson.ObjectID) error {
+	if !slices.Contains(labels_constant.CustomLabelTypes, labelType) {
+		return validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, fmt.Sprintf("labels can not be added to type %d", labelType))
+	}
+	if name == "" || utf8.RuneCountInString(name) > labels_constant.MaxLabelNameLength {
+		return validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, fmt.Sprintf("label name must have 1 to %d characters", labels_constant.MaxLabelNameLength))
+	}
+	if color != nil && !labelColorPattern.MatchString(*color) {
+		return validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, fmt.Sprintf("invalid color %q, expected #RRGGBB", *color))
+	}
+
+	exists, err := s.labelRepo.CheckLabelNameExistence(ctx, userID, labelType, name, excludeLabelID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.LabelNameExists, fmt.Sprintf("a label named %q already exists", name))
+	}
+	return nil
+}
+
+// customLabelError returns the validation errors as they are, the others are database errors.
+func customLabelError(ctx context.Context, err error) *common.Error {
+	var ve *validation.ValidationError
+	if errors.As(err, &ve) {
+		return utils.CustomError(ctx, ve.Category, ve.Code, err)
+	}
+	return utils.DatabaseError(ctx, err)
+}
@@ -5,6 +5,7 @@ import (
 	"personal_schedule_service/global"
 	"personal_schedule_service/internal/grpc/helper"
 	"personal_schedule_service/internal/grpc/mapper"
+	"personal_schedule_service/internal/grpc/models"
 	"personal_schedule_service/internal/grpc/validation"
 	"personal_schedule_service/internal/repos"
 	"personal_schedule_service/proto/common"
@@ -14,9 +15,27 @@ import (
 type (
 	LabelService interface {
 		SeedLabels(ctx context.Context) error
-		GetLabelPerTypes(ctx context.Context, req *common.EmptyRequest) (*personal_schedule.GetLabelPerTypesResponse, error)
-		GetLabelsByTypeIDs(ctx context.Context, req *common.IDRequest) (*personal_schedule.GetLabelsByTypeIDsResponse, error)
+		GetLabelPerTypes(ctx context.Context, req *personal_schedule.GetLabelPerTypesRequest) (*personal_schedule.GetLabelPerTypesResponse, error)
+		GetLabelsByTypeIDs(ctx context.Context, req *personal_schedule.GetLabelsByTypeIDsRequest) (*personal_schedule.GetLabelsByTypeIDsResponse, error)
 		GetDefaultLabel(ctx context.Context, req *common.EmptyRequest) (*personal_schedule.GetDefaultLabelResponse, error)
+		GetUserDefaultLabel(ctx context.Context, req *personal_schedule.GetDefaultLabelRequest) (*personal_schedule.GetDefaultLabelResponse, error)
+		CreateLabel(ctx context.Context, req *personal_schedule.CreateLabelRequest) (*personal_schedule.CreateLabelResponse, error)
+		UpdateLabel(ctx context.Context, req *personal_schedule.UpdateLabelRequest) (*personal_schedule.UpdateLabelResponse, error)
+		DeleteLabel(ctx context.Context, req *personal_schedule.DeleteLabelRequest) (*personal_schedule.DeleteLabelResponse, error)
+		ReorderLabels(ctx context.Context, req *personal_schedule.ReorderLabelsRequest) (*personal_schedule.ReorderLabelsResponse, error)
+	}
+
+	LabelAdminService interface {
+		ListSystemLabels(ctx context.Context, req *personal_schedule.ListSystemLabelsRequest) (*personal_schedule.ListSystemLabelsResponse, error)
+		CreateSystemLabel(ctx context.Context, req *personal_schedule.CreateSystemLabelRequest) (*personal_schedule.CreateSystemLabelResponse, error)
+		UpdateSystemLabel(ctx context.Context, req *personal_schedule.UpdateSystemLabelRequest) (*personal_schedule.UpdateSystemLabelResponse, error)
+		DeprecateSystemLabel(ctx context.Context, req *personal_schedule.DeprecateSystemLabelRequest) (*personal_schedule.DeprecateSystemLabelResponse, error)
+		DeleteSystemLabel(ctx context.Context, req *personal_schedule.DeleteSystemLabelRequest) (*personal_schedule.DeleteSystemLabelResponse, error)
+	}
+
+	UserPreferenceService interface {
+		GetUserPreference(ctx context.Context, req *personal_schedule.GetUserPreferenceRequest) (*personal_schedule.GetUserPreferenceResponse, error)
+		UpdateUserPreference(ctx context.Context, req *personal_schedule.UpdateUserPreferenceRequest) (*personal_schedule.UpdateUserPreferenceResponse, error)
 	}
 
 	GoalService interface {
@@ -28,6 +47,29 @@ type (
 		UpdateGoalLabel(ctx context.Context, req *personal_schedule.UpdateGoalLabelRequest) (*personal_schedule.UpdateGoalLabelResponse, error)
 	}
 
+	AnalyticsService interface {
+		GetProductivityStats(ctx context.Context, req *personal_schedule.GetProductivityStatsRequest) (*personal_schedule.GetProductivityStatsResponse, error)
+	}
+
+	SearchService interface {
+		Search(ctx context.Context, req *personal_schedule.SearchRequest) (*personal_schedule.SearchResponse, error)
+	}
+
+	TagService interface {
+		ListTags(ctx context.Context, req *personal_schedule.ListTagsRequest) (*personal_schedule.ListTagsResponse, error)
+		RenameTag(ctx context.Context, req *personal_schedule.RenameTagRequest) (*personal_schedule.RenameTagResponse, error)
+		MergeTags(ctx context.Context, req *personal_schedule.MergeTagsRequest) (*personal_schedule.MergeTagsResponse, error)
+	}
+
+	CalendarService interface {
+		ExportCalendar(ctx context.Context, req *personal_schedule.ExportCalendarRequest) (*personal_schedule.ExportCalendarResponse, error)
+		CreateCalendarFeedToken(ctx context.Context, req *personal_schedule.CreateCalendarFeedTokenRequest) (*personal_schedule.CreateCalendarFeedTokenResponse, error)
+		RotateCalendarFeedToken(ctx context.Context, req *personal_schedule.RotateCalendarFeedTokenRequest) (*personal_schedule.RotateCalendarFeedTokenResponse, error)
+		RevokeCalendarFeedToken(ctx context.Context, req *personal_schedule.RevokeCalendarFeedTokenRequest) (*personal_schedule.RevokeCalendarFeedTokenResponse, error)
+		GetCalendarFeed(ctx context.Context, token string) (*models.CalendarFeed, error)
+		RenderCalendarFeed(ctx context.Context, feed *models.CalendarFeed) (string, error)
+	}
+
 	WorkService interface {
 		UpsertWork(ctx context.Context, req *personal_schedule.UpsertWorkRequest) (*personal_schedule.UpsertWorkResponse, error)
 		GetWorks(ctx context.Context, req *personal_schedule.GetWorksRequest) (*personal_schedule.GetWorksResponse, error)
@@ -39,18 +81,64 @@ type (
 		DeleteAllDraftWorks(ctx context.Context, req *personal_schedule.DeleteAllDraftWorksRequest) (*personal_schedule.DeleteAllDraftWorksResponse, error)
 		GenerateWorksFromAI(ctx context.Context, req *personal_schedule.GenerateWorksByAIRequest) (*common.EmptyResponse, error)
 		DeleteExpiredDraftWorks(ctx context.Context) error
+		MaterializeRepeatedWorks(ctx context.Context) error
+		TransitionOverdueWorks(ctx context.Context) error
+		GetWorkHistory(ctx context.Context, req *personal_schedule.GetWorkHistoryRequest) (*personal_schedule.GetWorkHistoryResponse, error)
+		CheckScheduleConflicts(ctx context.Context, req *personal_schedule.CheckScheduleConflictsRequest) (*personal_schedule.CheckScheduleConflictsResponse, error)
+		FindFreeTime(ctx context.Context, req *personal_schedule.FindFreeTimeRequest) (*personal_schedule.FindFreeTimeResponse, error)
+		AutoSchedule(ctx context.Context, req *personal_schedule.AutoScheduleRequest) (*personal_schedule.AutoScheduleResponse, error)
+		ImportCalendar(ctx context.Context, req *personal_schedule.ImportCalendarRequest) (*personal_schedule.ImportCalendarResponse, error)
+		BulkUpdateWorks(ctx context.Context, req *personal_schedule.BulkUpdateWorksRequest) (*personal_schedule.BulkUpdateWorksResponse, error)
+		BulkDeleteWorks(ctx context.Context, req *personal_schedule.BulkDeleteWorksRequest) (*personal_schedule.BulkDeleteWorksResponse, error)
 	}
 )
 
 func NewLabelService(
 	labelRepo repos.LabelRepo,
 	labelMapper mapper.LabelMapper,
+	userRepo repos.UserRepo,
+	workRepo repos.WorkRepo,
+	outboxRepo repos.OutboxRepo,
 ) LabelService {
 	return &labelService{
-		labelHelper: helper.NewLabelHelper(),
-		logger:      global.Logger,
-		labelRepo:   labelRepo,
-		labelMapper: labelMapper,
+		labelHelper:    helper.NewLabelHelper(),
+		logger:         global.Logger,
+		labelRepo:      labelRepo,
+		labelMapper:    labelMapper,
+		userRepo:       userRepo,
+		workRepo:       workRepo,
+		outboxRepo:     outboxRepo,
+		mongoConnector: global.MongoDbConntector,
+	}
+}
+
+func NewLabelAdminService(
+	labelRepo repos.LabelRepo,
+	labelMapper mapper.LabelMapper,
+	workRepo repos.WorkRepo,
+	outboxRepo repos.OutboxRepo,
+) LabelAdminService {
+	return &labelService{
+		labelHelper:    helper.NewLabelHelper(),
+		logger:         global.Logger,
+		labelRepo:      labelRepo,
+		labelMapper:    labelMapper,
+		workRepo:       workRepo,
+		outboxRepo:     outboxRepo,
+		mongoConnector: global.MongoDbConntector,
+	}
+}
+
+func NewUserPreferenceService(
+	userRepo repos.UserRepo,
+	userPreferenceMapper mapper.UserPreferenceMapper,
+	validator validation.UserPreferenceValidator,
+) UserPreferenceService {
+	return &userPreferenceService{
+		logger:               global.Logger,
+		userRepo:             userRepo,
+		userPreferenceMapper: userPreferenceMapper,
+		validator:            validator,
 	}
 }
 
@@ -58,10 +146,12 @@ func NewGoalService(
 	goalRepo repos.GoalRepo,
 	goalMapper mapper.GoalMapper,
 	validator validation.GoalValidator,
+	outboxRepo repos.OutboxRepo,
 ) GoalService {
 	return &goalService{
 		logger:         global.Logger,
 		goalRepo:       goalRepo,
+		outboxRepo:     outboxRepo,
 		goalMapper:     goalMapper,
 		mongoConnector: global.MongoDbConntector,
 		validator:      validator,
@@ -72,13 +162,74 @@ func NewWorkService(
 	workRepo repos.WorkRepo,
 	workMapper mapper.WorkMapper,
 	validator validation.WorkValidator,
+	outboxRepo repos.OutboxRepo,
+	userRepo repos.UserRepo,
+	goalRepo repos.GoalRepo,
+	labelRepo repos.LabelRepo,
 ) WorkService {
 	return &workService{
 		logger:            global.Logger,
 		workRepo:          workRepo,
+		outboxRepo:        outboxRepo,
+		userRepo:          userRepo,
+		goalRepo:          goalRepo,
+		labelRepo:         labelRepo,
 		workMapper:        workMapper,
 		mongoConnector:    global.MongoDbConntector,
 		validator:         validator,
 		eventbusConnector: global.EventBusConnector,
+		recurrenceHelper:  helper.NewRecurrenceHelper(),
+		scheduleHelper:    helper.NewScheduleHelper(),
+		calendarHelper:    helper.NewCalendarHelper(),
+	}
+}
+
+func NewAnalyticsService(
+	analyticsRepo repos.AnalyticsRepo,
+	workRepo repos.WorkRepo,
+	labelRepo repos.LabelRepo,
+	labelMapper mapper.LabelMapper,
+	userRepo repos.UserRepo,
+) AnalyticsService {
+	return &analyticsService{
+		logger:        global.Logger,
+		analyticsRepo: analyticsRepo,
+		workRepo:      workRepo,
+		labelRepo:     labelRepo,
+		userRepo:      userRepo,
+		labelMapper:   labelMapper,
+	}
+}
+
+func NewCalendarService(
+	workRepo repos.WorkRepo,
+	labelRepo repos.LabelRepo,
+	userRepo repos.UserRepo,
+) CalendarService {
+	return &calendarService{
+		logger:         global.Logger,
+		workRepo:       workRepo,
+		labelRepo:      labelRepo,
+		userRepo:       userRepo,
+		calendarHelper: helper.NewCalendarHelper(),
+	}
+}
+
+func NewSearchService(
+	searchRepo repos.SearchRepo,
+) SearchService {
+	return &searchService{
+		logger:     global.Logger,
+		searchRepo: searchRepo,
+	}
+}
+
+func NewTagService(
+	tagRepo repos.TagRepo,
+) TagService {
+	return &tagService{
+		logger:         global.Logger,
+		tagRepo:        tagRepo,
+		mongoConnector: global.MongoDbConntector,
 	}
 }
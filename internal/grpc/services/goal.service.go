@@ -2,15 +2,20 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	// "fmt"
 	"personal_schedule_service/internal/collection"
 	labels_constant "personal_schedule_service/internal/constant/labels"
+	lifecycle_constant "personal_schedule_service/internal/constant/lifecycle"
 	"personal_schedule_service/internal/grpc/mapper"
 	"personal_schedule_service/internal/grpc/utils"
 	"personal_schedule_service/internal/grpc/validation"
+	"personal_schedule_service/internal/i18n"
 	"personal_schedule_service/internal/repos"
+	app_error "personal_schedule_service/pkg/settings/error"
+	"personal_schedule_service/proto/common"
 	"personal_schedule_service/proto/personal_schedule"
 	"time"
 
@@ -21,9 +26,13 @@ import (
 	"go.uber.org/zap"
 )
 
+// errGoalHasLinkedWorks aborts the deletion of a goal in refuse mode.
+var errGoalHasLinkedWorks = errors.New("goal has linked works")
+
 type goalService struct {
 	logger         log.Logger
 	goalRepo       repos.GoalRepo
+	outboxRepo     repos.OutboxRepo
 	goalMapper     mapper.GoalMapper
 	validator      validation.GoalValidator
 	mongoConnector *mongolib.MongoConnector
@@ -89,10 +98,9 @@ func (s *goalService) UpsertGoal(ctx context.Context, req *personal_schedule.Ups
 	goalDB, tasksDB, err := s.goalMapper.MapUpsertProtoToModels(req)
 	if err != nil {
 		s.logger.Warn("Failed to map UpsertGoal proto", "", zap.Error(err))
-		errMsg := "Invalid data format: " + err.Error()
 		return &personal_schedule.UpsertGoalResponse{
 			IsSuccess: false,
-			Message:   errMsg,
+			Message:   i18n.T(ctx, i18n.MsgInvalidData, err.Error()),
 			Error:     utils.InternalServerError(ctx, err),
 		}, nil
 	}
@@ -105,16 +113,26 @@ func (s *goalService) UpsertGoal(ctx context.Context, req *personal_schedule.Ups
 		goalDB.CreatedAt = now
 		goalDB.LastModifiedAt = now
 
-		newID, err := s.goalRepo.CreateGoal(ctx, goalDB)
+		err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+			newID, err := s.goalRepo.CreateGoal(txCtx, goalDB)
+			if err != nil {
+				return err
+			}
+			goalID = newID
+			goalDB.ID = newID
+			if err := s.syncGoalTasks(txCtx, goalID, tasksDB); err != nil {
+				return err
+			}
+			return s.insertGoalLifecycleEvents(txCtx, lifecycle_constant.GOAL_CREATED, nil, goalDB, req.UserId)
+		})
 		if err != nil {
 			s.logger.Error("Failed to create goal", "", zap.Error(err))
 			return &personal_schedule.UpsertGoalResponse{
 				IsSuccess: false,
-				Message:   "Failed to create goal",
+				Message:   i18n.T(ctx, i18n.MsgGoalCreateFailed),
 				Error:     utils.DatabaseError(ctx, err),
 			}, err
 		}
-		goalID = newID
 
 	} else {
 		goalID, _ = bson.ObjectIDFromHex(*req.Id)
@@ -124,51 +142,56 @@ func (s *goalService) UpsertGoal(ctx context.Context, req *personal_schedule.Ups
 			s.logger.Error("Failed to get goal by ID", "", zap.Error(err))
 			return &personal_schedule.UpsertGoalResponse{
 				IsSuccess: false,
-				Message:   "Database error",
+				Message:   i18n.T(ctx, i18n.MsgDatabaseError),
 				Error:     utils.DatabaseError(ctx, err),
 			}, err
 		}
 		if existingGoal == nil {
-			errMsg := "goal not found"
-			s.logger.Warn(errMsg, "", zap.String("goal_id", *req.Id))
+			s.logger.Warn("goal not found", "", zap.String("goal_id", *req.Id))
 			return &personal_schedule.UpsertGoalResponse{
 				IsSuccess: false,
-				Message:   errMsg,
+				Message:   i18n.T(ctx, i18n.MsgGoalNotFound),
 				Error:     utils.NotFoundError(ctx, err),
 			}, nil
 		}
 		if existingGoal.UserID != req.UserId {
-			errMsg := "forbidden: user does not own this goal"
-			s.logger.Warn(errMsg, "", zap.String("goal_id", *req.Id), zap.String("user_id", req.UserId))
+			s.logger.Warn("forbidden: user does not own this goal", "", zap.String("goal_id", *req.Id), zap.String("user_id", req.UserId))
 			return &personal_schedule.UpsertGoalResponse{
 				IsSuccess: false,
-				Message:   errMsg,
+				Message:   i18n.T(ctx, i18n.MsgGoalForbidden),
 				Error:     utils.PermissionDeniedError(ctx, err),
 			}, nil
 		}
 
-		if err := s.goalRepo.UpdateGoal(ctx, goalID, goalDB); err != nil {
+		existingGoal.ID = goalID
+		updatedGoal := *goalDB
+		updatedGoal.ID = goalID
+		updatedGoal.UserID = existingGoal.UserID
+		err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+			if err := s.goalRepo.UpdateGoal(txCtx, goalID, goalDB); err != nil {
+				return err
+			}
+			if err := s.insertGoalLabelEvents(txCtx, existingGoal, diffLabelFields(goalLabelFields(existingGoal), goalLabelFields(goalDB), existingGoal.UserID, req.UserId)); err != nil {
+				return err
+			}
+			if err := s.syncGoalTasks(txCtx, goalID, tasksDB); err != nil {
+				return err
+			}
+			return s.insertGoalLifecycleEvents(txCtx, lifecycle_constant.GOAL_UPDATED, existingGoal, &updatedGoal, req.UserId)
+		})
+		if err != nil {
 			s.logger.Error("Failed to update goal", "", zap.Error(err))
 			return &personal_schedule.UpsertGoalResponse{
 				IsSuccess: false,
-				Message:   "Failed to update goal",
+				Message:   i18n.T(ctx, i18n.MsgGoalUpdateFailed),
 				Error:     utils.DatabaseError(ctx, err),
 			}, err
 		}
 	}
 
-	if err := s.syncGoalTasks(ctx, goalID, tasksDB); err != nil {
-		s.logger.Error("Failed to sync goal tasks", "", zap.Error(err))
-		return &personal_schedule.UpsertGoalResponse{
-			IsSuccess: false,
-			Message:   "Failed to sync tasks (Goal was upserted but tasks failed)",
-			Error:     utils.DatabaseError(ctx, err),
-		}, err
-	}
-
 	return &personal_schedule.UpsertGoalResponse{
 		IsSuccess: true,
-		Message:   "Goal upserted successfully",
+		Message:   i18n.T(ctx, i18n.MsgGoalUpserted),
 	}, nil
 }
 
@@ -200,6 +223,7 @@ func (s *goalService) syncGoalTasks(ctx context.Context, goalID bson.ObjectID, p
 				SetFilter(bson.M{"_id": task.ID, "goal_id": goalID}).
 				SetUpdate(bson.M{"$set": bson.M{
 					"name":             task.Name,
+					"name_normalized":  task.NameNormalized,
 					"is_completed":     task.IsCompleted,
 					"last_modified_at": now,
 				}}))
@@ -294,19 +318,93 @@ func (s *goalService) DeleteGoal(ctx context.Context, req *personal_schedule.Del
 		return nil, fmt.Errorf("forbidden: user does not own this goal")
 	}
 
-	if err := s.goalRepo.DeleteTasksByGoalID(ctx, goalID); err != nil {
-		s.logger.Error("Error deleting goal tasks", "err", zap.Error(err))
-		return nil, err
+	var resp *personal_schedule.DeleteGoalResponse
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		resp = &personal_schedule.DeleteGoalResponse{}
+		works, err := s.goalRepo.GetWorksByGoalID(txCtx, goalID)
+		if err != nil {
+			return err
+		}
+		// a series still linked through its template would materialize new works of the goal
+		linkedSeries, err := s.goalRepo.CountSeriesByGoalID(txCtx, goalID)
+		if err != nil {
+			return err
+		}
+		resp.LinkedWorks = int32(len(works))
+
+		switch req.Mode {
+		case personal_schedule.GoalDeleteMode_GOAL_DELETE_MODE_REFUSE:
+			if len(works) > 0 || linkedSeries > 0 {
+				resp.LinkedWorks += int32(linkedSeries)
+				return errGoalHasLinkedWorks
+			}
+		case personal_schedule.GoalDeleteMode_GOAL_DELETE_MODE_CASCADE:
+			workIDs := make([]bson.ObjectID, 0, len(works))
+			for _, work := range works {
+				workIDs = append(workIDs, work.ID)
+			}
+			deletedWorks, deletedSubTasks, err := s.goalRepo.DeleteWorksByGoalID(txCtx, goalID, workIDs)
+			if err != nil {
+				return err
+			}
+			resp.DeletedWorks = int32(deletedWorks)
+			resp.DeletedSubTasks = int32(deletedSubTasks)
+			if err := s.insertLinkedWorkEvents(txCtx, lifecycle_constant.WORK_DELETED, works, req.UserId); err != nil {
+				return err
+			}
+		default:
+			detached, err := s.goalRepo.DetachWorksFromGoal(txCtx, goalID)
+			if err != nil {
+				return err
+			}
+			resp.DetachedWorks = int32(detached)
+			for i := range works {
+				works[i].GoalID = nil
+			}
+			if err := s.insertLinkedWorkEvents(txCtx, lifecycle_constant.WORK_UPDATED, works, req.UserId); err != nil {
+				return err
+			}
+		}
+
+		deletedGoalTasks, err := s.goalRepo.DeleteTasksByGoalID(txCtx, goalID)
+		if err != nil {
+			return err
+		}
+		resp.DeletedGoalTasks = int32(deletedGoalTasks)
+		if err := s.goalRepo.DeleteGoal(txCtx, goalID); err != nil {
+			return err
+		}
+		return s.insertGoalLifecycleEvents(txCtx, lifecycle_constant.GOAL_DELETED, nil, goal, req.UserId)
+	})
+	if errors.Is(err, errGoalHasLinkedWorks) {
+		s.logger.Info("Goal still has linked works", "", zap.String("goal_id", req.GoalId), zap.Int32("linked_works", resp.LinkedWorks))
+		return &personal_schedule.DeleteGoalResponse{
+			Success:     false,
+			Message:     utils.ToStringPointer(i18n.T(ctx, i18n.MsgGoalHasLinkedWorks, resp.LinkedWorks)),
+			LinkedWorks: resp.LinkedWorks,
+			Error:       utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.GoalHasLinkedWorks, err),
+		}, nil
 	}
-	if err := s.goalRepo.DeleteGoal(ctx, goalID); err != nil {
+	if err != nil {
 		s.logger.Error("Error deleting goal", "err", zap.Error(err))
 		return nil, err
 	}
 
-	return &personal_schedule.DeleteGoalResponse{
-		Success: true,
-		Error:   nil,
-	}, nil
+	resp.Success = true
+	return resp, nil
+}
+
+// insertLinkedWorkEvents writes a lifecycle event for every work touched by the deletion of their goal.
+func (s *goalService) insertLinkedWorkEvents(ctx context.Context, eventType string, works []collection.Work, actorID string) error {
+	events := make([]interface{}, 0, len(works))
+	for i := range works {
+		event, err := newWorkLifecycleEvent(ctx, eventType, &works[i], actorID, nil)
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+	}
+	return s.outboxRepo.InsertOutboxEvents(ctx, events)
 }
 
 func (s *goalService) GetGoalsForDialog(ctx context.Context, req *personal_schedule.GetGoalsForDialogRequest) (*personal_schedule.GetGoalForDialogResponse, error) {
@@ -369,6 +467,18 @@ func (s *goalService) UpdateGoalLabel(ctx context.Context, req *personal_schedul
 		}, nil
 	}
 
+	if err := s.validator.ValidateLabelOfType(ctx, req.UserId, req.LabelId, int(req.LabelType)); err != nil {
+		s.logger.Warn("Invalid label", "", zap.String("label_id", req.LabelId), zap.Error(err))
+		if ve, ok := err.(*validation.ValidationError); ok {
+			return &personal_schedule.UpdateGoalLabelResponse{
+				Error: utils.CustomError(ctx, ve.Category, ve.Code, err),
+			}, nil
+		}
+		return &personal_schedule.UpdateGoalLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
 	var fieldName string
 	switch req.LabelType {
 	case 2:
@@ -386,7 +496,22 @@ func (s *goalService) UpdateGoalLabel(ctx context.Context, req *personal_schedul
 			Error: utils.InternalServerError(ctx, err),
 		}, nil
 	}
-	if err := s.goalRepo.UpdateGoalField(ctx, goalID, fieldName, labelID); err != nil {
+	before := goalLabelFields(goal)
+	after := goalLabelFields(goal)
+	after[fieldName] = labelID
+	goal.ID = goalID
+	updatedGoal := *goal
+	setGoalLabelField(&updatedGoal, fieldName, labelID)
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		if err := s.goalRepo.UpdateGoalField(txCtx, goalID, fieldName, labelID); err != nil {
+			return err
+		}
+		if err := s.insertGoalLabelEvents(txCtx, goal, diffLabelFields(before, after, goal.UserID, req.UserId)); err != nil {
+			return err
+		}
+		return s.insertGoalLifecycleEvents(txCtx, lifecycle_constant.GOAL_UPDATED, goal, &updatedGoal, req.UserId)
+	})
+	if err != nil {
 		s.logger.Error("Failed to update goal field", "", zap.Error(err))
 		return &personal_schedule.UpdateGoalLabelResponse{
 			Error: utils.DatabaseError(ctx, err),
@@ -395,6 +520,74 @@ func (s *goalService) UpdateGoalLabel(ctx context.Context, req *personal_schedul
 
 	return &personal_schedule.UpdateGoalLabelResponse{
 		Error:   nil,
-		Message: "Goal label updated successfully",
+		Message: i18n.T(ctx, i18n.MsgGoalLabelUpdated),
 	}, nil
 }
+
+// insertGoalLabelEvents stores the events of a goal in the work_events collection, in the transaction of the change itself.
+func (s *goalService) insertGoalLabelEvents(ctx context.Context, goal *collection.Goal, events []collection.WorkEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	docs := make([]interface{}, 0, len(events))
+	for i := range events {
+		goalID := goal.ID
+		events[i].GoalID = &goalID
+		docs = append(docs, events[i])
+	}
+	return s.goalRepo.InsertGoalEvents(ctx, docs)
+}
+
+// insertGoalLifecycleEvents writes the lifecycle event of a goal change to the outbox,
+// an update moving the goal to COMPLETED also emits goal.completed. before is nil on create and delete.
+func (s *goalService) insertGoalLifecycleEvents(ctx context.Context, eventType string, before, after *collection.Goal, actorID string) error {
+	var previousStatusID *bson.ObjectID
+	if before != nil && before.StatusID != after.StatusID {
+		statusID := before.StatusID
+		previousStatusID = &statusID
+	}
+
+	event, err := newGoalLifecycleEvent(ctx, eventType, after, actorID, previousStatusID)
+	if err != nil {
+		return err
+	}
+	events := []interface{}{event}
+
+	if eventType == lifecycle_constant.GOAL_UPDATED && previousStatusID != nil {
+		completedLabel, err := s.goalRepo.GetLabelByKey(ctx, labels_constant.LabelCompleted)
+		if err != nil {
+			return err
+		}
+		if completedLabel != nil && after.StatusID == completedLabel.ID {
+			completedEvent, err := newGoalLifecycleEvent(ctx, lifecycle_constant.GOAL_COMPLETED, after, actorID, previousStatusID)
+			if err != nil {
+				return err
+			}
+			events = append(events, completedEvent)
+		}
+	}
+	return s.outboxRepo.InsertOutboxEvents(ctx, events)
+}
+
+// setGoalLabelField sets the label of a goal by its field name, as used by UpdateGoalField.
+func setGoalLabelField(goal *collection.Goal, fieldName string, labelID bson.ObjectID) {
+	switch fieldName {
+	case "status_id":
+		goal.StatusID = labelID
+	case "difficulty_id":
+		goal.DifficultyID = labelID
+	case "priority_id":
+		goal.PriorityID = labelID
+	case "category_id":
+		goal.CategoryID = labelID
+	}
+}
+
+func goalLabelFields(goal *collection.Goal) map[string]bson.ObjectID {
+	return map[string]bson.ObjectID{
+		"status_id":     goal.StatusID,
+		"difficulty_id": goal.DifficultyID,
+		"priority_id":   goal.PriorityID,
+		"category_id":   goal.CategoryID,
+	}
+}
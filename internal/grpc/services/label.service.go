@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"personal_schedule_service/internal/collection"
 	labels_constant "personal_schedule_service/internal/constant/labels"
 	"personal_schedule_service/internal/grpc/helper"
 	"personal_schedule_service/internal/grpc/mapper"
@@ -13,40 +15,36 @@ import (
 	"personal_schedule_service/proto/personal_schedule"
 
 	"github.com/thanvuc/go-core-lib/log"
+	"github.com/thanvuc/go-core-lib/mongolib"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.uber.org/zap"
 )
 
 type labelService struct {
-	labelHelper helper.LabelHelper
-	logger      log.Logger
-	labelRepo   repos.LabelRepo
-	labelMapper mapper.LabelMapper
+	labelHelper    helper.LabelHelper
+	logger         log.Logger
+	labelRepo      repos.LabelRepo
+	labelMapper    mapper.LabelMapper
+	userRepo       repos.UserRepo
+	workRepo       repos.WorkRepo
+	outboxRepo     repos.OutboxRepo
+	mongoConnector *mongolib.MongoConnector
 }
 
+// SeedLabels inserts the system labels shipped with the service whose key is missing, it is run on every start.
 func (s *labelService) SeedLabels(ctx context.Context) error {
-	count, err := s.labelRepo.CountLabels(ctx)
-	if err != nil {
-		s.logger.Error("Cannot get labels", "", zap.Error(err))
-		return err
-	}
-
-	if count > 0 {
-		s.logger.Info("Labels already initialized, skip inserting labels", "")
-		return nil
-	}
-
-	labels := s.labelHelper.GenerateLabel()
-	err = s.labelRepo.InsertLabels(ctx, &labels)
+	inserted, err := s.labelRepo.UpsertSystemLabels(ctx, s.labelHelper.GenerateLabel())
 	if err != nil {
 		s.logger.Error("Cannot Initalize Labels", "", zap.Error(err))
 		panic("Cannot Initalize Labels")
 	}
-	s.logger.Info("Labels initialized successfully", "")
+	s.logger.Info("Labels initialized successfully", "", zap.Int64("inserted", inserted))
 	return nil
 }
 
-func (s *labelService) GetLabelPerTypes(ctx context.Context, req *common.EmptyRequest) (*personal_schedule.GetLabelPerTypesResponse, error) {
-	labels, err := s.labelRepo.GetLabels(ctx)
+func (s *labelService) GetLabelPerTypes(ctx context.Context, req *personal_schedule.GetLabelPerTypesRequest) (*personal_schedule.GetLabelPerTypesResponse, error) {
+	labels, err := s.labelRepo.GetLabels(ctx, req.GetUserId())
 	if err != nil {
 		return &personal_schedule.GetLabelPerTypesResponse{
 			LabelPerTypes: nil,
@@ -73,8 +71,8 @@ func (s *labelService) GetLabelPerTypes(ctx context.Context, req *common.EmptyRe
 	return resp, nil
 }
 
-func (s *labelService) GetLabelsByTypeIDs(ctx context.Context, req *common.IDRequest) (*personal_schedule.GetLabelsByTypeIDsResponse, error) {
-	labels, err := s.labelRepo.GetLabelsByTypeIDs(ctx, utils.StringToInt32(req.Id))
+func (s *labelService) GetLabelsByTypeIDs(ctx context.Context, req *personal_schedule.GetLabelsByTypeIDsRequest) (*personal_schedule.GetLabelsByTypeIDsResponse, error) {
+	labels, err := s.labelRepo.GetLabelsByTypeIDs(ctx, utils.StringToInt32(req.Id), req.GetUserId())
 	if err != nil {
 		return &personal_schedule.GetLabelsByTypeIDsResponse{
 			Labels: nil,
@@ -97,28 +95,47 @@ func (s *labelService) GetLabelsByTypeIDs(ctx context.Context, req *common.IDReq
 	return resp, nil
 }
 
+// GetDefaultLabel returns the system default labels, for the clients which do not send the user.
 func (s *labelService) GetDefaultLabel(ctx context.Context, req *common.EmptyRequest) (*personal_schedule.GetDefaultLabelResponse, error) {
-	typeID, err := s.labelRepo.GetLabelByKey(ctx, labels_constant.LabelInDay)
+	return s.GetUserDefaultLabel(ctx, &personal_schedule.GetDefaultLabelRequest{})
+}
+
+// GetUserDefaultLabel returns the default labels of the user, the system ones fill the types they have none for.
+func (s *labelService) GetUserDefaultLabel(ctx context.Context, req *personal_schedule.GetDefaultLabelRequest) (*personal_schedule.GetDefaultLabelResponse, error) {
+	defaults := &collection.UserDefaultLabels{}
+	if req.UserId != nil && *req.UserId != "" {
+		user, err := s.userRepo.GetUserByID(ctx, *req.UserId)
+		if err != nil {
+			return &personal_schedule.GetDefaultLabelResponse{
+				Error: utils.DatabaseError(ctx, err),
+			}, err
+		}
+		if user != nil && user.DefaultLabels != nil {
+			defaults = user.DefaultLabels
+		}
+	}
+
+	typeID, err := s.preferredLabel(ctx, defaults.TypeID, labels_constant.LabelInDay)
 	if err != nil {
 		return nil, err
 	}
 
-	difficultyID, err := s.labelRepo.GetLabelByKey(ctx, labels_constant.LabelDifficultyEasy)
+	difficultyID, err := s.preferredLabel(ctx, defaults.DifficultyID, labels_constant.LabelDifficultyEasy)
 	if err != nil {
 		return nil, err
 	}
 
-	priorityID, err := s.labelRepo.GetLabelByKey(ctx, labels_constant.LabelPriorityImportantNotUrgent)
+	priorityID, err := s.preferredLabel(ctx, defaults.PriorityID, labels_constant.LabelPriorityImportantNotUrgent)
 	if err != nil {
 		return nil, err
 	}
 
-	statusID, err := s.labelRepo.GetLabelByKey(ctx, labels_constant.LabelPending)
+	statusID, err := s.preferredLabel(ctx, defaults.StatusID, labels_constant.LabelPending)
 	if err != nil {
 		return nil, err
 	}
 
-	categoryID, err := s.labelRepo.GetLabelByKey(ctx, labels_constant.LabelCategoryPersonal)
+	categoryID, err := s.preferredLabel(ctx, defaults.CategoryID, labels_constant.LabelCategoryPersonal)
 	if err != nil {
 		return nil, err
 	}
@@ -133,3 +150,19 @@ func (s *labelService) GetDefaultLabel(ctx context.Context, req *common.EmptyReq
 	}
 	return resp, nil
 }
+
+// preferredLabel returns the label the user picked, or the built-in default
+// when there is no pick or the picked label no longer exists.
+func (s *labelService) preferredLabel(ctx context.Context, labelID *bson.ObjectID, fallbackKey string) (*collection.Label, error) {
+	if labelID != nil {
+		label, err := s.labelRepo.GetLabelByID(ctx, *labelID)
+		if err == nil {
+			return label, nil
+		}
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, err
+		}
+	}
+
+	return s.labelRepo.GetLabelByKey(ctx, fallbackKey)
+}
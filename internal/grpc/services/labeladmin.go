@@ -0,0 +1,338 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"personal_schedule_service/internal/collection"
+	labels_constant "personal_schedule_service/internal/constant/labels"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/grpc/validation"
+	"personal_schedule_service/internal/i18n"
+	app_error "personal_schedule_service/pkg/settings/error"
+	"personal_schedule_service/proto/common"
+	"personal_schedule_service/proto/personal_schedule"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+)
+
+var labelKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+func (s *labelService) ListSystemLabels(ctx context.Context, req *personal_schedule.ListSystemLabelsRequest) (*personal_schedule.ListSystemLabelsResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	labels, err := s.labelRepo.GetSystemLabels(ctx, req.IncludeDeprecated)
+	if err != nil {
+		s.logger.Error("Failed to get system labels", requestID, zap.Error(err))
+		return &personal_schedule.ListSystemLabelsResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	return &personal_schedule.ListSystemLabelsResponse{
+		Labels: s.labelMapper.MapLabelsToLabelsProto(labels),
+	}, nil
+}
+
+// CreateSystemLabel adds a label shared by every user after the system labels of the same type.
+func (s *labelService) CreateSystemLabel(ctx context.Context, req *personal_schedule.CreateSystemLabelRequest) (*personal_schedule.CreateSystemLabelResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	key := strings.ToUpper(strings.TrimSpace(req.Key))
+	if !labelKeyPattern.MatchString(key) {
+		return &personal_schedule.CreateSystemLabelResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, fmt.Errorf("invalid key %q, expected upper case letters, digits and underscores", req.Key)),
+		}, nil
+	}
+	name := strings.TrimSpace(req.Name)
+	labelType := int(req.LabelType)
+	if err := s.validateCustomLabel(ctx, "", labelType, name, req.Color, nil); err != nil {
+		s.logger.Warn("Invalid system label", requestID, zap.Error(err))
+		return &personal_schedule.CreateSystemLabelResponse{
+			Error: customLabelError(ctx, err),
+		}, nil
+	}
+
+	if _, err := s.labelRepo.GetLabelByKey(ctx, key); err == nil {
+		return &personal_schedule.CreateSystemLabelResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.LabelKeyExists, fmt.Errorf("a label with key %q already exists", key)),
+		}, nil
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		s.logger.Error("Failed to get label by key", requestID, zap.Error(err))
+		return &personal_schedule.CreateSystemLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	systemLabels, err := s.labelRepo.GetSystemLabels(ctx, true)
+	if err != nil {
+		s.logger.Error("Failed to get system labels", requestID, zap.Error(err))
+		return &personal_schedule.CreateSystemLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	var order int32
+	for _, label := range systemLabels {
+		if label.LabelType == labelType && label.Order >= order {
+			order = label.Order + 1
+		}
+	}
+
+	color := labels_constant.DefaultCustomLabelColor
+	if req.Color != nil {
+		color = strings.ToUpper(*req.Color)
+	}
+	now := time.Now()
+	label := collection.Label{
+		Name:           name,
+		Meaning:        req.Meaning,
+		Note:           req.Note,
+		Color:          &color,
+		LabelType:      labelType,
+		Key:            key,
+		Order:          order,
+		CreatedAt:      now,
+		LastModifiedAt: now,
+	}
+	label.ID, err = s.labelRepo.CreateLabel(ctx, &label)
+	if err != nil {
+		s.logger.Error("Failed to create system label", requestID, zap.Error(err))
+		return &personal_schedule.CreateSystemLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	s.logger.Info("System label created", requestID, zap.String("operator_id", req.OperatorId), zap.String("key", key))
+	return &personal_schedule.CreateSystemLabelResponse{
+		Label: s.labelMapper.MapLabelToLabelProto(label),
+	}, nil
+}
+
+func (s *labelService) UpdateSystemLabel(ctx context.Context, req *personal_schedule.UpdateSystemLabelRequest) (*personal_schedule.UpdateSystemLabelResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	label, err := s.getSystemLabel(ctx, req.Id)
+	if err != nil {
+		return &personal_schedule.UpdateSystemLabelResponse{
+			Error: customLabelError(ctx, err),
+		}, nil
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		exists, err := s.labelRepo.CheckLabelNameExistence(ctx, "", label.LabelType, name, &label.ID)
+		if err != nil {
+			s.logger.Error("Failed to check label name", requestID, zap.Error(err))
+			return &personal_schedule.UpdateSystemLabelResponse{
+				Error: utils.DatabaseError(ctx, err),
+			}, nil
+		}
+		if exists {
+			return &personal_schedule.UpdateSystemLabelResponse{
+				Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.LabelNameExists, fmt.Errorf("a label named %q already exists", name)),
+			}, nil
+		}
+		label.Name = name
+	}
+	if label.Name == "" || utf8.RuneCountInString(label.Name) > labels_constant.MaxLabelNameLength {
+		return &personal_schedule.UpdateSystemLabelResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, fmt.Errorf("label name must have 1 to %d characters", labels_constant.MaxLabelNameLength)),
+		}, nil
+	}
+	if req.Color != nil {
+		if !labelColorPattern.MatchString(*req.Color) {
+			return &personal_schedule.UpdateSystemLabelResponse{
+				Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, fmt.Errorf("invalid color %q, expected #RRGGBB", *req.Color)),
+			}, nil
+		}
+		color := strings.ToUpper(*req.Color)
+		label.Color = &color
+	}
+	if req.Meaning != nil {
+		label.Meaning = req.Meaning
+	}
+	if req.Note != nil {
+		label.Note = req.Note
+	}
+
+	if err := s.labelRepo.UpdateLabel(ctx, label); err != nil {
+		s.logger.Error("Failed to update system label", requestID, zap.Error(err))
+		return &personal_schedule.UpdateSystemLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	s.logger.Info("System label updated", requestID, zap.String("operator_id", req.OperatorId), zap.String("key", label.Key))
+	return &personal_schedule.UpdateSystemLabelResponse{
+		Label: s.labelMapper.MapLabelToLabelProto(*label),
+	}, nil
+}
+
+// DeprecateSystemLabel hides a system label from the users, then moves every work and goal using it to the replacement.
+// Deprecating it again with the same replacement resumes an interrupted reassignment.
+func (s *labelService) DeprecateSystemLabel(ctx context.Context, req *personal_schedule.DeprecateSystemLabelRequest) (*personal_schedule.DeprecateSystemLabelResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	label, field, err := s.getRetirableLabel(ctx, req.Id)
+	if err != nil {
+		return &personal_schedule.DeprecateSystemLabelResponse{
+			Error: customLabelError(ctx, err),
+		}, nil
+	}
+	replacement, err := s.getSystemReplacementLabel(ctx, req.ReplacementLabelId, label)
+	if err != nil {
+		return &personal_schedule.DeprecateSystemLabelResponse{
+			Error: customLabelError(ctx, err),
+		}, nil
+	}
+
+	works, goals, err := s.retireSystemLabel(ctx, label, field, replacement.ID, req.OperatorId)
+	if err != nil {
+		s.logger.Error("Failed to deprecate system label", requestID, zap.String("key", label.Key), zap.Error(err))
+		return &personal_schedule.DeprecateSystemLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	s.logger.Info("System label deprecated", requestID,
+		zap.String("operator_id", req.OperatorId), zap.String("key", label.Key), zap.String("replacement_key", replacement.Key),
+		zap.Int64("reassigned_works", works), zap.Int64("reassigned_goals", goals))
+	return &personal_schedule.DeprecateSystemLabelResponse{
+		IsSuccess:       true,
+		Message:         i18n.T(ctx, i18n.MsgLabelDeprecated),
+		ReassignedWorks: works,
+		ReassignedGoals: goals,
+	}, nil
+}
+
+// DeleteSystemLabel deletes a system label, a used one is deprecated and its works and goals moved to the replacement first.
+// The replacement of a label already deprecated defaults to the one it was deprecated for.
+func (s *labelService) DeleteSystemLabel(ctx context.Context, req *personal_schedule.DeleteSystemLabelRequest) (*personal_schedule.DeleteSystemLabelResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	label, field, err := s.getRetirableLabel(ctx, req.Id)
+	if err != nil {
+		return &personal_schedule.DeleteSystemLabelResponse{
+			Error: customLabelError(ctx, err),
+		}, nil
+	}
+
+	replacementID := utils.SafeString(req.ReplacementLabelId)
+	if replacementID == "" && label.ReplacedByID != nil {
+		replacementID = label.ReplacedByID.Hex()
+	}
+
+	var works, goals int64
+	if replacementID != "" {
+		replacement, err := s.getSystemReplacementLabel(ctx, replacementID, label)
+		if err != nil {
+			return &personal_schedule.DeleteSystemLabelResponse{
+				Error: customLabelError(ctx, err),
+			}, nil
+		}
+		works, goals, err = s.retireSystemLabel(ctx, label, field, replacement.ID, req.OperatorId)
+		if err != nil {
+			s.logger.Error("Failed to reassign system label", requestID, zap.String("key", label.Key), zap.Error(err))
+			return &personal_schedule.DeleteSystemLabelResponse{
+				Error: utils.DatabaseError(ctx, err),
+			}, nil
+		}
+	}
+
+	usage, err := s.labelRepo.CountSystemLabelUsage(ctx, field, label.ID)
+	if err != nil {
+		s.logger.Error("Failed to count label usage", requestID, zap.Error(err))
+		return &personal_schedule.DeleteSystemLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	if usage.Total() > 0 {
+		return &personal_schedule.DeleteSystemLabelResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.LabelInUse, fmt.Errorf("label is used by %d works, %d goals, %d series and %d user defaults, a replacement label is required", usage.Works, usage.Goals, usage.SeriesTemplates, usage.DefaultLabels)),
+		}, nil
+	}
+
+	if err := s.labelRepo.DeleteSystemLabel(ctx, label.ID); err != nil {
+		s.logger.Error("Failed to delete system label", requestID, zap.Error(err))
+		return &personal_schedule.DeleteSystemLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	s.logger.Info("System label deleted", requestID,
+		zap.String("operator_id", req.OperatorId), zap.String("key", label.Key),
+		zap.Int64("reassigned_works", works), zap.Int64("reassigned_goals", goals))
+	return &personal_schedule.DeleteSystemLabelResponse{
+		IsSuccess:       true,
+		Message:         i18n.T(ctx, i18n.MsgLabelDeleted),
+		ReassignedWorks: works,
+		ReassignedGoals: goals,
+	}, nil
+}
+
+// retireSystemLabel deprecates the label first so that no new work or goal picks it, then reassigns the existing ones in batches,
+// with the events of the operator.
+func (s *labelService) retireSystemLabel(ctx context.Context, label *collection.Label, field string, replacementID bson.ObjectID, operatorID string) (int64, int64, error) {
+	if err := s.labelRepo.DeprecateSystemLabel(ctx, label.ID, replacementID); err != nil {
+		return 0, 0, err
+	}
+	works, goals, err := s.reassignLabelUsage(ctx, "", field, label.ID, replacementID, operatorID)
+	if err != nil {
+		return works, goals, err
+	}
+	return works, goals, s.labelRepo.ReassignSystemLabel(ctx, field, label.ID, replacementID)
+}
+
+// getSystemLabel returns the label when it is a system label, the custom labels are changed by their users.
+func (s *labelService) getSystemLabel(ctx context.Context, id string) (*collection.Label, error) {
+	labelID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.LabelNotFoundCode, "invalid label id")
+	}
+	label, err := s.labelRepo.GetLabelByID(ctx, labelID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.LabelNotFoundCode, fmt.Sprintf("label %s not found", id))
+		}
+		return nil, err
+	}
+	if label.UserID != nil {
+		return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.LabelNotFoundCode, fmt.Sprintf("system label %s not found", id))
+	}
+	return label, nil
+}
+
+// getRetirableLabel returns a system label which can be deprecated or deleted with the field referencing it.
+// The labels shipped with the service are looked up by key and can only be renamed or recolored.
+func (s *labelService) getRetirableLabel(ctx context.Context, id string) (*collection.Label, string, error) {
+	label, err := s.getSystemLabel(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	field, ok := labels_constant.LabelTypeFields[label.LabelType]
+	if !ok || slices.ContainsFunc(s.labelHelper.GenerateLabel(), func(l collection.Label) bool { return l.Key == label.Key }) {
+		return nil, "", validation.NewValidationError(common.ErrorCode_ERROR_CODE_PERMISSION_DENIED, app_error.ProtectedLabel, fmt.Sprintf("label %s is used by the service and can not be deprecated or deleted", label.Key))
+	}
+	return label, field, nil
+}
+
+// getSystemReplacementLabel returns the system label replacing a retired one, of the same type and not deprecated.
+func (s *labelService) getSystemReplacementLabel(ctx context.Context, id string, retired *collection.Label) (*collection.Label, error) {
+	label, err := s.getSystemLabel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if label.ID == retired.ID || label.DeprecatedAt != nil {
+		return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, "replacement label must be another label which is not deprecated")
+	}
+	if label.LabelType != retired.LabelType {
+		return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, "replacement label must be of the same type")
+	}
+	return label, nil
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"personal_schedule_service/internal/collection"
+	labels_constant "personal_schedule_service/internal/constant/labels"
+	lifecycle_constant "personal_schedule_service/internal/constant/lifecycle"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// reassignLabelUsage moves the works and goals of the user, of every user when userID is empty, from a label to its
+// replacement, LabelReassignBatchSize documents per transaction. Each moved document gets its label_changed event
+// and its lifecycle events as if it had been updated by actorID. It can be run again to resume after a failure.
+func (s *labelService) reassignLabelUsage(ctx context.Context, userID string, field string, fromID, toID bson.ObjectID, actorID string) (int64, int64, error) {
+	var completedID *bson.ObjectID
+	if field == "status_id" {
+		completedLabel, err := s.labelRepo.GetLabelByKey(ctx, labels_constant.LabelCompleted)
+		if err != nil {
+			return 0, 0, err
+		}
+		if completedLabel != nil {
+			completedID = &completedLabel.ID
+		}
+	}
+
+	var reassignedWorks int64
+	for {
+		works, err := s.labelRepo.GetWorksByLabel(ctx, userID, field, fromID, labels_constant.LabelReassignBatchSize)
+		if err != nil {
+			return reassignedWorks, 0, err
+		}
+		if len(works) == 0 {
+			break
+		}
+		err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+			n, err := s.reassignWorks(txCtx, works, field, fromID, toID, actorID, completedID)
+			reassignedWorks += n
+			return err
+		})
+		if err != nil {
+			return reassignedWorks, 0, err
+		}
+		if len(works) < labels_constant.LabelReassignBatchSize {
+			break
+		}
+	}
+
+	var reassignedGoals int64
+	for {
+		goals, err := s.labelRepo.GetGoalsByLabel(ctx, userID, field, fromID, labels_constant.LabelReassignBatchSize)
+		if err != nil {
+			return reassignedWorks, reassignedGoals, err
+		}
+		if len(goals) == 0 {
+			break
+		}
+		err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+			n, err := s.reassignGoals(txCtx, goals, field, fromID, toID, actorID, completedID)
+			reassignedGoals += n
+			return err
+		})
+		if err != nil {
+			return reassignedWorks, reassignedGoals, err
+		}
+		if len(goals) < labels_constant.LabelReassignBatchSize {
+			break
+		}
+	}
+	return reassignedWorks, reassignedGoals, nil
+}
+
+func (s *labelService) reassignWorks(ctx context.Context, works []collection.Work, field string, fromID, toID bson.ObjectID, actorID string, completedID *bson.ObjectID) (int64, error) {
+	ids := make([]bson.ObjectID, 0, len(works))
+	var labelEvents, lifecycleEvents []interface{}
+	for i := range works {
+		before := &works[i]
+		after := *before
+		setWorkLabelField(&after, field, toID)
+		ids = append(ids, before.ID)
+
+		for _, event := range diffLabelFields(workLabelFields(before), workLabelFields(&after), before.UserID, actorID) {
+			workID := before.ID
+			event.WorkID = &workID
+			labelEvents = append(labelEvents, event)
+		}
+
+		var previousStatusID *bson.ObjectID
+		if field == "status_id" {
+			previousStatusID = &fromID
+		}
+		event, err := newWorkLifecycleEvent(ctx, lifecycle_constant.WORK_UPDATED, &after, actorID, previousStatusID)
+		if err != nil {
+			return 0, err
+		}
+		lifecycleEvents = append(lifecycleEvents, event)
+		if previousStatusID != nil && completedID != nil && toID == *completedID {
+			completedEvent, err := newWorkLifecycleEvent(ctx, lifecycle_constant.WORK_COMPLETED, &after, actorID, previousStatusID)
+			if err != nil {
+				return 0, err
+			}
+			lifecycleEvents = append(lifecycleEvents, completedEvent)
+		}
+	}
+
+	modified, err := s.labelRepo.ReassignWorksLabel(ctx, ids, field, fromID, toID)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.workRepo.InsertWorkEvents(ctx, labelEvents); err != nil {
+		return 0, err
+	}
+	return modified, s.outboxRepo.InsertOutboxEvents(ctx, lifecycleEvents)
+}
+
+func (s *labelService) reassignGoals(ctx context.Context, goals []collection.Goal, field string, fromID, toID bson.ObjectID, actorID string, completedID *bson.ObjectID) (int64, error) {
+	ids := make([]bson.ObjectID, 0, len(goals))
+	var labelEvents, lifecycleEvents []interface{}
+	for i := range goals {
+		before := &goals[i]
+		after := *before
+		setGoalLabelField(&after, field, toID)
+		ids = append(ids, before.ID)
+
+		for _, event := range diffLabelFields(goalLabelFields(before), goalLabelFields(&after), before.UserID, actorID) {
+			goalID := before.ID
+			event.GoalID = &goalID
+			labelEvents = append(labelEvents, event)
+		}
+
+		var previousStatusID *bson.ObjectID
+		if field == "status_id" {
+			previousStatusID = &fromID
+		}
+		event, err := newGoalLifecycleEvent(ctx, lifecycle_constant.GOAL_UPDATED, &after, actorID, previousStatusID)
+		if err != nil {
+			return 0, err
+		}
+		lifecycleEvents = append(lifecycleEvents, event)
+		if previousStatusID != nil && completedID != nil && toID == *completedID {
+			completedEvent, err := newGoalLifecycleEvent(ctx, lifecycle_constant.GOAL_COMPLETED, &after, actorID, previousStatusID)
+			if err != nil {
+				return 0, err
+			}
+			lifecycleEvents = append(lifecycleEvents, completedEvent)
+		}
+	}
+
+	modified, err := s.labelRepo.ReassignGoalsLabel(ctx, ids, field, fromID, toID)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.workRepo.InsertWorkEvents(ctx, labelEvents); err != nil {
+		return 0, err
+	}
+	return modified, s.outboxRepo.InsertOutboxEvents(ctx, lifecycleEvents)
+}
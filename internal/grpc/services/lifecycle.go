@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"personal_schedule_service/internal/collection"
+	lifecycle_constant "personal_schedule_service/internal/constant/lifecycle"
+	outbox_constant "personal_schedule_service/internal/constant/outbox"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/proto/common"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/protobuf/proto"
+)
+
+// newLifecycleEvent wraps a versioned common.ScheduleEvent into an outbox event, the relay routes it
+// to the lifecycle exchange with the event type as routing key.
+func newLifecycleEvent(ctx context.Context, aggregateType string, aggregateID string, eventType string, userID string, actorID string, envelope *common.ScheduleEvent) (collection.OutboxEvent, error) {
+	envelope.SchemaVersion = lifecycle_constant.SCHEMA_VERSION
+	envelope.EventType = eventType
+	envelope.UserId = userID
+	envelope.ActorId = actorID
+	envelope.RequestId = utils.GetRequestIDFromOutgoingContext(ctx)
+
+	event := newOutboxEvent(ctx, aggregateType, aggregateID, eventType, nil)
+	envelope.EventId = event.ID.Hex()
+	envelope.OccurredAt = event.OccurredAt.UnixMilli()
+
+	payload, err := proto.Marshal(envelope)
+	if err != nil {
+		return collection.OutboxEvent{}, err
+	}
+	event.Payload = payload
+	return event, nil
+}
+
+// newWorkLifecycleEvent builds a work.* event, previousStatusID is set when the status of the work changed.
+func newWorkLifecycleEvent(ctx context.Context, eventType string, work *collection.Work, actorID string, previousStatusID *bson.ObjectID) (collection.OutboxEvent, error) {
+	payload := &common.WorkEventPayload{
+		Id:               work.ID.Hex(),
+		Name:             work.Name,
+		StartDate:        unixMilliPtr(work.StartDate),
+		EndDate:          work.EndDate.UnixMilli(),
+		StatusId:         work.StatusID.Hex(),
+		DifficultyId:     work.DifficultyID.Hex(),
+		PriorityId:       work.PriorityID.Hex(),
+		TypeId:           work.TypeID.Hex(),
+		CategoryId:       work.CategoryID.Hex(),
+		GoalId:           hexPtr(work.GoalID),
+		RepeatedId:       hexPtr(work.RepeatedID),
+		PreviousStatusId: hexPtr(previousStatusID),
+	}
+	envelope := &common.ScheduleEvent{Payload: &common.ScheduleEvent_Work{Work: payload}}
+	return newLifecycleEvent(ctx, outbox_constant.AggregateTypeWork, work.ID.Hex(), eventType, work.UserID, actorID, envelope)
+}
+
+// newGoalLifecycleEvent builds a goal.* event, previousStatusID is set when the status of the goal changed.
+func newGoalLifecycleEvent(ctx context.Context, eventType string, goal *collection.Goal, actorID string, previousStatusID *bson.ObjectID) (collection.OutboxEvent, error) {
+	payload := &common.GoalEventPayload{
+		Id:               goal.ID.Hex(),
+		Name:             goal.Name,
+		StartDate:        unixMilliPtr(goal.StartDate),
+		EndDate:          unixMilliPtr(goal.EndDate),
+		StatusId:         goal.StatusID.Hex(),
+		DifficultyId:     goal.DifficultyID.Hex(),
+		PriorityId:       goal.PriorityID.Hex(),
+		CategoryId:       goal.CategoryID.Hex(),
+		PreviousStatusId: hexPtr(previousStatusID),
+	}
+	envelope := &common.ScheduleEvent{Payload: &common.ScheduleEvent_Goal{Goal: payload}}
+	return newLifecycleEvent(ctx, outbox_constant.AggregateTypeGoal, goal.ID.Hex(), eventType, goal.UserID, actorID, envelope)
+}
+
+// newSeriesLifecycleEvent builds a series.* event, previousSeriesID is set when the series was split from another one.
+func newSeriesLifecycleEvent(ctx context.Context, eventType string, series *collection.RepeatedSeries, actorID string, materializedCount int, previousSeriesID *bson.ObjectID) (collection.OutboxEvent, error) {
+	payload := &common.SeriesEventPayload{
+		Id:                series.ID.Hex(),
+		Rrule:             series.RRule,
+		Dtstart:           series.DTStart.UnixMilli(),
+		DurationMs:        series.DurationMs,
+		TimeZone:          series.TimeZone,
+		EndsAt:            unixMilliPtr(series.EndsAt),
+		Name:              series.Template.Name,
+		MaterializedCount: int32(materializedCount),
+		PreviousSeriesId:  hexPtr(previousSeriesID),
+	}
+	envelope := &common.ScheduleEvent{Payload: &common.ScheduleEvent_Series{Series: payload}}
+	return newLifecycleEvent(ctx, outbox_constant.AggregateTypeSeries, series.ID.Hex(), eventType, series.UserID, actorID, envelope)
+}
+
+func unixMilliPtr(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	ms := t.UnixMilli()
+	return &ms
+}
+
+func hexPtr(id *bson.ObjectID) *string {
+	if id == nil {
+		return nil
+	}
+	hex := id.Hex()
+	return &hex
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"personal_schedule_service/internal/collection"
+	user_constant "personal_schedule_service/internal/constant/user"
+	"personal_schedule_service/internal/repos"
+	"personal_schedule_service/proto/personal_schedule"
+	"strings"
+	"time"
+)
+
+// userPreferences returns the preference profile of the user, empty when the user is not synced yet.
+func userPreferences(ctx context.Context, userRepo repos.UserRepo, userID string) (*collection.User, error) {
+	user, err := userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return &collection.User{ID: userID}, nil
+	}
+	return user, nil
+}
+
+// preferredWorkingHours returns the working hours of the request, or the ones of the user when the request has none.
+func preferredWorkingHours(requested []*personal_schedule.WorkingHours, user *collection.User) []*personal_schedule.WorkingHours {
+	if len(requested) > 0 {
+		return requested
+	}
+	workingHours := make([]*personal_schedule.WorkingHours, 0, len(user.WorkingHours))
+	for _, hours := range user.WorkingHours {
+		workingHours = append(workingHours, &personal_schedule.WorkingHours{
+			Weekday:     hours.Weekday,
+			StartMinute: hours.StartMinute,
+			EndMinute:   hours.EndMinute,
+		})
+	}
+	return workingHours
+}
+
+// preferredWeekStart returns the first day of the week of the user.
+func preferredWeekStart(user *collection.User) time.Weekday {
+	if user.WeekStart == nil {
+		return user_constant.DEFAULT_WEEK_START
+	}
+	return time.Weekday(*user.WeekStart)
+}
+
+// dailyCapacityMs returns how much work the user wants per day, 0 when there is no limit.
+func dailyCapacityMs(user *collection.User) int64 {
+	return int64(user.DailyCapacityHours * float64(time.Hour/time.Millisecond))
+}
+
+// defaultReminders builds the default reminders of a work starting at startDate, skipping the ones already past.
+func defaultReminders(user *collection.User, startDate int64, now time.Time) []*personal_schedule.WorkNotification {
+	var reminders []*personal_schedule.WorkNotification
+	for _, offset := range user.ReminderOffsetsMinutes {
+		triggerAt := startDate - int64(offset)*time.Minute.Milliseconds()
+		if triggerAt <= now.UnixMilli() {
+			continue
+		}
+		reminders = append(reminders, &personal_schedule.WorkNotification{
+			TriggerAt: triggerAt,
+			IsActive:  true,
+		})
+	}
+	return reminders
+}
+
+// userPersonality describes the preferences of the user for the work generation prompt.
+func userPersonality(user *collection.User) string {
+	locale := user.Locale
+	if locale == "" {
+		locale = user_constant.DEFAULT_LOCALE
+	}
+	parts := []string{
+		fmt.Sprintf("locale: %s", locale),
+		fmt.Sprintf("week starts on %s", preferredWeekStart(user)),
+	}
+	if len(user.WorkingHours) > 0 {
+		hours := make([]string, 0, len(user.WorkingHours))
+		for _, h := range user.WorkingHours {
+			hours = append(hours, fmt.Sprintf("%s %02d:%02d-%02d:%02d", time.Weekday(h.Weekday), h.StartMinute/60, h.StartMinute%60, h.EndMinute/60, h.EndMinute%60))
+		}
+		parts = append(parts, "working hours: "+strings.Join(hours, ", "))
+	}
+	if user.DailyCapacityHours > 0 {
+		parts = append(parts, fmt.Sprintf("at most %g hours of work per day", user.DailyCapacityHours))
+	}
+	return strings.Join(parts, "; ")
+}
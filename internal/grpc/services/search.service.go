@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	search_constant "personal_schedule_service/internal/constant/search"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/repos"
+	app_error "personal_schedule_service/pkg/settings/error"
+	"personal_schedule_service/proto/common"
+	"personal_schedule_service/proto/personal_schedule"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/thanvuc/go-core-lib/log"
+	"go.uber.org/zap"
+)
+
+type searchService struct {
+	logger     log.Logger
+	searchRepo repos.SearchRepo
+}
+
+// searchEntityTypes are the types searched when the request has none, in the order of their groups.
+var searchEntityTypes = []personal_schedule.SearchEntityType{
+	personal_schedule.SearchEntityType_SEARCH_ENTITY_TYPE_WORK,
+	personal_schedule.SearchEntityType_SEARCH_ENTITY_TYPE_GOAL,
+	personal_schedule.SearchEntityType_SEARCH_ENTITY_TYPE_GOAL_TASK,
+	personal_schedule.SearchEntityType_SEARCH_ENTITY_TYPE_SUB_TASK,
+}
+
+func (s *searchService) Search(ctx context.Context, req *personal_schedule.SearchRequest) (*personal_schedule.SearchResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	words := searchWords(req.Query)
+	if len(words) == 0 {
+		return &personal_schedule.SearchResponse{
+			Groups: []*personal_schedule.SearchGroup{},
+			Error:  utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidSearchQuery, fmt.Errorf("search query has no word")),
+		}, nil
+	}
+
+	types := searchEntityTypes
+	if len(req.Types) > 0 {
+		types = make([]personal_schedule.SearchEntityType, 0, len(req.Types))
+		for _, entityType := range req.Types {
+			if !slices.Contains(searchEntityTypes, entityType) {
+				return &personal_schedule.SearchResponse{
+					Groups: []*personal_schedule.SearchGroup{},
+					Error:  utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidSearchQuery, fmt.Errorf("unknown search entity type %d", entityType)),
+				}, nil
+			}
+			if !slices.Contains(types, entityType) {
+				types = append(types, entityType)
+			}
+		}
+	}
+
+	limit := int64(req.Limit)
+	if limit <= 0 {
+		limit = search_constant.DEFAULT_SEARCH_LIMIT
+	}
+	limit = min(limit, search_constant.MAX_SEARCH_LIMIT)
+
+	// the accented words match the descriptions, which are not normalized, the folded ones the normalized names
+	terms := make(map[string]bool, len(words))
+	var searchTerms []string
+	for _, word := range words {
+		normalized := utils.RemoveAccent(word)
+		terms[normalized] = true
+		for _, term := range []string{word, normalized} {
+			if !slices.Contains(searchTerms, term) {
+				searchTerms = append(searchTerms, term)
+			}
+		}
+	}
+	search := strings.Join(searchTerms, " ")
+
+	groups := make([]*personal_schedule.SearchGroup, 0, len(types))
+	for _, entityType := range types {
+		results, err := s.searchEntity(ctx, entityType, req.UserId, search, limit)
+		if err != nil {
+			s.logger.Error("Failed to search", requestID, zap.String("type", entityType.String()), zap.Error(err))
+			return &personal_schedule.SearchResponse{
+				Groups: []*personal_schedule.SearchGroup{},
+				Error:  utils.DatabaseError(ctx, err),
+			}, nil
+		}
+
+		hits := make([]*personal_schedule.SearchHit, 0, len(results))
+		for _, result := range results {
+			hits = append(hits, searchHit(result, terms))
+		}
+		groups = append(groups, &personal_schedule.SearchGroup{
+			Type: entityType,
+			Hits: hits,
+		})
+	}
+
+	return &personal_schedule.SearchResponse{
+		Groups: groups,
+	}, nil
+}
+
+func (s *searchService) searchEntity(ctx context.Context, entityType personal_schedule.SearchEntityType, userID string, search string, limit int64) ([]repos.SearchResult, error) {
+	switch entityType {
+	case personal_schedule.SearchEntityType_SEARCH_ENTITY_TYPE_WORK:
+		return s.searchRepo.SearchWorks(ctx, userID, search, limit)
+	case personal_schedule.SearchEntityType_SEARCH_ENTITY_TYPE_GOAL:
+		return s.searchRepo.SearchGoals(ctx, userID, search, limit)
+	case personal_schedule.SearchEntityType_SEARCH_ENTITY_TYPE_GOAL_TASK:
+		return s.searchRepo.SearchGoalTasks(ctx, userID, search, limit)
+	case personal_schedule.SearchEntityType_SEARCH_ENTITY_TYPE_SUB_TASK:
+		return s.searchRepo.SearchSubTasks(ctx, userID, search, limit)
+	}
+	return nil, fmt.Errorf("unknown search entity type %d", entityType)
+}
+
+func searchHit(result repos.SearchResult, terms map[string]bool) *personal_schedule.SearchHit {
+	hit := &personal_schedule.SearchHit{
+		Id:         result.ID.Hex(),
+		Name:       result.Name,
+		Score:      result.Score,
+		Highlights: []*personal_schedule.SearchHighlight{},
+		ParentName: result.ParentName,
+	}
+	if result.ParentID != nil {
+		parentID := result.ParentID.Hex()
+		hit.ParentId = &parentID
+	}
+	if result.StartDate != nil {
+		startDate := result.StartDate.UnixMilli()
+		hit.StartDate = &startDate
+	}
+	if result.EndDate != nil {
+		endDate := result.EndDate.UnixMilli()
+		hit.EndDate = &endDate
+	}
+
+	if highlight := searchHighlight(search_constant.HIGHLIGHT_FIELD_NAME, result.Name, terms); highlight != nil {
+		hit.Highlights = append(hit.Highlights, highlight)
+	}
+	if result.ShortDescriptions != nil {
+		if highlight := searchHighlight(search_constant.HIGHLIGHT_FIELD_SHORT_DESCRIPTIONS, *result.ShortDescriptions, terms); highlight != nil {
+			hit.Highlights = append(hit.Highlights, highlight)
+		}
+	}
+	return hit
+}
+
+// searchHighlight returns the words of text matching the terms, as $text does, or nil when none does.
+// A text longer than a snippet is cut around its first match.
+func searchHighlight(field string, text string, terms map[string]bool) *personal_schedule.SearchHighlight {
+	runes := []rune(text)
+	var matches []*personal_schedule.SearchMatch
+	start := -1
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && isSearchWordRune(runes[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 && terms[utils.RemoveAccent(string(runes[start:i]))] {
+			matches = append(matches, &personal_schedule.SearchMatch{Start: int32(start), End: int32(i)})
+		}
+		start = -1
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+
+	if len(runes) > search_constant.SNIPPET_RUNES {
+		from := max(0, int(matches[0].Start)-search_constant.SNIPPET_LEAD_RUNES)
+		to := min(len(runes), from+search_constant.SNIPPET_RUNES)
+		from = to - search_constant.SNIPPET_RUNES
+
+		kept := matches[:0]
+		for _, match := range matches {
+			if int(match.Start) >= from && int(match.End) <= to {
+				match.Start -= int32(from)
+				match.End -= int32(from)
+				kept = append(kept, match)
+			}
+		}
+		matches = kept
+		text = string(runes[from:to])
+	}
+
+	return &personal_schedule.SearchHighlight{
+		Field:   field,
+		Text:    text,
+		Matches: matches,
+	}
+}
+
+// searchWords splits the query in lowercase words, its punctuation is dropped so that it is never
+// read as a $text phrase or negation.
+func searchWords(query string) []string {
+	var words []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(query), func(r rune) bool { return !isSearchWordRune(r) }) {
+		if !slices.Contains(words, word) {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+func isSearchWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+}
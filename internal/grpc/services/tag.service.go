@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	tag_constant "personal_schedule_service/internal/constant/tag"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/grpc/validation"
+	"personal_schedule_service/internal/i18n"
+	"personal_schedule_service/internal/repos"
+	app_error "personal_schedule_service/pkg/settings/error"
+	"personal_schedule_service/proto/common"
+	"personal_schedule_service/proto/personal_schedule"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/thanvuc/go-core-lib/log"
+	"github.com/thanvuc/go-core-lib/mongolib"
+	"go.uber.org/zap"
+)
+
+type tagService struct {
+	logger         log.Logger
+	tagRepo        repos.TagRepo
+	mongoConnector *mongolib.MongoConnector
+}
+
+func (s *tagService) ListTags(ctx context.Context, req *personal_schedule.ListTagsRequest) (*personal_schedule.ListTagsResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	tags, err := s.tagRepo.ListTags(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error("Failed to list tags", requestID, zap.Error(err))
+		return &personal_schedule.ListTagsResponse{
+			Tags:  []*personal_schedule.TagUsage{},
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	protoTags := make([]*personal_schedule.TagUsage, 0, len(tags))
+	for _, tag := range tags {
+		protoTags = append(protoTags, &personal_schedule.TagUsage{
+			Name:      tag.Name,
+			WorkCount: tag.WorkCount,
+			GoalCount: tag.GoalCount,
+		})
+	}
+	return &personal_schedule.ListTagsResponse{
+		Tags: protoTags,
+	}, nil
+}
+
+// RenameTag renames a tag on every work and goal of the user, renaming it to an existing tag merges them.
+func (s *tagService) RenameTag(ctx context.Context, req *personal_schedule.RenameTagRequest) (*personal_schedule.RenameTagResponse, error) {
+	sources := utils.NormalizeTags([]string{req.Tag})
+	targets := utils.NormalizeTags([]string{req.NewName})
+	if len(sources) == 0 || len(targets) == 0 {
+		return &personal_schedule.RenameTagResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidTag, fmt.Errorf("tag and new name cannot be empty")),
+		}, nil
+	}
+
+	updatedWorks, updatedGoals, err := s.replaceTags(ctx, sources, targets[0], req.UserId)
+	if err != nil {
+		return &personal_schedule.RenameTagResponse{
+			Error: tagError(ctx, err),
+		}, nil
+	}
+	return &personal_schedule.RenameTagResponse{
+		IsSuccess:    true,
+		Message:      i18n.T(ctx, i18n.MsgTagRenamed),
+		UpdatedWorks: updatedWorks,
+		UpdatedGoals: updatedGoals,
+	}, nil
+}
+
+// MergeTags replaces the source tags by the target tag on every work and goal of the user.
+func (s *tagService) MergeTags(ctx context.Context, req *personal_schedule.MergeTagsRequest) (*personal_schedule.MergeTagsResponse, error) {
+	targets := utils.NormalizeTags([]string{req.TargetTag})
+	if len(targets) == 0 {
+		return &personal_schedule.MergeTagsResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidTag, fmt.Errorf("target tag cannot be empty")),
+		}, nil
+	}
+	sources := slices.DeleteFunc(utils.NormalizeTags(req.SourceTags), func(tag string) bool { return tag == targets[0] })
+	if len(sources) == 0 {
+		return &personal_schedule.MergeTagsResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidTag, fmt.Errorf("source tags must have a tag other than the target")),
+		}, nil
+	}
+
+	updatedWorks, updatedGoals, err := s.replaceTags(ctx, sources, targets[0], req.UserId)
+	if err != nil {
+		return &personal_schedule.MergeTagsResponse{
+			Error: tagError(ctx, err),
+		}, nil
+	}
+	return &personal_schedule.MergeTagsResponse{
+		IsSuccess:    true,
+		Message:      i18n.T(ctx, i18n.MsgTagsMerged),
+		UpdatedWorks: updatedWorks,
+		UpdatedGoals: updatedGoals,
+	}, nil
+}
+
+// replaceTags replaces the normalized source tags by the target in one transaction, a target among the sources is a no-op.
+func (s *tagService) replaceTags(ctx context.Context, sources []string, target string, userID string) (int64, int64, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	if utf8.RuneCountInString(target) > tag_constant.MAX_TAG_LENGTH {
+		return 0, 0, validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidTag, fmt.Sprintf("tag %q is longer than %d characters", target, tag_constant.MAX_TAG_LENGTH))
+	}
+	if slices.Equal(sources, []string{target}) {
+		return 0, 0, nil
+	}
+
+	var updatedWorks, updatedGoals int64
+	err := withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		var err error
+		updatedWorks, updatedGoals, err = s.tagRepo.ReplaceTags(txCtx, userID, sources, target)
+		return err
+	})
+	if err != nil {
+		s.logger.Error("Failed to replace tags", requestID, zap.Strings("sources", sources), zap.String("target", target), zap.Error(err))
+		return 0, 0, err
+	}
+	return updatedWorks, updatedGoals, nil
+}
+
+func tagError(ctx context.Context, err error) *common.Error {
+	var ve *validation.ValidationError
+	if errors.As(err, &ve) {
+		return utils.CustomError(ctx, ve.Category, ve.Code, err)
+	}
+	return utils.DatabaseError(ctx, err)
+}
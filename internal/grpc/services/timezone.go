@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"personal_schedule_service/global"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/repos"
+	app_error "personal_schedule_service/pkg/settings/error"
+	"personal_schedule_service/proto/common"
+	"time"
+)
+
+var errInvalidTimeZone = errors.New("invalid time zone")
+
+// userLocation resolves the time zone of a request: the one given in the request, else the one of the user,
+// else the service default. A user time zone which can no longer be loaded falls back to the default.
+func userLocation(ctx context.Context, userRepo repos.UserRepo, userID string, requested *string) (*time.Location, error) {
+	if requested != nil && *requested != "" {
+		loc, err := time.LoadLocation(*requested)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q", errInvalidTimeZone, *requested)
+		}
+		return loc, nil
+	}
+
+	timeZone, err := userRepo.GetUserTimeZone(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if timeZone == "" {
+		return global.HCMTimeLocation, nil
+	}
+	return utils.LoadLocationOrDefault(timeZone), nil
+}
+
+// timeZoneError maps an error of userLocation to the response error.
+func timeZoneError(ctx context.Context, err error) *common.Error {
+	if errors.Is(err, errInvalidTimeZone) {
+		return utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidTimeZone, err)
+	}
+	return utils.DatabaseError(ctx, err)
+}
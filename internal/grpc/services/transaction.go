@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"personal_schedule_service/internal/collection"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/proto/common"
+	"time"
+
+	"github.com/thanvuc/go-core-lib/mongolib"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// withTransaction runs fn in a Mongo transaction, the repos join it through the context given to fn.
+// fn is retried on transient errors, so it must not publish anything itself: messages go to the outbox.
+func withTransaction(ctx context.Context, connector *mongolib.MongoConnector, fn func(txCtx context.Context) error) error {
+	_, err := connector.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		return nil, fn(txCtx)
+	})
+	return err
+}
+
+// newOutboxEvent builds a pending outbox event, to be inserted in the transaction of the change it announces.
+func newOutboxEvent(ctx context.Context, aggregateType string, aggregateID string, eventType string, payload []byte) collection.OutboxEvent {
+	now := time.Now().UTC()
+	return collection.OutboxEvent{
+		ID:            bson.NewObjectID(),
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        common.OutboxStatus_OUTBOX_STATUS_PENDING.String(),
+		OccurredAt:    now,
+		RequestID:     utils.GetRequestIDFromOutgoingContext(ctx),
+		NextAttemptAt: now,
+	}
+}
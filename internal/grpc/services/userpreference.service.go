@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"personal_schedule_service/internal/grpc/mapper"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/grpc/validation"
+	"personal_schedule_service/internal/repos"
+	"personal_schedule_service/proto/personal_schedule"
+
+	"github.com/thanvuc/go-core-lib/log"
+	"go.uber.org/zap"
+)
+
+type userPreferenceService struct {
+	logger               log.Logger
+	userRepo             repos.UserRepo
+	userPreferenceMapper mapper.UserPreferenceMapper
+	validator            validation.UserPreferenceValidator
+}
+
+func (s *userPreferenceService) GetUserPreference(ctx context.Context, req *personal_schedule.GetUserPreferenceRequest) (*personal_schedule.GetUserPreferenceResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+	user, err := s.userRepo.GetUserByID(ctx, req.UserId)
+	if err != nil {
+		s.logger.Error("Failed to get user", requestID, zap.Error(err))
+		return &personal_schedule.GetUserPreferenceResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	return &personal_schedule.GetUserPreferenceResponse{
+		Preference: s.userPreferenceMapper.MapUserToPreferenceProto(req.UserId, user),
+	}, nil
+}
+
+func (s *userPreferenceService) UpdateUserPreference(ctx context.Context, req *personal_schedule.UpdateUserPreferenceRequest) (*personal_schedule.UpdateUserPreferenceResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+	if err := s.validator.ValidateUpdateUserPreference(ctx, req); err != nil {
+		s.logger.Warn("UpdateUserPreference validation failed", requestID, zap.Error(err))
+		if ve, ok := err.(*validation.ValidationError); ok {
+			return &personal_schedule.UpdateUserPreferenceResponse{
+				Error: utils.CustomError(ctx, ve.Category, ve.Code, err),
+			}, nil
+		}
+		return &personal_schedule.UpdateUserPreferenceResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	user, err := s.userRepo.UpdateUserPreference(ctx, s.userPreferenceMapper.MapUpdateRequestToUser(req))
+	if err != nil {
+		s.logger.Error("Failed to update user preference", requestID, zap.Error(err))
+		return &personal_schedule.UpdateUserPreferenceResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	return &personal_schedule.UpdateUserPreferenceResponse{
+		Preference: s.userPreferenceMapper.MapUserToPreferenceProto(req.UserId, user),
+	}, nil
+}
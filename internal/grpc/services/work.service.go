@@ -4,19 +4,25 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"personal_schedule_service/global"
 	"personal_schedule_service/internal/collection"
 	labels_constant "personal_schedule_service/internal/constant/labels"
-	notifications_constant "personal_schedule_service/internal/constant/notifications"
+	lifecycle_constant "personal_schedule_service/internal/constant/lifecycle"
+	outbox_constant "personal_schedule_service/internal/constant/outbox"
 	workgeneration_constant "personal_schedule_service/internal/constant/work"
+	"personal_schedule_service/internal/grpc/helper"
 	"personal_schedule_service/internal/grpc/mapper"
 	"personal_schedule_service/internal/grpc/models"
 	"personal_schedule_service/internal/grpc/utils"
 	"personal_schedule_service/internal/grpc/validation"
+	"personal_schedule_service/internal/i18n"
+	"personal_schedule_service/internal/recurrence"
 	"personal_schedule_service/internal/repos"
 	app_error "personal_schedule_service/pkg/settings/error"
 	"personal_schedule_service/proto/common"
 	"personal_schedule_service/proto/personal_schedule"
+	"slices"
 	"strings"
 	"time"
 
@@ -34,10 +40,17 @@ import (
 type workService struct {
 	logger            log.Logger
 	workRepo          repos.WorkRepo
+	outboxRepo        repos.OutboxRepo
+	userRepo          repos.UserRepo
+	goalRepo          repos.GoalRepo
+	labelRepo         repos.LabelRepo
 	workMapper        mapper.WorkMapper
 	mongoConnector    *mongolib.MongoConnector
 	validator         validation.WorkValidator
 	eventbusConnector *eventbus.RabbitMQConnector
+	recurrenceHelper  helper.RecurrenceHelper
+	scheduleHelper    helper.ScheduleHelper
+	calendarHelper    helper.CalendarHelper
 }
 
 type recovertTimes struct {
@@ -50,13 +63,41 @@ type recovertTimes struct {
 
 func (s *workService) UpsertWork(ctx context.Context, req *personal_schedule.UpsertWorkRequest) (*personal_schedule.UpsertWorkResponse, error) {
 	requestId := utils.GetRequestIDFromOutgoingContext(ctx)
+	// repeated works follow the wall clock of the user unless the rule names its own time zone
+	if req.Recurrence != nil || req.RepeatStartDate != nil || req.RepeatEndDate != nil {
+		if req.Recurrence == nil {
+			req.Recurrence = &personal_schedule.RecurrenceRule{}
+		}
+		if req.Recurrence.TimeZone == nil || *req.Recurrence.TimeZone == "" {
+			loc, err := userLocation(ctx, s.userRepo, req.UserId, nil)
+			if err != nil {
+				s.logger.Error("Failed to get time zone of user", requestId, zap.Error(err))
+				return &personal_schedule.UpsertWorkResponse{
+					IsSuccess: false,
+					Error:     utils.DatabaseError(ctx, err),
+				}, nil
+			}
+			timeZone := loc.String()
+			req.Recurrence.TimeZone = &timeZone
+		}
+	}
+
 	if err := s.validator.ValidateUpsertWork(ctx, req); err != nil {
 		s.logger.Error("UpsertWork validation failed", requestId, zap.Error(err))
 		if ve, ok := err.(*validation.ValidationError); ok {
-			return &personal_schedule.UpsertWorkResponse{
+			resp := &personal_schedule.UpsertWorkResponse{
 				IsSuccess: false,
 				Error:     utils.CustomError(ctx, ve.Category, ve.Code, err),
-			}, nil
+			}
+			if ve.Code == app_error.TimeOverlap && req.StartDate != nil {
+				candidate := &personal_schedule.CandidateInterval{RefId: req.Id, Start: *req.StartDate, End: req.EndDate, ExcludeWorkId: req.Id}
+				results, err := s.buildScheduleConflicts(ctx, req.UserId, []*personal_schedule.CandidateInterval{candidate}, workgeneration_constant.DEFAULT_CONFLICT_SUGGESTIONS)
+				if err != nil {
+					s.logger.Warn("Failed to build schedule conflicts", requestId, zap.Error(err))
+				}
+				resp.Conflicts = conflictsOnly(results)
+			}
+			return resp, nil
 		}
 	}
 
@@ -81,34 +122,28 @@ func (s *workService) UpsertWork(ctx context.Context, req *personal_schedule.Ups
 	isRepeated := work.TypeID == repeatLabel.ID
 
 	now := time.Now().UTC()
-	if req.Id == nil || *req.Id == "" {
+	isCreate := req.Id == nil || *req.Id == ""
+	var currentDBWork *collection.Work
+	if isCreate {
 		if isRepeated {
-			if req.StartDate == nil || req.EndDate == 0 || req.RepeatStartDate == nil || req.RepeatEndDate == nil {
+			hasRule := req.Recurrence != nil && req.Recurrence.Rrule != ""
+			if req.StartDate == nil || req.EndDate == 0 || (!hasRule && (req.RepeatStartDate == nil || req.RepeatEndDate == nil)) {
 				s.logger.Error("Repeated work must have start_date, end_date ", requestId)
 				return &personal_schedule.UpsertWorkResponse{
 					IsSuccess: false,
 					Error:     utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.RepeatedWorkMissingDates, fmt.Errorf("repeated work must have start_date, end_date")),
 				}, nil
 			}
-			return s.createRepeatedWorks(ctx, work, req, subTasksDB)
+			return s.createRepeatedWorks(ctx, work, req, subTasksDB, nil)
 		}
 
+		work.ID = bson.NewObjectID()
 		work.UserID = req.UserId
 		work.CreatedAt = now
 		work.LastModifiedAt = now
-
-		newID, err := s.workRepo.CreateWork(ctx, work)
-		if err != nil {
-			s.logger.Error("Failed to create work", requestId, zap.Error(err))
-			return &personal_schedule.UpsertWorkResponse{
-				IsSuccess: false, Message: "Failed to create work", Error: utils.DatabaseError(ctx, err),
-			}, err
-		}
-		work.ID = newID
-
 	} else {
 		workID, _ := bson.ObjectIDFromHex(*req.Id)
-		currentDBWork, _ := s.workRepo.GetWorkByID(ctx, workID)
+		currentDBWork, _ = s.workRepo.GetWorkByID(ctx, workID)
 		wasRepeated := currentDBWork != nil && currentDBWork.TypeID == repeatLabel.ID
 		if !wasRepeated && isRepeated {
 			return s.convertNormalToRepeatedUsingCreate(ctx, currentDBWork, req, subTasksDB)
@@ -121,130 +156,403 @@ func (s *workService) UpsertWork(ctx context.Context, req *personal_schedule.Ups
 			}
 
 			if updateType == 2 {
-				return s.updateRepeatedWorksChain(ctx, req.Id, work, subTasksDB)
+				return s.updateRepeatedWorksChain(ctx, req, work, subTasksDB)
 			}
 		}
-		if err := s.workRepo.UpdateWork(ctx, workID, work); err != nil {
-			s.logger.Error("Failed to update work", requestId, zap.Error(err))
-			return &personal_schedule.UpsertWorkResponse{
-				IsSuccess: false, Message: "Failed to update work", Error: utils.DatabaseError(ctx, err),
-			}, err
-		}
 		work.ID = workID
 	}
-	if err := s.syncSubTasks(ctx, work.ID, subTasksDB); err != nil {
-		s.logger.Error("Failed to sync sub-tasks", requestId, zap.Error(err))
-		return &personal_schedule.UpsertWorkResponse{
-			IsSuccess: false, Message: "Failed to sync sub-tasks (Work was upserted but tasks failed)", Error: utils.DatabaseError(ctx, err),
-		}, err
+
+	// a new work without reminders gets the default reminders of the user
+	if isCreate && len(req.Notifications) == 0 && req.StartDate != nil {
+		user, err := userPreferences(ctx, s.userRepo, req.UserId)
+		if err != nil {
+			s.logger.Error("Failed to get preferences of user", requestId, zap.Error(err))
+			return &personal_schedule.UpsertWorkResponse{
+				IsSuccess: false,
+				Error:     utils.DatabaseError(ctx, err),
+			}, nil
+		}
+		req.Notifications = defaultReminders(user, *req.StartDate, now)
 	}
 
+	var notificationEvent *collection.OutboxEvent
 	if len(req.Notifications) > 0 {
-		if err := s.sendNotificationEvent(ctx, req, work.ID.Hex()); err != nil {
-			s.logger.Error("Failed to send notification event", requestId, zap.Error(err))
+		notificationEvent, err = s.buildNotificationEvent(ctx, req, work.ID.Hex())
+		if err != nil {
+			s.logger.Error("Failed to build notification event", requestId, zap.Error(err))
 			return &personal_schedule.UpsertWorkResponse{
 				IsSuccess: false,
-				Message:   "Failed to send notification event (Work was upserted but notification failed)",
+				Message:   i18n.T(ctx, i18n.MsgNotificationEventFailed),
 				Error:     utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.NotificationCannotBeSent, err),
 			}, err
 		}
 	}
 
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		if isCreate {
+			if _, err := s.workRepo.CreateWork(txCtx, work); err != nil {
+				return err
+			}
+			if err := s.insertWorkLifecycleEvents(txCtx, lifecycle_constant.WORK_CREATED, nil, work, req.UserId); err != nil {
+				return err
+			}
+		} else {
+			if err := s.workRepo.UpdateWork(txCtx, work.ID, work); err != nil {
+				return err
+			}
+			if currentDBWork != nil {
+				if err := s.recordWorkLabelChanges(txCtx, currentDBWork, work, req.UserId); err != nil {
+					return err
+				}
+			}
+			if err := s.insertWorkLifecycleEvents(txCtx, lifecycle_constant.WORK_UPDATED, currentDBWork, work, req.UserId); err != nil {
+				return err
+			}
+		}
+		if err := s.syncSubTasks(txCtx, work.ID, subTasksDB); err != nil {
+			return err
+		}
+		if notificationEvent != nil {
+			return s.outboxRepo.InsertOutboxEvents(txCtx, []interface{}{notificationEvent})
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to upsert work", requestId, zap.Error(err))
+		return &personal_schedule.UpsertWorkResponse{
+			IsSuccess: false, Message: i18n.T(ctx, i18n.MsgWorkUpsertFailed), Error: utils.DatabaseError(ctx, err),
+		}, err
+	}
+
 	return &personal_schedule.UpsertWorkResponse{
 		IsSuccess: true,
-		Message:   "Work upserted successfully",
+		Message:   i18n.T(ctx, i18n.MsgWorkUpserted),
 	}, nil
 }
 
-func (s *workService) createRepeatedWorks(ctx context.Context, baseWork *collection.Work, req *personal_schedule.UpsertWorkRequest, baseSubTasks []collection.SubTask) (*personal_schedule.UpsertWorkResponse, error) {
-	tTimeStart := time.UnixMilli(*req.StartDate).UTC()
-	tTimeEnd := time.UnixMilli(req.EndDate).UTC()
-
-	hour, min, sec := tTimeStart.Clock()
+func (s *workService) createRepeatedWorks(ctx context.Context, baseWork *collection.Work, req *personal_schedule.UpsertWorkRequest, baseSubTasks []collection.SubTask, skipDay *time.Time) (*personal_schedule.UpsertWorkResponse, error) {
+	requestId := utils.GetRequestIDFromOutgoingContext(ctx)
+	if req.StartDate == nil || req.EndDate <= *req.StartDate {
+		return &personal_schedule.UpsertWorkResponse{
+			IsSuccess: false,
+			Message:   i18n.T(ctx, i18n.MsgWorkEndBeforeStart),
+		}, nil
+	}
 
-	duration := tTimeEnd.Sub(tTimeStart)
-	if duration <= 0 {
+	rec, err := s.recurrenceHelper.BuildFromUpsertRequest(req)
+	if err != nil {
+		s.logger.Error("Invalid recurrence rule", requestId, zap.Error(err))
 		return &personal_schedule.UpsertWorkResponse{
 			IsSuccess: false,
-			Message:   "End Time must be after Start Time",
+			Error:     utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidRecurrenceRule, err),
 		}, nil
 	}
 
-	loopDate := time.UnixMilli(*req.RepeatStartDate).UTC()
-	limitDate := time.UnixMilli(*req.RepeatEndDate).UTC()
+	// the day of the converted normal work is already taken by the work itself
+	if skipDay != nil {
+		y, m, d := skipDay.In(rec.Location).Date()
+		dayStart := time.Date(y, m, d, 0, 0, 0, 0, rec.Location)
+		rec.ExDates = append(rec.ExDates, rec.Between(dayStart, dayStart.AddDate(0, 0, 1))...)
+	}
 
-	if utils.TruncateToDay(limitDate).Before(utils.TruncateToDay(loopDate)) {
-		return &personal_schedule.UpsertWorkResponse{IsSuccess: false,
-			Message: "Repeat End Date must be after Start Date",
+	if _, err := s.recurrenceHelper.Occurrences(rec); err != nil {
+		s.logger.Error("Invalid recurrence rule", requestId, zap.Error(err))
+		return &personal_schedule.UpsertWorkResponse{
+			IsSuccess: false,
+			Error:     utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidRecurrenceRule, err),
 		}, nil
 	}
 
-	repeatedID := bson.NewObjectID()
-	var worksToInsert []interface{}
-	var subTasksToInsert []interface{}
+	duration := time.UnixMilli(req.EndDate).Sub(time.UnixMilli(*req.StartDate))
+	var created int
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		created, err = s.insertSeriesWorks(txCtx, baseWork, req.UserId, rec, duration, baseSubTasks, nil)
+		return err
+	})
+	if err != nil {
+		s.logger.Error("Failed to create repeated works", requestId, zap.Error(err))
+		return &personal_schedule.UpsertWorkResponse{IsSuccess: false, Error: utils.DatabaseError(ctx, err)}, nil
+	}
+
+	return &personal_schedule.UpsertWorkResponse{
+		IsSuccess: true,
+		Message:   i18n.T(ctx, i18n.MsgRepeatedWorksCreated, created),
+	}, nil
+}
+
+// insertSeriesWorks stores the series definition and materializes its works up to the horizon,
+// later occurrences are created by MaterializeRepeatedWorks.
+// previousSeriesID is the series this one was split from, it turns the series.created event into series.split.
+func (s *workService) insertSeriesWorks(ctx context.Context, baseWork *collection.Work, userID string, rec *recurrence.Recurrence, duration time.Duration, baseSubTasks []collection.SubTask, previousSeriesID *bson.ObjectID) (int, error) {
 	now := time.Now().UTC()
 
-	for !utils.TruncateToDay(loopDate).After(utils.TruncateToDay(limitDate)) {
-		y, m, d := loopDate.Date()
-		thisWorkStart := time.Date(y, m, d, hour, min, sec, 0, time.UTC)
-		thisWorkEnd := thisWorkStart.Add(duration)
+	exDates := make([]time.Time, 0, len(rec.ExDates))
+	for _, ex := range rec.ExDates {
+		exDates = append(exDates, ex.UTC())
+	}
+	series := &collection.RepeatedSeries{
+		ID:                bson.NewObjectID(),
+		UserID:            userID,
+		RRule:             rec.Rule.String(),
+		DTStart:           rec.DTStart.UTC(),
+		DurationMs:        duration.Milliseconds(),
+		ExDates:           exDates,
+		TimeZone:          rec.Location.String(),
+		Template:          newSeriesTemplate(baseWork, baseSubTasks),
+		MaterializedUntil: rec.DTStart.UTC(),
+		EndsAt:            s.recurrenceHelper.EndsAt(rec),
+		CreatedAt:         now,
+		LastModifiedAt:    now,
+	}
+	if err := s.workRepo.CreateRepeatedSeries(ctx, series); err != nil {
+		return 0, err
+	}
+
+	created, err := s.materializeSeries(ctx, series, rec, rec.DTStart, s.recurrenceHelper.HorizonEnd(rec.Location))
+	if err != nil {
+		return 0, err
+	}
+
+	eventType := utils.Ternary(previousSeriesID != nil, lifecycle_constant.SERIES_SPLIT, lifecycle_constant.SERIES_CREATED)
+	event, err := newSeriesLifecycleEvent(ctx, eventType, series, userID, created, previousSeriesID)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.outboxRepo.InsertOutboxEvents(ctx, []interface{}{event}); err != nil {
+		return 0, err
+	}
+	return created, nil
+}
+
+func newSeriesTemplate(work *collection.Work, subTasks []collection.SubTask) collection.RepeatedSeriesTemplate {
+	subTaskNames := make([]string, 0, len(subTasks))
+	for _, sub := range subTasks {
+		subTaskNames = append(subTaskNames, sub.Name)
+	}
+	return collection.RepeatedSeriesTemplate{
+		Name:                work.Name,
+		NameNormalized:      work.NameNormalized,
+		ShortDescriptions:   work.ShortDescriptions,
+		DetailedDescription: work.DetailedDescription,
+		StatusID:            work.StatusID,
+		DifficultyID:        work.DifficultyID,
+		PriorityID:          work.PriorityID,
+		TypeID:              work.TypeID,
+		CategoryID:          work.CategoryID,
+		DraftID:             work.DraftID,
+		GoalID:              work.GoalID,
+		SubTasks:            subTaskNames,
+		Tags:                work.Tags,
+	}
+}
 
-		newWork := *baseWork
-		newWork.ID = bson.NewObjectID()
-		newWork.RepeatedID = &repeatedID
-		newWork.UserID = req.UserId
-		newWork.CreatedAt = now
-		newWork.LastModifiedAt = now
+// materializeSeries creates the works of the occurrences starting in [from, to) which do not exist yet.
+// It is idempotent, re-running it for the same window inserts nothing.
+func (s *workService) materializeSeries(ctx context.Context, series *collection.RepeatedSeries, rec *recurrence.Recurrence, from, to time.Time) (int, error) {
+	if !to.After(from) {
+		return 0, nil
+	}
 
-		newWork.StartDate = &thisWorkStart
-		newWork.EndDate = thisWorkEnd
+	now := time.Now().UTC()
+	template := series.Template
 
-		worksToInsert = append(worksToInsert, newWork)
+	var works []collection.Work
+	for _, occ := range rec.Between(from, to) {
+		works = append(works, seriesOccurrence(series, occ, now))
+	}
+
+	insertedIDs, err := s.workRepo.UpsertRepeatedWorks(ctx, works)
+	if err != nil {
+		return 0, err
+	}
 
-		for _, sub := range baseSubTasks {
-			newSub := sub
-			newSub.ID = bson.NewObjectID()
-			newSub.WorkID = newWork.ID
-			newSub.CreatedAt = now
-			newSub.LastModifiedAt = now
-			subTasksToInsert = append(subTasksToInsert, newSub)
+	var subTasksToInsert []interface{}
+	for _, workID := range insertedIDs {
+		for _, name := range template.SubTasks {
+			subTasksToInsert = append(subTasksToInsert, collection.SubTask{
+				ID:             bson.NewObjectID(),
+				Name:           name,
+				NameNormalized: utils.RemoveAccent(name),
+				WorkID:         workID,
+				CreatedAt:      now,
+				LastModifiedAt: now,
+			})
 		}
+	}
+	if err := s.workRepo.BulkInsertSubTasks(ctx, subTasksToInsert); err != nil {
+		return 0, err
+	}
 
-		loopDate = loopDate.AddDate(0, 0, 1)
+	// only a window contiguous with the materialized part moves the mark forward
+	if !from.After(series.MaterializedUntil) && to.After(series.MaterializedUntil) {
+		if err := s.workRepo.AdvanceRepeatedSeriesMaterializedUntil(ctx, series.ID, to); err != nil {
+			return 0, err
+		}
 	}
 
-	if len(worksToInsert) > 0 {
-		if err := s.workRepo.BulkInsertWorks(ctx, worksToInsert); err != nil {
-			return &personal_schedule.UpsertWorkResponse{IsSuccess: false, Error: utils.DatabaseError(ctx, err)}, nil
+	return len(insertedIDs), nil
+}
+
+// seriesOccurrence builds the work of the occurrence of the series starting at occ from its template.
+func seriesOccurrence(series *collection.RepeatedSeries, occ time.Time, now time.Time) collection.Work {
+	template := series.Template
+	start := occ.UTC()
+	recurrenceID := start
+	return collection.Work{
+		ID:                  bson.NewObjectID(),
+		Name:                template.Name,
+		NameNormalized:      template.NameNormalized,
+		ShortDescriptions:   template.ShortDescriptions,
+		DetailedDescription: template.DetailedDescription,
+		StartDate:           &start,
+		EndDate:             start.Add(time.Duration(series.DurationMs) * time.Millisecond),
+		StatusID:            template.StatusID,
+		DifficultyID:        template.DifficultyID,
+		PriorityID:          template.PriorityID,
+		TypeID:              template.TypeID,
+		CategoryID:          template.CategoryID,
+		DraftID:             template.DraftID,
+		UserID:              series.UserID,
+		GoalID:              template.GoalID,
+		Tags:                template.Tags,
+		RepeatedID:          &series.ID,
+		RecurrenceID:        &recurrenceID,
+		CreatedAt:           now,
+		LastModifiedAt:      now,
+	}
+}
+
+// MaterializeRepeatedWorks creates the works of every series up to the rolling horizon.
+func (s *workService) MaterializeRepeatedWorks(ctx context.Context) error {
+	horizon := time.Now().UTC().AddDate(0, 0, workgeneration_constant.REPEATED_WORK_HORIZON_DAYS)
+	seriesList, err := s.workRepo.GetRepeatedSeriesBehind(ctx, "", horizon)
+	if err != nil {
+		return err
+	}
+
+	created := 0
+	for i := range seriesList {
+		series := &seriesList[i]
+		rec, err := s.recurrenceHelper.BuildFromSeries(series)
+		if err != nil {
+			s.logger.Error("Invalid repeated series", "", zap.String("repeated_id", series.ID.Hex()), zap.Error(err))
+			continue
 		}
+		var n int
+		err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+			n, err = s.materializeSeries(txCtx, series, rec, series.MaterializedUntil, s.recurrenceHelper.HorizonEnd(rec.Location))
+			return err
+		})
+		if err != nil {
+			s.logger.Error("Failed to materialize repeated series", "", zap.String("repeated_id", series.ID.Hex()), zap.Error(err))
+			continue
+		}
+		created += n
 	}
-	if len(subTasksToInsert) > 0 {
-		_ = s.workRepo.BulkInsertSubTasks(ctx, subTasksToInsert)
+
+	s.logger.Info("Materialized repeated works", "", zap.Int("series", len(seriesList)), zap.Int("created", created))
+	return nil
+}
+
+// expandRepeatedSeries materializes the occurrences of the user's series which are behind the horizon
+// and start before the end of the queried range, when the cronjob has not reached them yet.
+// A read never materializes an occurrence beyond the horizon, see virtualOccurrences for those.
+func (s *workService) expandRepeatedSeries(ctx context.Context, userID string, to time.Time) error {
+	// the horizon of the furthest time zone ends at most a day after the one of UTC
+	horizon := s.recurrenceHelper.HorizonEnd(time.UTC).AddDate(0, 0, 1)
+	if to.After(horizon) {
+		to = horizon
+	}
+	seriesList, err := s.workRepo.GetRepeatedSeriesBehind(ctx, userID, to)
+	if err != nil {
+		return err
 	}
 
-	return &personal_schedule.UpsertWorkResponse{
-		IsSuccess: true,
-		Message:   fmt.Sprintf("Created %d repeated works", len(worksToInsert)),
-	}, nil
+	for i := range seriesList {
+		series := &seriesList[i]
+		rec, err := s.recurrenceHelper.BuildFromSeries(series)
+		if err != nil {
+			return err
+		}
+		// the window stays contiguous with the materialized part, so materialized_until bounds every work of the series
+		windowEnd := to
+		if seriesHorizon := s.recurrenceHelper.HorizonEnd(rec.Location); windowEnd.After(seriesHorizon) {
+			windowEnd = seriesHorizon
+		}
+		if !windowEnd.After(series.MaterializedUntil) {
+			continue
+		}
+		err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+			_, err := s.materializeSeries(txCtx, series, rec, series.MaterializedUntil, windowEnd)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (s *workService) updateRepeatedWorksChain(ctx context.Context, currentWorkIDStr *string, inputWork *collection.Work, inputSubTasks []collection.SubTask) (*personal_schedule.UpsertWorkResponse, error) {
+// virtualOccurrences returns the unsaved works of the occurrences of the user's series overlapping the range
+// which are not materialized, so the busy time beyond the horizon is known without writing it.
+func (s *workService) virtualOccurrences(ctx context.Context, userID string, from, to time.Time) ([]collection.Work, error) {
+	seriesList, err := s.workRepo.GetRepeatedSeriesBehind(ctx, userID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	var works []collection.Work
+	for i := range seriesList {
+		series := &seriesList[i]
+		rec, err := s.recurrenceHelper.BuildFromSeries(series)
+		if err != nil {
+			return nil, err
+		}
+		start := from.Add(-time.Duration(series.DurationMs) * time.Millisecond)
+		if start.Before(series.MaterializedUntil) {
+			start = series.MaterializedUntil
+		}
+		for _, occ := range rec.Between(start, to) {
+			works = append(works, seriesOccurrence(series, occ, now))
+		}
+	}
+	return works, nil
+}
+
+func (s *workService) updateRepeatedWorksChain(ctx context.Context, req *personal_schedule.UpsertWorkRequest, inputWork *collection.Work, inputSubTasks []collection.SubTask) (*personal_schedule.UpsertWorkResponse, error) {
+	currentWorkIDStr := req.Id
 	currID, _ := bson.ObjectIDFromHex(*currentWorkIDStr)
 	currentDBWork, err := s.workRepo.GetWorkByID(ctx, currID)
 	if err != nil || currentDBWork == nil {
 		return &personal_schedule.UpsertWorkResponse{
 			IsSuccess: false,
-			Message:   "Work not found",
+			Message:   i18n.T(ctx, i18n.MsgWorkNotFound),
 		}, nil
 	}
 
 	if currentDBWork.RepeatedID == nil {
 		return &personal_schedule.UpsertWorkResponse{
 			IsSuccess: false,
-			Message:   "This work is not part of a repeated chain",
+			Message:   i18n.T(ctx, i18n.MsgWorkNotRepeated),
 		}, nil
 	}
 
+	if inputWork.StartDate == nil || currentDBWork.StartDate == nil {
+		return &personal_schedule.UpsertWorkResponse{
+			IsSuccess: false,
+			Message:   i18n.T(ctx, i18n.MsgRepeatedWorkMissingStart),
+		}, nil
+	}
+
+	series, err := s.workRepo.GetRepeatedSeriesByID(ctx, *currentDBWork.RepeatedID)
+	if err != nil {
+		return nil, err
+	}
+	if series != nil && s.isSeriesChanged(series, req, inputWork) {
+		return s.splitRepeatedSeries(ctx, req, currentDBWork, series, inputWork, inputSubTasks)
+	}
+
 	futureWorks, err := s.workRepo.GetFutureRepeatedWorks(ctx, *currentDBWork.RepeatedID, *currentDBWork.StartDate)
 	if err != nil {
 		return nil, err
@@ -255,55 +563,50 @@ func (s *workService) updateRepeatedWorksChain(ctx context.Context, currentWorkI
 	}
 	shouldUpdateSubTasks := isSubTasksChanged(currentDBSubTasks, inputSubTasks)
 
-	newBaseStart := *inputWork.StartDate
-	newBaseEnd := inputWork.EndDate
-	newDuration := newBaseEnd.Sub(newBaseStart)
-	h, m, sec := newBaseStart.Clock()
+	newDuration := inputWork.EndDate.Sub(*inputWork.StartDate)
 
 	var writeModels []mongo.WriteModel
 	var futureWorkIDs []bson.ObjectID
+	updatedWorks := make([]collection.Work, 0, len(futureWorks))
 
 	for _, fw := range futureWorks {
 		futureWorkIDs = append(futureWorkIDs, fw.ID)
-		y, month, d := fw.StartDate.Date()
-
-		updatedStart := time.Date(y, month, d, h, m, sec, 0, newBaseStart.Location())
-		updatedEnd := updatedStart.Add(newDuration)
-
-		update := bson.M{
-			"$set": bson.M{
-				"name":                 inputWork.Name,
-				"short_descriptions":   inputWork.ShortDescriptions,
-				"detailed_description": inputWork.DetailedDescription,
-				"status_id":            inputWork.StatusID,
-				"difficulty_id":        inputWork.DifficultyID,
-				"priority_id":          inputWork.PriorityID,
-				"type_id":              inputWork.TypeID,
-				"category_id":          inputWork.CategoryID,
-				"goal_id":              inputWork.GoalID,
-				"start_date":           updatedStart,
-				"end_date":             updatedEnd,
-				"last_modified_at":     time.Now().UTC(),
-			},
+		updatedWork := fw
+		updatedWork.StatusID = inputWork.StatusID
+		updatedWork.DifficultyID = inputWork.DifficultyID
+		updatedWork.PriorityID = inputWork.PriorityID
+		updatedWork.TypeID = inputWork.TypeID
+		updatedWork.CategoryID = inputWork.CategoryID
+		updatedWorks = append(updatedWorks, updatedWork)
+
+		updates := bson.M{
+			"name":                 inputWork.Name,
+			"short_descriptions":   inputWork.ShortDescriptions,
+			"detailed_description": inputWork.DetailedDescription,
+			"status_id":            inputWork.StatusID,
+			"difficulty_id":        inputWork.DifficultyID,
+			"priority_id":          inputWork.PriorityID,
+			"type_id":              inputWork.TypeID,
+			"category_id":          inputWork.CategoryID,
+			"goal_id":              inputWork.GoalID,
+			"tags":                 inputWork.Tags,
+			"last_modified_at":     time.Now().UTC(),
+		}
+		// the time of the series is unchanged on this path, every occurrence goes back to its own start,
+		// which already keeps the wall clock of the series across DST changes
+		if series != nil && fw.RecurrenceID != nil {
+			updates["start_date"] = *fw.RecurrenceID
+			updates["end_date"] = fw.RecurrenceID.Add(newDuration)
 		}
+		update := bson.M{"$set": updates}
 
 		model := mongo.NewUpdateOneModel().SetFilter(bson.M{"_id": fw.ID}).SetUpdate(update)
 		writeModels = append(writeModels, model)
 	}
 
-	if len(writeModels) > 0 {
-		if err := s.workRepo.BulkUpdateWorks(ctx, writeModels); err != nil {
-			return nil, err
-		}
-	}
+	var newSubTasksToInsert []interface{}
 	if shouldUpdateSubTasks {
-		if err := s.workRepo.DeleteSubTasksByWorkIDs(ctx, futureWorkIDs); err != nil {
-			return nil, err
-		}
-
-		var newSubTasksToInsert []interface{}
 		now := time.Now().UTC()
-
 		for _, workID := range futureWorkIDs {
 			for _, templateSub := range inputSubTasks {
 				newSub := templateSub
@@ -315,30 +618,171 @@ func (s *workService) updateRepeatedWorksChain(ctx context.Context, currentWorkI
 				newSubTasksToInsert = append(newSubTasksToInsert, newSub)
 			}
 		}
+	}
 
-		if len(newSubTasksToInsert) > 0 {
-			_ = s.workRepo.BulkInsertSubTasks(ctx, newSubTasksToInsert)
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		if len(writeModels) > 0 {
+			if err := s.workRepo.BulkUpdateWorks(txCtx, writeModels); err != nil {
+				return err
+			}
 		}
+		for i := range futureWorks {
+			if err := s.recordWorkLabelChanges(txCtx, &futureWorks[i], &updatedWorks[i], req.UserId); err != nil {
+				return err
+			}
+		}
+		// occurrences materialized later must carry the updated fields as well
+		if series != nil {
+			updatedSeries := *series
+			updatedSeries.Template = newSeriesTemplate(inputWork, inputSubTasks)
+			if err := s.workRepo.UpdateRepeatedSeriesTemplate(txCtx, series.ID, updatedSeries.Template); err != nil {
+				return err
+			}
+			event, err := newSeriesLifecycleEvent(txCtx, lifecycle_constant.SERIES_UPDATED, &updatedSeries, req.UserId, len(writeModels), nil)
+			if err != nil {
+				return err
+			}
+			if err := s.outboxRepo.InsertOutboxEvents(txCtx, []interface{}{event}); err != nil {
+				return err
+			}
+		}
+		if shouldUpdateSubTasks {
+			if err := s.workRepo.DeleteSubTasksByWorkIDs(txCtx, futureWorkIDs); err != nil {
+				return err
+			}
+			if err := s.workRepo.BulkInsertSubTasks(txCtx, newSubTasksToInsert); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &personal_schedule.UpsertWorkResponse{
 		IsSuccess: true,
-		Message:   fmt.Sprintf("Updated %d works in chain", len(writeModels)),
+		Message:   i18n.T(ctx, i18n.MsgRepeatedWorksUpdated, len(writeModels)),
 	}, nil
 }
 
-func (s *workService) convertNormalToRepeatedUsingCreate(ctx context.Context, baseWork *collection.Work, req *personal_schedule.UpsertWorkRequest, baseSubTasks []collection.SubTask) (*personal_schedule.UpsertWorkResponse, error) {
-	if req.RepeatStartDate != nil {
-		d := time.UnixMilli(*req.RepeatStartDate).UTC()
-		cur := time.UnixMilli(*req.StartDate).UTC()
+// isSeriesChanged reports whether the update changes the recurrence itself (rule, time of day or duration),
+// which cannot be applied in place on the materialized works.
+func (s *workService) isSeriesChanged(series *collection.RepeatedSeries, req *personal_schedule.UpsertWorkRequest, inputWork *collection.Work) bool {
+	if req.Recurrence != nil && req.Recurrence.Rrule != "" {
+		rule, err := recurrence.Parse(req.Recurrence.Rrule)
+		if err == nil && rule.String() != series.RRule {
+			return true
+		}
+	}
+
+	rec, err := s.recurrenceHelper.BuildFromSeries(series)
+	if err != nil {
+		return false
+	}
+	newStart := inputWork.StartDate.In(rec.Location)
+	if newStart.Hour() != rec.DTStart.Hour() || newStart.Minute() != rec.DTStart.Minute() || newStart.Second() != rec.DTStart.Second() {
+		return true
+	}
+	return inputWork.EndDate.Sub(*inputWork.StartDate).Milliseconds() != series.DurationMs
+}
+
+// splitRepeatedSeries ends the current series right before the edited work
+// and starts a new series from it with the updated rule and times.
+func (s *workService) splitRepeatedSeries(ctx context.Context, req *personal_schedule.UpsertWorkRequest, currentDBWork *collection.Work, series *collection.RepeatedSeries, inputWork *collection.Work, inputSubTasks []collection.SubTask) (*personal_schedule.UpsertWorkResponse, error) {
+	requestId := utils.GetRequestIDFromOutgoingContext(ctx)
+	oldRec, err := s.recurrenceHelper.BuildFromSeries(series)
+	if err != nil {
+		return nil, err
+	}
+
+	newReq := proto.Clone(req).(*personal_schedule.UpsertWorkRequest)
+	newReq.RepeatStartDate = nil
+	if newReq.Recurrence == nil || newReq.Recurrence.Rrule == "" {
+		rule := *oldRec.Rule
+		if rule.Count > 0 {
+			// the remaining occurrences keep the end of the original series
+			if last, ok := oldRec.Last(); ok {
+				until := time.Date(last.Year(), last.Month(), last.Day(), 23, 59, 59, 0, oldRec.Location).UTC()
+				rule.Count = 0
+				rule.Until = &until
+			}
+		}
+		timeZone := series.TimeZone
+		newReq.Recurrence = &personal_schedule.RecurrenceRule{
+			Rrule:    rule.String(),
+			TimeZone: &timeZone,
+		}
+	}
+
+	newRec, err := s.recurrenceHelper.BuildFromUpsertRequest(newReq)
+	if err != nil {
+		return &personal_schedule.UpsertWorkResponse{
+			IsSuccess: false,
+			Error:     utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidRecurrenceRule, err),
+		}, nil
+	}
+	if _, err := s.recurrenceHelper.Occurrences(newRec); err != nil {
+		return &personal_schedule.UpsertWorkResponse{
+			IsSuccess: false,
+			Error:     utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidRecurrenceRule, err),
+		}, nil
+	}
+
+	splitAt := *currentDBWork.StartDate
+	if currentDBWork.RecurrenceID != nil {
+		splitAt = *currentDBWork.RecurrenceID
+	}
+	truncated := *oldRec.Rule
+	until := splitAt.Add(-time.Second).UTC()
+	truncated.Count = 0
+	truncated.Until = &until
+	truncatedRec := &recurrence.Recurrence{Rule: &truncated, DTStart: oldRec.DTStart, ExDates: oldRec.ExDates, Location: oldRec.Location}
+	duration := inputWork.EndDate.Sub(*inputWork.StartDate)
+
+	var created int
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		if err := s.workRepo.UpdateRepeatedSeriesRule(txCtx, series.ID, truncated.String(), s.recurrenceHelper.EndsAt(truncatedRec)); err != nil {
+			return err
+		}
 
-		if utils.TruncateToDay(d).Equal(utils.TruncateToDay(cur)) {
-			next := d.AddDate(0, 0, 1).UnixMilli()
-			req.RepeatStartDate = &next
+		futureWorks, err := s.workRepo.GetFutureRepeatedWorks(txCtx, series.ID, *currentDBWork.StartDate)
+		if err != nil {
+			return err
+		}
+		futureWorkIDs := make([]bson.ObjectID, 0, len(futureWorks))
+		for _, fw := range futureWorks {
+			futureWorkIDs = append(futureWorkIDs, fw.ID)
+		}
+		if err := s.workRepo.DeleteSubTasksByWorkIDs(txCtx, futureWorkIDs); err != nil {
+			return err
 		}
+		if err := s.workRepo.DeleteWorksByIDs(txCtx, futureWorkIDs); err != nil {
+			return err
+		}
+
+		created, err = s.insertSeriesWorks(txCtx, inputWork, req.UserId, newRec, duration, inputSubTasks, &series.ID)
+		return err
+	})
+	if err != nil {
+		s.logger.Error("Failed to split repeated series", requestId, zap.Error(err))
+		return nil, err
 	}
 
-	return s.createRepeatedWorks(ctx, baseWork, req, baseSubTasks)
+	return &personal_schedule.UpsertWorkResponse{
+		IsSuccess: true,
+		Message:   i18n.T(ctx, i18n.MsgRepeatedWorksUpdated, created),
+	}, nil
+}
+
+func (s *workService) convertNormalToRepeatedUsingCreate(ctx context.Context, baseWork *collection.Work, req *personal_schedule.UpsertWorkRequest, baseSubTasks []collection.SubTask) (*personal_schedule.UpsertWorkResponse, error) {
+	var skipDay *time.Time
+	if req.StartDate != nil {
+		cur := time.UnixMilli(*req.StartDate)
+		skipDay = &cur
+	}
+
+	return s.createRepeatedWorks(ctx, baseWork, req, baseSubTasks, skipDay)
 }
 
 func isSubTasksChanged(dbTasks []collection.SubTask, inputTasks []collection.SubTask) bool {
@@ -391,6 +835,7 @@ func (s *workService) syncSubTasks(ctx context.Context, workID bson.ObjectID, pa
 				SetFilter(bson.M{"_id": task.ID, "work_id": workID}).
 				SetUpdate(bson.M{"$set": bson.M{
 					"name":             task.Name,
+					"name_normalized":  task.NameNormalized,
 					"is_completed":     task.IsCompleted,
 					"last_modified_at": now,
 				}}))
@@ -409,7 +854,8 @@ func (s *workService) syncSubTasks(ctx context.Context, workID bson.ObjectID, pa
 	return nil
 }
 
-func (s *workService) sendNotificationEvent(ctx context.Context, req *personal_schedule.UpsertWorkRequest, workId string) error {
+// buildNotificationEvent builds the outbox event scheduling the notifications of a work.
+func (s *workService) buildNotificationEvent(ctx context.Context, req *personal_schedule.UpsertWorkRequest, workId string) (*collection.OutboxEvent, error) {
 	// Prepare event data
 	notifications := common.Notifications{}
 	for _, notification := range req.Notifications {
@@ -436,41 +882,31 @@ func (s *workService) sendNotificationEvent(ctx context.Context, req *personal_s
 	notificationsPayload, err := proto.Marshal(&notifications)
 	if err != nil {
 		s.logger.Error("Failed to marshal notifications payload", "", zap.Error(err))
-		return err
-	}
-
-	// Publish event to RabbitMQ
-	if err := s.publishNotifications(ctx, notificationsPayload); err != nil {
-		s.logger.Error("Failed to publish notifications event", "", zap.Error(err))
-		return err
+		return nil, err
 	}
 
-	return nil
+	event := newOutboxEvent(ctx, outbox_constant.AggregateTypeWork, workId, outbox_constant.EventTypeScheduledNotification, notificationsPayload)
+	return &event, nil
 }
 
-func (s *workService) publishNotifications(ctx context.Context, payload []byte) error {
-	publisher := eventbus.NewPublisher(
-		s.eventbusConnector,
-		notifications_constant.NOTIFICATION_EXCHANGE,
-		eventbus.ExchangeTypeTopic,
-		nil,
-		nil,
-		false,
-	)
-
-	requestId := utils.GetRequestIDFromOutgoingContext(ctx)
-
-	err := publisher.Publish(ctx, requestId, []string{notifications_constant.NOTIFICATION_ROUTING_KEY}, payload, nil)
+func (s *workService) GetWorks(ctx context.Context, req *personal_schedule.GetWorksRequest) (*personal_schedule.GetWorksResponse, error) {
+	page, after, err := worksPagination(req)
 	if err != nil {
-		s.logger.Error("Failed to publish notification event", "", zap.Error(err))
-		return err
+		return &personal_schedule.GetWorksResponse{
+			Works: []*personal_schedule.Work{},
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidCursor, err),
+		}, nil
 	}
 
-	return nil
-}
+	if req.FromDate != nil && req.ToDate != nil {
+		// the range end is inclusive in the works query
+		to := time.UnixMilli(*req.ToDate).UTC().Add(time.Millisecond)
+		if err := s.expandRepeatedSeries(ctx, req.UserId, to); err != nil {
+			s.logger.Error("Failed to expand repeated series", "", zap.Error(err))
+		}
+	}
 
-func (s *workService) GetWorks(ctx context.Context, req *personal_schedule.GetWorksRequest) (*personal_schedule.GetWorksResponse, error) {
-	aggWorks, totalWorks, err := s.workRepo.GetWorks(ctx, req)
+	result, err := s.workRepo.GetWorks(ctx, req, page, after)
 	if err != nil {
 		s.logger.Error("Failed to get works", "", zap.Error(err))
 		return &personal_schedule.GetWorksResponse{
@@ -479,6 +915,7 @@ func (s *workService) GetWorks(ctx context.Context, req *personal_schedule.GetWo
 			Error:      utils.InternalServerError(ctx, err),
 		}, nil
 	}
+	aggWorks := result.Works
 
 	overdueLabel, err := s.workRepo.GetLabelByKey(ctx, labels_constant.LabelOverDue)
 	if err != nil {
@@ -492,15 +929,98 @@ func (s *workService) GetWorks(ctx context.Context, req *personal_schedule.GetWo
 			aggWorks[i].Overdue = []collection.Label{*overdueLabel}
 		}
 	}
-
-	protoWorks := s.workMapper.ConvertAggregatedWorksToProto(aggWorks)
-
-	return &personal_schedule.GetWorksResponse{
-		Works:      protoWorks,
-		TotalWorks: int32(totalWorks),
-		Error:      nil,
-	}, nil
-
+
+	protoWorks := s.workMapper.ConvertAggregatedWorksToProto(aggWorks)
+
+	resp := &personal_schedule.GetWorksResponse{
+		Works:      protoWorks,
+		TotalWorks: result.Total,
+		PageInfo:   worksPageInfo(req, page, after, result),
+		Error:      nil,
+	}
+	if result.HasMore {
+		nextCursor, err := utils.EncodeCursor(workCursor(req, aggWorks[len(aggWorks)-1]))
+		if err != nil {
+			s.logger.Error("Failed to encode works cursor", "", zap.Error(err))
+		} else {
+			resp.NextCursor = &nextCursor
+		}
+	}
+	return resp, nil
+}
+
+// worksPagination resolves the page of a GetWorks request. A cursor takes precedence over the page,
+// it has to be read with the sort it was issued for.
+func worksPagination(req *personal_schedule.GetWorksRequest) (models.Pagination, *models.WorkCursor, error) {
+	if req.Cursor == nil || *req.Cursor == "" {
+		return utils.ToPagination(req.PageQuery), nil, nil
+	}
+
+	var after models.WorkCursor
+	if err := utils.DecodeCursor(*req.Cursor, &after); err != nil {
+		return models.Pagination{}, nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if after.SortKey != int32(req.SortKey) || after.Descending != req.SortDescending {
+		return models.Pagination{}, nil, fmt.Errorf("cursor was issued for another sort")
+	}
+
+	pageSize := int32(0)
+	if req.PageQuery != nil {
+		pageSize = req.PageQuery.PageSize
+	}
+	if pageSize <= 0 {
+		pageSize = workgeneration_constant.DEFAULT_WORKS_PAGE_SIZE
+	}
+	return models.Pagination{Limit: pageSize}, &after, nil
+}
+
+func worksPageInfo(req *personal_schedule.GetWorksRequest, page models.Pagination, after *models.WorkCursor, result *repos.WorksPage) *common.PageInfo {
+	switch {
+	case after != nil:
+		pageInfo := utils.ToPageInfo(0, page.Limit, result.Total)
+		pageInfo.HasPrev = true
+		pageInfo.HasNext = result.HasMore
+		return pageInfo
+	case page.Limit > 0:
+		return utils.ToPageInfo(req.PageQuery.Page, req.PageQuery.PageSize, result.Total)
+	default:
+		// every work is in the only page
+		pageInfo := &common.PageInfo{
+			TotalItems: result.Total,
+			PageSize:   result.Total,
+			Page:       1,
+		}
+		if result.Total > 0 {
+			pageInfo.TotalPages = 1
+		}
+		return pageInfo
+	}
+}
+
+// workCursor is the position of work in the sort of the request.
+func workCursor(req *personal_schedule.GetWorksRequest, work repos.AggregatedWork) models.WorkCursor {
+	cursor := models.WorkCursor{
+		SortKey:    int32(req.SortKey),
+		Descending: req.SortDescending,
+		ID:         work.ID,
+	}
+	switch req.SortKey {
+	case personal_schedule.WorkSortKey_WORK_SORT_KEY_START_DATE:
+		if work.StartDate != nil {
+			cursor.Value = work.StartDate.UnixMilli()
+		} else {
+			cursor.Null = true
+		}
+	case personal_schedule.WorkSortKey_WORK_SORT_KEY_PRIORITY:
+		if work.PriorityRank != nil {
+			cursor.Value = int64(*work.PriorityRank)
+		}
+	case personal_schedule.WorkSortKey_WORK_SORT_KEY_LAST_MODIFIED_AT:
+		cursor.Value = work.LastModifiedAt.UnixMilli()
+	default:
+		cursor.Value = work.EndDate.UnixMilli()
+	}
+	return cursor
 }
 
 func (s *workService) GetWork(ctx context.Context, req *personal_schedule.GetWorkRequest) (*personal_schedule.GetWorkResponse, error) {
@@ -556,6 +1076,19 @@ func (s *workService) GetWork(ctx context.Context, req *personal_schedule.GetWor
 	}
 
 	protoWork := s.workMapper.MapAggregatedToWorkDetailProto(*work, subTasksDB)
+	if work.RepeatedID != nil {
+		series, err := s.workRepo.GetRepeatedSeriesByID(ctx, *work.RepeatedID)
+		if err == nil && series != nil {
+			protoWork.Recurrence = s.workMapper.MapSeriesToRecurrenceProto(series)
+			// materialized works only cover the horizon, the series itself bounds the range
+			seriesStart = &series.DTStart
+			seriesEnd = nil
+			if series.EndsAt != nil {
+				end := series.EndsAt.Add(time.Duration(series.DurationMs) * time.Millisecond)
+				seriesEnd = &end
+			}
+		}
+	}
 	if seriesStart != nil {
 		v := seriesStart.UnixMilli()
 		protoWork.RepeatSeriesStartDate = &v
@@ -602,15 +1135,29 @@ func (s *workService) DeleteWork(ctx context.Context, req *personal_schedule.Del
 		}, nil
 	}
 
-	err = s.workRepo.DeleteSubTaskByWorkID(ctx, workID)
-	if err != nil {
-		s.logger.Error("Error deleting sub-tasks by work ID", "err", zap.Error(err))
-		return &personal_schedule.DeleteWorkResponse{
-			Success: false,
-			Error:   utils.DatabaseError(ctx, err),
-		}, err
-	}
-	err = s.workRepo.DeleteWork(ctx, workID)
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		if err := s.workRepo.DeleteSubTaskByWorkID(txCtx, workID); err != nil {
+			return err
+		}
+		if err := s.workRepo.DeleteWork(txCtx, workID); err != nil {
+			return err
+		}
+		if err := s.insertWorkLifecycleEvents(txCtx, lifecycle_constant.WORK_DELETED, nil, work, req.UserId); err != nil {
+			return err
+		}
+
+		// keep the occurrence excluded so it is not expanded again from the series
+		if work.RepeatedID != nil {
+			exDate := work.StartDate
+			if work.RecurrenceID != nil {
+				exDate = work.RecurrenceID
+			}
+			if exDate != nil {
+				return s.workRepo.AddRepeatedSeriesExDate(txCtx, *work.RepeatedID, *exDate)
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		s.logger.Error("Error deleting work", "err", zap.Error(err))
 		return &personal_schedule.DeleteWorkResponse{
@@ -643,6 +1190,7 @@ func (s *workService) cloneSubTasks(oldSubs []collection.SubTask, newWorkID bson
 		result = append(result, collection.SubTask{
 			ID:             bson.NewObjectID(),
 			Name:           s.Name,
+			NameNormalized: utils.RemoveAccent(s.Name),
 			IsCompleted:    false,
 			WorkID:         newWorkID,
 			CreatedAt:      now,
@@ -710,7 +1258,7 @@ func (s *workService) RecoverWorks(ctx context.Context, req *personal_schedule.G
 		s.logger.Error("Failed to parse recover times", "", zap.Error(err))
 		return &personal_schedule.GetRecoveryWorksResponse{
 			IsSuccess: false,
-			Message:   "Failed to parse recover times",
+			Message:   i18n.T(ctx, i18n.MsgRecoverTimesInvalid),
 			Error:     utils.InternalServerError(ctx, err),
 		}, nil
 	}
@@ -731,7 +1279,7 @@ func (s *workService) RecoverWorks(ctx context.Context, req *personal_schedule.G
 		s.logger.Error("Failed to get source works", "", zap.Error(err))
 		return &personal_schedule.GetRecoveryWorksResponse{
 			IsSuccess: false,
-			Message:   "Failed to get source works",
+			Message:   i18n.T(ctx, i18n.MsgRecoverSourceFailed),
 			Error:     utils.DatabaseError(ctx, err),
 		}, nil
 	}
@@ -744,6 +1292,8 @@ func (s *workService) RecoverWorks(ctx context.Context, req *personal_schedule.G
 
 	var worksToInsert []interface{}
 	var subTasksToInsert []interface{}
+	// clones overlapping existing works are not recovered, they are reported with free slots instead
+	var skipped []*personal_schedule.CandidateInterval
 
 	boundariesCache := make(map[string]*repos.SeriesBoundaries)
 
@@ -777,7 +1327,7 @@ func (s *workService) RecoverWorks(ctx context.Context, req *personal_schedule.G
 			s.logger.Error("Failed to clone single work", "", zap.Error(err))
 			return &personal_schedule.GetRecoveryWorksResponse{
 				IsSuccess: false,
-				Message:   "Failed to clone single work",
+				Message:   i18n.T(ctx, i18n.MsgRecoverCloneFailed),
 				Error:     utils.DatabaseError(ctx, err),
 			}, nil
 		}
@@ -790,11 +1340,17 @@ func (s *workService) RecoverWorks(ctx context.Context, req *personal_schedule.G
 		if err != nil {
 			return &personal_schedule.GetRecoveryWorksResponse{
 				IsSuccess: false,
-				Message:   "Failed to check overlapping works",
+				Message:   i18n.T(ctx, i18n.MsgOverlapCheckFailed),
 				Error:     utils.DatabaseError(ctx, err),
 			}, nil
 		}
 		if count > 0 {
+			sourceID := oldWork.ID.Hex()
+			skipped = append(skipped, &personal_schedule.CandidateInterval{
+				RefId: &sourceID,
+				Start: newWork.StartDate.UnixMilli(),
+				End:   newWork.EndDate.UnixMilli(),
+			})
 			continue
 		}
 		worksToInsert = append(worksToInsert, newWork)
@@ -804,22 +1360,31 @@ func (s *workService) RecoverWorks(ctx context.Context, req *personal_schedule.G
 		}
 
 	}
-	if err := s.workRepo.BulkInsertWorks(ctx, worksToInsert); err != nil {
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		if err := s.workRepo.BulkInsertWorks(txCtx, worksToInsert); err != nil {
+			return err
+		}
+		return s.workRepo.BulkInsertSubTasks(txCtx, subTasksToInsert)
+	})
+	if err != nil {
+		s.logger.Error("Failed to insert recovered works", "", zap.Error(err))
 		return &personal_schedule.GetRecoveryWorksResponse{
 			IsSuccess: false,
-			Message:   "Failed to insert recovered works",
+			Message:   i18n.T(ctx, i18n.MsgRecoverInsertFailed),
 			Error:     utils.DatabaseError(ctx, err),
 		}, nil
 	}
 
-	if len(subTasksToInsert) > 0 {
-		_ = s.workRepo.BulkInsertSubTasks(ctx, subTasksToInsert)
+	conflicts, err := s.buildScheduleConflicts(ctx, req.UserId, skipped, workgeneration_constant.DEFAULT_CONFLICT_SUGGESTIONS)
+	if err != nil {
+		s.logger.Warn("Failed to build schedule conflicts of skipped works", "", zap.Error(err))
 	}
 
 	return &personal_schedule.GetRecoveryWorksResponse{
 		IsSuccess: true,
-		Message:   "Works recovered successfully",
+		Message:   i18n.T(ctx, i18n.MsgWorksRecovered),
 		Error:     nil,
+		Conflicts: conflictsOnly(conflicts),
 	}, nil
 }
 
@@ -862,6 +1427,18 @@ func (s *workService) UpdateWorkLabel(ctx context.Context, req *personal_schedul
 		}, nil
 	}
 
+	if err := s.validator.ValidateLabelOfType(ctx, req.UserId, req.LabelId, int(req.LabelType)); err != nil {
+		s.logger.Warn("Invalid label", "", zap.String("label_id", req.LabelId), zap.Error(err))
+		if ve, ok := err.(*validation.ValidationError); ok {
+			return &personal_schedule.UpdateWorkLabelResponse{
+				Error: utils.CustomError(ctx, ve.Category, ve.Code, err),
+			}, nil
+		}
+		return &personal_schedule.UpdateWorkLabelResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
 	var fieldName string
 	switch req.LabelType {
 	case 1:
@@ -883,7 +1460,21 @@ func (s *workService) UpdateWorkLabel(ctx context.Context, req *personal_schedul
 	}
 	fmt.Println("Updating work", workID.Hex(), "field", fieldName, "to label", labelID.Hex())
 
-	if err := s.workRepo.UpdateWorkField(ctx, workID, fieldName, labelID); err != nil {
+	before := workLabelFields(work)
+	after := workLabelFields(work)
+	after[fieldName] = labelID
+	updatedWork := *work
+	setWorkLabelField(&updatedWork, fieldName, labelID)
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		if err := s.workRepo.UpdateWorkField(txCtx, workID, fieldName, labelID); err != nil {
+			return err
+		}
+		if err := s.insertWorkLabelEvents(txCtx, work, diffLabelFields(before, after, work.UserID, req.UserId)); err != nil {
+			return err
+		}
+		return s.insertWorkLifecycleEvents(txCtx, lifecycle_constant.WORK_UPDATED, work, &updatedWork, req.UserId)
+	})
+	if err != nil {
 		s.logger.Error("Failed to update work label", "", zap.Error(err))
 		return &personal_schedule.UpdateWorkLabelResponse{
 			Error: utils.DatabaseError(ctx, err),
@@ -892,7 +1483,7 @@ func (s *workService) UpdateWorkLabel(ctx context.Context, req *personal_schedul
 
 	return &personal_schedule.UpdateWorkLabelResponse{
 		IsSuccess: true,
-		Message:   "Label updated successfully",
+		Message:   i18n.T(ctx, i18n.MsgWorkLabelUpdated),
 	}, nil
 }
 
@@ -902,7 +1493,7 @@ func (s *workService) SaveDraftAsRealWork(ctx context.Context, req *personal_sch
 		s.logger.Error("Failed to get draft label", "", zap.Error(err))
 		return &personal_schedule.SaveDraftAsRealWorkResponse{
 			IsSuccess: false,
-			Message:   "Failed to get draft label",
+			Message:   i18n.T(ctx, i18n.MsgDraftLabelFailed),
 			Error:     utils.DatabaseError(ctx, err),
 		}, nil
 	}
@@ -912,7 +1503,7 @@ func (s *workService) SaveDraftAsRealWork(ctx context.Context, req *personal_sch
 		s.logger.Error("Failed to get draft works", "", zap.Error(err))
 		return &personal_schedule.SaveDraftAsRealWorkResponse{
 			IsSuccess: false,
-			Message:   "Failed to get draft works",
+			Message:   i18n.T(ctx, i18n.MsgDraftWorksFailed),
 			Error:     utils.DatabaseError(ctx, err),
 		}, nil
 	}
@@ -920,72 +1511,140 @@ func (s *workService) SaveDraftAsRealWork(ctx context.Context, req *personal_sch
 	if len(worksDraft) == 0 {
 		return &personal_schedule.SaveDraftAsRealWorkResponse{
 			IsSuccess: true,
-			Message:   "No draft works to accept",
+			Message:   i18n.T(ctx, i18n.MsgNoDraftWorks),
 		}, nil
 	}
 
+	candidates := make([]*personal_schedule.CandidateInterval, 0, len(worksDraft))
 	for _, work := range worksDraft {
 		if work.StartDate == nil {
 			continue
 		}
+		draftID := work.ID.Hex()
+		excludeID := draftID
+		if work.ScheduledFromID != nil {
+			// an auto scheduled draft moves its work, the current time of the work is given back
+			excludeID = work.ScheduledFromID.Hex()
+		}
+		candidates = append(candidates, &personal_schedule.CandidateInterval{
+			RefId:         &draftID,
+			Start:         work.StartDate.UnixMilli(),
+			End:           work.EndDate.UnixMilli(),
+			ExcludeWorkId: &excludeID,
+		})
+	}
+	results, err := s.buildScheduleConflicts(ctx, req.UserId, candidates, workgeneration_constant.DEFAULT_CONFLICT_SUGGESTIONS)
+	if err != nil {
+		return &personal_schedule.SaveDraftAsRealWorkResponse{
+			IsSuccess: false,
+			Message:   i18n.T(ctx, i18n.MsgOverlapCheckFailed),
+			Error:     utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	if conflicts := conflictsOnly(results); len(conflicts) > 0 {
+		return &personal_schedule.SaveDraftAsRealWorkResponse{
+			IsSuccess: false,
+			Message:   i18n.T(ctx, i18n.MsgDraftWorksOverlap),
+			Conflicts: conflicts,
+		}, nil
+	}
 
-		count, err := s.workRepo.CountOverlappingWorks(
-			ctx,
-			req.UserId,
-			work.StartDate.UnixMilli(),
-			work.EndDate.UnixMilli(),
-			&work.ID,
-		)
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		newWorks, err := s.applyScheduledDrafts(txCtx, req.UserId, worksDraft)
 		if err != nil {
-			return &personal_schedule.SaveDraftAsRealWorkResponse{
-				IsSuccess: false,
-				Message:   "Error checking overlapping works",
-				Error:     utils.DatabaseError(ctx, err),
-			}, nil
+			return err
 		}
-
-		if count > 0 {
-			return &personal_schedule.SaveDraftAsRealWorkResponse{
-				IsSuccess: false,
-				Message:   "Some draft works overlap with existing works",
-			}, nil
+		if err := s.workRepo.SaveDraftAsRealWork(txCtx, req.UserId, draftLabel.ID); err != nil {
+			return err
 		}
-	}
-
-	err = s.workRepo.SaveDraftAsRealWork(
-		ctx,
-		req.UserId,
-		draftLabel.ID,
-	)
+		// imported series keep materializing their next occurrences as real works
+		if err := s.workRepo.SaveDraftRepeatedSeries(txCtx, req.UserId, draftLabel.ID); err != nil {
+			return err
+		}
+		// accepted drafts are new works for the consumers
+		for i := range newWorks {
+			accepted := newWorks[i]
+			accepted.DraftID = nil
+			if err := s.insertWorkLifecycleEvents(txCtx, lifecycle_constant.WORK_CREATED, nil, &accepted, req.UserId); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		s.logger.Error("Failed to commit drafts", "", zap.Error(err))
 		return &personal_schedule.SaveDraftAsRealWorkResponse{
 			IsSuccess: false,
-			Message:   "Failed to commit drafts",
+			Message:   i18n.T(ctx, i18n.MsgDraftCommitFailed),
 			Error:     utils.DatabaseError(ctx, err),
 		}, nil
 	}
 
 	return &personal_schedule.SaveDraftAsRealWorkResponse{
 		IsSuccess: true,
-		Message:   "Recovery drafts committed successfully",
+		Message:   i18n.T(ctx, i18n.MsgDraftsCommitted),
 	}, nil
 }
 
+// applyScheduledDrafts moves the works to the time of their auto scheduled drafts and deletes these drafts,
+// the other drafts are returned to be accepted as new works.
+func (s *workService) applyScheduledDrafts(ctx context.Context, userID string, drafts []collection.Work) ([]collection.Work, error) {
+	var newWorks []collection.Work
+	var appliedIDs []bson.ObjectID
+	for _, draft := range drafts {
+		if draft.ScheduledFromID == nil {
+			newWorks = append(newWorks, draft)
+			continue
+		}
+		appliedIDs = append(appliedIDs, draft.ID)
+
+		before, err := s.workRepo.GetWorkByID(ctx, *draft.ScheduledFromID)
+		if err != nil {
+			return nil, err
+		}
+		if before == nil || before.UserID != userID {
+			// the work was deleted since it was scheduled
+			continue
+		}
+		if err := s.workRepo.UpdateWorkSchedule(ctx, before.ID, *draft.StartDate, draft.EndDate); err != nil {
+			return nil, err
+		}
+		after := *before
+		after.StartDate = draft.StartDate
+		after.EndDate = draft.EndDate
+		if err := s.insertWorkLifecycleEvents(ctx, lifecycle_constant.WORK_UPDATED, before, &after, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.workRepo.DeleteSubTasksByWorkIDs(ctx, appliedIDs); err != nil {
+		return nil, err
+	}
+	if err := s.workRepo.DeleteWorksByIDs(ctx, appliedIDs); err != nil {
+		return nil, err
+	}
+	return newWorks, nil
+}
+
 func (s *workService) DeleteAllDraftWorks(ctx context.Context, req *personal_schedule.DeleteAllDraftWorksRequest) (*personal_schedule.DeleteAllDraftWorksResponse, error) {
 
-	err := s.workRepo.DeleteAllDraftWorks(ctx, req.UserId)
+	err := withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		if err := s.workRepo.DeleteDraftRepeatedSeries(txCtx, req.UserId); err != nil {
+			return err
+		}
+		return s.workRepo.DeleteAllDraftWorks(txCtx, req.UserId)
+	})
 	if err != nil {
 		s.logger.Error("Failed to delete draft works", "", zap.Error(err))
 		return &personal_schedule.DeleteAllDraftWorksResponse{
 			IsSuccess: false,
-			Message:   "Failed to delete draft works",
+			Message:   i18n.T(ctx, i18n.MsgDraftDeleteFailed),
 			Error:     utils.DatabaseError(ctx, err),
 		}, nil
 	}
 	return &personal_schedule.DeleteAllDraftWorksResponse{
 		IsSuccess: true,
-		Message:   "Draft works deleted successfully",
+		Message:   i18n.T(ctx, i18n.MsgDraftsDeleted),
 	}, nil
 }
 
@@ -996,7 +1655,7 @@ func (s *workService) GenerateWorksFromAI(ctx context.Context, req *personal_sch
 		s.logger.Error("Validation failed for generate works by AI request", "", zap.Error(err))
 		return &common.EmptyResponse{
 			Success: utils.ToBoolPointer(false),
-			Message: utils.ToStringPointer("Validation error"),
+			Message: utils.ToStringPointer(i18n.T(ctx, i18n.MsgValidationError)),
 			Error:   utils.InternalServerError(ctx, err),
 		}, nil
 	}
@@ -1029,37 +1688,58 @@ func (s *workService) GenerateWorksFromAI(ctx context.Context, req *personal_sch
 		s.logger.Error("Failed to marshal generate works by AI prompts", "", zap.Error(err))
 		return &common.EmptyResponse{
 			Success: utils.ToBoolPointer(false),
-			Message: utils.ToStringPointer("Internal error"),
+			Message: utils.ToStringPointer(i18n.T(ctx, i18n.MsgInternalError)),
 			Error:   utils.InternalServerError(ctx, err),
 		}, err
 	}
 
-	existingTime, err := s.workRepo.GetExistingTimes(ctx, req.UserId, req.LocalDate)
+	loc, err := userLocation(ctx, s.userRepo, req.UserId, nil)
+	if err != nil {
+		s.logger.Error("Failed to get time zone of user", "", zap.Error(err))
+		return &common.EmptyResponse{
+			Success: utils.ToBoolPointer(false),
+			Message: utils.ToStringPointer(i18n.T(ctx, i18n.MsgInternalError)),
+			Error:   utils.DatabaseError(ctx, err),
+		}, err
+	}
+
+	user, err := userPreferences(ctx, s.userRepo, req.UserId)
+	if err != nil {
+		s.logger.Error("Failed to get preferences of user", "", zap.Error(err))
+		return &common.EmptyResponse{
+			Success: utils.ToBoolPointer(false),
+			Message: utils.ToStringPointer(i18n.T(ctx, i18n.MsgInternalError)),
+			Error:   utils.DatabaseError(ctx, err),
+		}, err
+	}
+
+	existingTime, err := s.workRepo.GetExistingTimes(ctx, req.UserId, req.LocalDate, loc)
 	if err != nil {
 		s.logger.Error("Failed to get existing work times for user", "", zap.Error(err))
 		return &common.EmptyResponse{
 			Success: utils.ToBoolPointer(false),
-			Message: utils.ToStringPointer("Internal error"),
+			Message: utils.ToStringPointer(i18n.T(ctx, i18n.MsgInternalError)),
 			Error:   utils.InternalServerError(ctx, err),
 		}, err
 	}
 
-	standardizedConstraintsPrompt := buildExistingTimeConstraint(existingTime)
+	standardizedConstraintsPrompt := buildExistingTimeConstraint(existingTime, loc)
 
 	payload, err := json.Marshal(models.GenerationWorksModel{
 		UserID:            req.UserId,
 		Prompts:           standardizedPromptsString,
 		LocalDate:         req.LocalDate,
+		TimeZone:          loc.String(),
 		AdditionalContext: req.AdditionalContext,
 		Constraints:       standardizedConstraintsPrompt,
-		UserPersonality:   "",
+		UserPersonality:   userPersonality(user),
 	})
 
 	if err != nil {
 		s.logger.Error("Failed to marshal generate works by AI payload", "", zap.Error(err))
 		return &common.EmptyResponse{
 			Success: utils.ToBoolPointer(false),
-			Message: utils.ToStringPointer("Internal error"),
+			Message: utils.ToStringPointer(i18n.T(ctx, i18n.MsgInternalError)),
 			Error:   utils.InternalServerError(ctx, err),
 		}, err
 	}
@@ -1069,17 +1749,17 @@ func (s *workService) GenerateWorksFromAI(ctx context.Context, req *personal_sch
 		s.logger.Error("Failed to publish generate works by AI event", "", zap.Error(err))
 		return &common.EmptyResponse{
 			Success: utils.ToBoolPointer(false),
-			Message: utils.ToStringPointer("Internal error"),
+			Message: utils.ToStringPointer(i18n.T(ctx, i18n.MsgInternalError)),
 			Error:   utils.InternalServerError(ctx, err),
 		}, err
 	}
 	return &common.EmptyResponse{
 		Success: utils.ToBoolPointer(true),
-		Message: utils.ToStringPointer("Work generation request submitted successfully, processing in background"),
+		Message: utils.ToStringPointer(i18n.T(ctx, i18n.MsgWorkGenerationSubmitted)),
 	}, nil
 }
 
-func buildExistingTimeConstraint(existingTime []*models.TimeRange) string {
+func buildExistingTimeConstraint(existingTime []*models.TimeRange, loc *time.Location) string {
 	if len(existingTime) == 0 {
 		return ""
 	}
@@ -1087,8 +1767,8 @@ func buildExistingTimeConstraint(existingTime []*models.TimeRange) string {
 	parts := make([]string, 0, len(existingTime))
 
 	for _, tr := range existingTime {
-		start := time.UnixMilli(tr.StartTime).In(global.HCMTimeLocation).Format("15:04")
-		end := time.UnixMilli(tr.EndTime).In(global.HCMTimeLocation).Format("15:04")
+		start := time.UnixMilli(tr.StartTime).In(loc).Format("15:04")
+		end := time.UnixMilli(tr.EndTime).In(loc).Format("15:04")
 
 		parts = append(parts, fmt.Sprintf("%s - %s", start, end))
 	}
@@ -1096,17 +1776,351 @@ func buildExistingTimeConstraint(existingTime []*models.TimeRange) string {
 	return fmt.Sprintf("[%s]", strings.Join(parts, ", "))
 }
 
+// DeleteExpiredDraftWorks deletes the drafts created before the current local day of their user,
+// users without a time zone follow the service default.
 func (s *workService) DeleteExpiredDraftWorks(ctx context.Context) error {
-	loc, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	userIDsByTimeZone, err := s.userRepo.GetUserIDsByTimeZone(ctx)
+	if err != nil {
+		return err
+	}
+
+	var zonedUserIDs []string
+	for timeZone, userIDs := range userIDsByTimeZone {
+		zonedUserIDs = append(zonedUserIDs, userIDs...)
+		if err := s.deleteDraftsBefore(ctx, localMidnight(utils.LoadLocationOrDefault(timeZone)), userIDs, false); err != nil {
+			return err
+		}
+	}
+	return s.deleteDraftsBefore(ctx, localMidnight(global.HCMTimeLocation), zonedUserIDs, true)
+}
+
+// deleteDraftsBefore deletes the expired drafts together with their draft series, which would materialize them again.
+func (s *workService) deleteDraftsBefore(ctx context.Context, before time.Time, userIDs []string, excludeUserIDs bool) error {
+	return withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		if err := s.workRepo.DeleteDraftRepeatedSeriesBefore(txCtx, before, userIDs, excludeUserIDs); err != nil {
+			return err
+		}
+		return s.workRepo.DeleteDraftBefore(txCtx, before, userIDs, excludeUserIDs)
+	})
+}
+
+func localMidnight(loc *time.Location) time.Time {
 	now := time.Now().In(loc)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+}
 
-	todayMidnight := time.Date(
-		now.Year(),
-		now.Month(),
-		now.Day(),
-		0, 0, 0, 0,
-		loc,
-	)
+// TransitionOverdueWorks moves ended PENDING/IN_PROGRESS works to OVER_DUE,
+// then OVER_DUE works past the grace period to GIVE_UP.
+func (s *workService) TransitionOverdueWorks(ctx context.Context) error {
+	pending, err := s.workRepo.GetLabelByKey(ctx, labels_constant.LabelPending)
+	if err != nil {
+		return err
+	}
+	inProgress, err := s.workRepo.GetLabelByKey(ctx, labels_constant.LabelInProgress)
+	if err != nil {
+		return err
+	}
+	overDue, err := s.workRepo.GetLabelByKey(ctx, labels_constant.LabelOverDue)
+	if err != nil {
+		return err
+	}
+	giveUp, err := s.workRepo.GetLabelByKey(ctx, labels_constant.LabelGiveUp)
+	if err != nil {
+		return err
+	}
+
+	graceHours := global.Config.Work.GiveUpGraceHours
+	if graceHours <= 0 {
+		graceHours = workgeneration_constant.DEFAULT_GIVE_UP_GRACE_HOURS
+	}
+	now := time.Now().UTC()
+
+	overdueCount, err := s.transitionWorksStatus(ctx, []bson.ObjectID{pending.ID, inProgress.ID}, overDue.ID, now,
+		workgeneration_constant.WORK_EVENT_REASON_OVERDUE,
+		i18n.MsgWorksOverdueTitle, i18n.MsgWorksOverdueMessage)
+	if err != nil {
+		return err
+	}
+
+	giveUpCount, err := s.transitionWorksStatus(ctx, []bson.ObjectID{overDue.ID}, giveUp.ID, now.Add(-time.Duration(graceHours)*time.Hour),
+		workgeneration_constant.WORK_EVENT_REASON_GRACE_EXPIRE,
+		i18n.MsgWorksGiveUpTitle, i18n.MsgWorksGiveUpMessage)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Transitioned overdue works", "", zap.Int("over_due", overdueCount), zap.Int("give_up", giveUpCount))
+	return nil
+}
+
+// transitionWorksStatus moves works batch by batch, recording a work event per moved work
+// and publishing one notification event per batch, titleID and messageID are the catalog IDs of its text.
+func (s *workService) transitionWorksStatus(ctx context.Context, fromStatusIDs []bson.ObjectID, toStatusID bson.ObjectID, endBefore time.Time, reason string, titleID string, messageID string) (int, error) {
+	total := 0
+	for {
+		works, err := s.workRepo.GetWorksToTransition(ctx, fromStatusIDs, endBefore, workgeneration_constant.STATUS_TRANSITION_BATCH_SIZE)
+		if err != nil {
+			return total, err
+		}
+		if len(works) == 0 {
+			return total, nil
+		}
+
+		// the batch, its history and its notifications are committed together
+		var moved int
+		err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+			now := time.Now().UTC()
+			var events []interface{}
+			var lifecycleEvents []interface{}
+			movedPerUser := make(map[string]int)
+			for _, work := range works {
+				ok, err := s.workRepo.TransitionWorkStatus(txCtx, work.ID, work.StatusID, toStatusID)
+				if err != nil {
+					return err
+				}
+				// the user changed the status in the meantime
+				if !ok {
+					continue
+				}
+
+				workID := work.ID
+				fromID := work.StatusID
+				toID := toStatusID
+				eventReason := reason
+				events = append(events, collection.WorkEvent{
+					WorkID:    &workID,
+					UserID:    work.UserID,
+					EventType: workgeneration_constant.WORK_EVENT_LABEL_CHANGED,
+					Field:     "status_id",
+					FromID:    &fromID,
+					ToID:      &toID,
+					ActorID:   workgeneration_constant.WORK_EVENT_ACTOR_SYSTEM,
+					Reason:    &eventReason,
+					CreatedAt: now,
+				})
+
+				movedWork := work
+				movedWork.StatusID = toStatusID
+				lifecycleEvent, err := newWorkLifecycleEvent(txCtx, lifecycle_constant.WORK_UPDATED, &movedWork, workgeneration_constant.WORK_EVENT_ACTOR_SYSTEM, &fromID)
+				if err != nil {
+					return err
+				}
+				lifecycleEvents = append(lifecycleEvents, lifecycleEvent)
+				movedPerUser[work.UserID]++
+			}
+
+			if err := s.workRepo.InsertWorkEvents(txCtx, events); err != nil {
+				return err
+			}
+			if err := s.outboxRepo.InsertOutboxEvents(txCtx, lifecycleEvents); err != nil {
+				return err
+			}
+			if len(movedPerUser) > 0 {
+				if err := s.enqueueStatusTransitionEvent(txCtx, movedPerUser, titleID, messageID); err != nil {
+					return err
+				}
+			}
+			moved = len(events)
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+		total += moved
+
+		if len(works) < workgeneration_constant.STATUS_TRANSITION_BATCH_SIZE || moved == 0 {
+			return total, nil
+		}
+	}
+}
+
+// enqueueStatusTransitionEvent writes one notification per user to the outbox, correlated by a batch ID.
+// Each notification is written in the locale of its user.
+func (s *workService) enqueueStatusTransitionEvent(ctx context.Context, movedPerUser map[string]int, titleID string, messageID string) error {
+	batchID := bson.NewObjectID().Hex()
+	triggerAt := time.Now().UnixMilli()
+	link := workgeneration_constant.LINK
+
+	locales, err := s.userRepo.GetUserLocales(ctx, slices.Collect(maps.Keys(movedPerUser)))
+	if err != nil {
+		return err
+	}
+
+	notifications := common.Notifications{}
+	for userID, count := range movedPerUser {
+		locale := i18n.UserLocale(locales[userID])
+		notifications.Notifications = append(notifications.Notifications, &common.Notification{
+			Title:           i18n.Message(locale, titleID),
+			Message:         i18n.Message(locale, messageID, count),
+			SenderId:        workgeneration_constant.WORK_EVENT_ACTOR_SYSTEM,
+			ReceiverIds:     []string{userID},
+			IsRead:          false,
+			Link:            &link,
+			IsActive:        true,
+			TriggerAt:       &triggerAt,
+			CorrelationId:   batchID,
+			CorrelationType: common.NOTIFICATION_TYPE_SCHEDULED_NOTIFICATION,
+		})
+	}
+
+	payload, err := proto.Marshal(&notifications)
+	if err != nil {
+		return err
+	}
+	event := newOutboxEvent(ctx, outbox_constant.AggregateTypeUser, batchID, outbox_constant.EventTypeScheduledNotification, payload)
+	return s.outboxRepo.InsertOutboxEvents(ctx, []interface{}{event})
+}
+
+func (s *workService) GetWorkHistory(ctx context.Context, req *personal_schedule.GetWorkHistoryRequest) (*personal_schedule.GetWorkHistoryResponse, error) {
+	workID, err := bson.ObjectIDFromHex(req.WorkId)
+	if err != nil {
+		s.logger.Warn("Invalid work ID format", "", zap.String("work_id", req.WorkId), zap.Error(err))
+		return &personal_schedule.GetWorkHistoryResponse{
+			Error: utils.InternalServerError(ctx, err),
+		}, nil
+	}
+
+	work, err := s.workRepo.GetWorkByID(ctx, workID)
+	if err != nil {
+		s.logger.Error("Error fetching work from repo", "", zap.Error(err))
+		return &personal_schedule.GetWorkHistoryResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+	if work == nil {
+		s.logger.Info("Work not found", "", zap.String("work_id", req.WorkId))
+		return &personal_schedule.GetWorkHistoryResponse{
+			Error: utils.NotFoundError(ctx, err),
+		}, nil
+	}
+	if work.UserID != req.UserId {
+		s.logger.Warn("Forbidden: user does not own this work", "", zap.String("work_id", req.WorkId), zap.String("user_id", req.UserId))
+		return &personal_schedule.GetWorkHistoryResponse{
+			Error: utils.PermissionDeniedError(ctx, err),
+		}, nil
+	}
+
+	events, err := s.workRepo.GetWorkEvents(ctx, workID)
+	if err != nil {
+		s.logger.Error("Failed to get work events", "", zap.Error(err))
+		return &personal_schedule.GetWorkHistoryResponse{
+			Error: utils.DatabaseError(ctx, err),
+		}, nil
+	}
+
+	return &personal_schedule.GetWorkHistoryResponse{
+		Events: s.workMapper.MapWorkEventsToProto(events),
+	}, nil
+}
+
+// recordWorkLabelChanges appends a label_changed event for every label which differs between before and after.
+func (s *workService) recordWorkLabelChanges(ctx context.Context, before, after *collection.Work, actorID string) error {
+	return s.insertWorkLabelEvents(ctx, before, diffLabelFields(workLabelFields(before), workLabelFields(after), before.UserID, actorID))
+}
+
+// insertWorkLabelEvents stores the events of a work, in the transaction of the change itself.
+func (s *workService) insertWorkLabelEvents(ctx context.Context, work *collection.Work, events []collection.WorkEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	docs := make([]interface{}, 0, len(events))
+	for i := range events {
+		workID := work.ID
+		events[i].WorkID = &workID
+		docs = append(docs, events[i])
+	}
+	return s.workRepo.InsertWorkEvents(ctx, docs)
+}
+
+// insertWorkLifecycleEvents writes the lifecycle event of a work change to the outbox,
+// an update moving the work to COMPLETED also emits work.completed. before is nil on create and delete.
+func (s *workService) insertWorkLifecycleEvents(ctx context.Context, eventType string, before, after *collection.Work, actorID string) error {
+	current := *after
+	var previousStatusID *bson.ObjectID
+	if before != nil {
+		// the upsert payload does not carry the series reference
+		if current.RepeatedID == nil {
+			current.RepeatedID = before.RepeatedID
+		}
+		if before.StatusID != after.StatusID {
+			statusID := before.StatusID
+			previousStatusID = &statusID
+		}
+	}
+
+	event, err := newWorkLifecycleEvent(ctx, eventType, &current, actorID, previousStatusID)
+	if err != nil {
+		return err
+	}
+	events := []interface{}{event}
+
+	if eventType == lifecycle_constant.WORK_UPDATED && previousStatusID != nil {
+		completedLabel, err := s.workRepo.GetLabelByKey(ctx, labels_constant.LabelCompleted)
+		if err != nil {
+			return err
+		}
+		if completedLabel != nil && current.StatusID == completedLabel.ID {
+			completedEvent, err := newWorkLifecycleEvent(ctx, lifecycle_constant.WORK_COMPLETED, &current, actorID, previousStatusID)
+			if err != nil {
+				return err
+			}
+			events = append(events, completedEvent)
+		}
+	}
+	return s.outboxRepo.InsertOutboxEvents(ctx, events)
+}
+
+// setWorkLabelField sets the label of a work by its field name, as used by UpdateWorkField.
+func setWorkLabelField(work *collection.Work, fieldName string, labelID bson.ObjectID) {
+	switch fieldName {
+	case "type_id":
+		work.TypeID = labelID
+	case "status_id":
+		work.StatusID = labelID
+	case "difficulty_id":
+		work.DifficultyID = labelID
+	case "priority_id":
+		work.PriorityID = labelID
+	case "category_id":
+		work.CategoryID = labelID
+	}
+}
+
+func workLabelFields(work *collection.Work) map[string]bson.ObjectID {
+	return map[string]bson.ObjectID{
+		"type_id":       work.TypeID,
+		"status_id":     work.StatusID,
+		"difficulty_id": work.DifficultyID,
+		"priority_id":   work.PriorityID,
+		"category_id":   work.CategoryID,
+	}
+}
 
-	return s.workRepo.DeleteDraftBefore(ctx, todayMidnight)
+// diffLabelFields builds a label_changed event, without its work or goal reference, for every field whose label changed.
+func diffLabelFields(before, after map[string]bson.ObjectID, userID string, actorID string) []collection.WorkEvent {
+	now := time.Now().UTC()
+	var events []collection.WorkEvent
+	for _, field := range []string{"type_id", "status_id", "difficulty_id", "priority_id", "category_id"} {
+		to, ok := after[field]
+		if !ok {
+			continue
+		}
+		from := before[field]
+		if from == to {
+			continue
+		}
+		event := collection.WorkEvent{
+			UserID:    userID,
+			EventType: workgeneration_constant.WORK_EVENT_LABEL_CHANGED,
+			Field:     field,
+			ToID:      &to,
+			ActorID:   actorID,
+			CreatedAt: now,
+		}
+		if !from.IsZero() {
+			event.FromID = &from
+		}
+		events = append(events, event)
+	}
+	return events
 }
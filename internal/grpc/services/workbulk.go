@@ -0,0 +1,323 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"personal_schedule_service/internal/collection"
+	labels_constant "personal_schedule_service/internal/constant/labels"
+	lifecycle_constant "personal_schedule_service/internal/constant/lifecycle"
+	workgeneration_constant "personal_schedule_service/internal/constant/work"
+	"personal_schedule_service/internal/grpc/utils"
+	"personal_schedule_service/internal/grpc/validation"
+	"personal_schedule_service/internal/i18n"
+	app_error "personal_schedule_service/pkg/settings/error"
+	"personal_schedule_service/proto/common"
+	"personal_schedule_service/proto/personal_schedule"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+)
+
+// bulkWorkIDs are the requested works in the order of the request, without duplicates.
+// The malformed ids are kept in order so they get a not found result as well.
+type bulkWorkIDs struct {
+	order []string
+	ids   map[string]bson.ObjectID
+}
+
+func parseBulkWorkIDs(workIDs []string) bulkWorkIDs {
+	parsed := bulkWorkIDs{ids: make(map[string]bson.ObjectID)}
+	seen := make(map[string]bool)
+	for _, raw := range workIDs {
+		if seen[raw] {
+			continue
+		}
+		seen[raw] = true
+		parsed.order = append(parsed.order, raw)
+		if id, err := bson.ObjectIDFromHex(raw); err == nil {
+			parsed.ids[raw] = id
+		}
+	}
+	return parsed
+}
+
+func (b bulkWorkIDs) objectIDs() []bson.ObjectID {
+	ids := make([]bson.ObjectID, 0, len(b.ids))
+	for _, raw := range b.order {
+		if id, ok := b.ids[raw]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// results reports every requested work, the found ones succeed unless failure is set.
+func (b bulkWorkIDs) results(ctx context.Context, found map[bson.ObjectID]bool, failure *common.Error) []*personal_schedule.BulkWorkResult {
+	results := make([]*personal_schedule.BulkWorkResult, 0, len(b.order))
+	for _, raw := range b.order {
+		result := &personal_schedule.BulkWorkResult{WorkId: raw}
+		id, ok := b.ids[raw]
+		switch {
+		case !ok || !found[id]:
+			result.Error = utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.WorkNotFound, fmt.Errorf("work %s not found", raw))
+		case failure != nil:
+			result.Error = failure
+		default:
+			result.IsSuccess = true
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// bulkWorkError maps an error of a bulk operation to its response error.
+func bulkWorkError(ctx context.Context, err error) *common.Error {
+	var ve *validation.ValidationError
+	if errors.As(err, &ve) {
+		return utils.CustomError(ctx, ve.Category, ve.Code, err)
+	}
+	return utils.DatabaseError(ctx, err)
+}
+
+// bulkWorkPatch is a validated patch, labels are keyed by the work field they are set on.
+type bulkWorkPatch struct {
+	labels  map[string]bson.ObjectID
+	setGoal bool
+	goalID  *bson.ObjectID
+	shift   time.Duration
+}
+
+func (s *workService) validateBulkWorkPatch(ctx context.Context, userID string, patch *personal_schedule.BulkWorkPatch) (*bulkWorkPatch, error) {
+	if patch == nil || (len(patch.Labels) == 0 && patch.GoalId == nil && patch.ShiftMs == 0) {
+		return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.EmptyWorkPatch, "patch has no change")
+	}
+
+	validated := &bulkWorkPatch{
+		labels: make(map[string]bson.ObjectID),
+		shift:  time.Duration(patch.ShiftMs) * time.Millisecond,
+	}
+	for _, change := range patch.Labels {
+		// the repeated, group and draft types need a series or a group behind the work, they are not patched in bulk
+		if change.LabelType == labels_constant.LabelTypeWorkType {
+			return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, "work type can not be changed in bulk")
+		}
+		field, ok := labels_constant.LabelTypeFields[int(change.LabelType)]
+		if !ok {
+			return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, fmt.Sprintf("invalid label type %d", change.LabelType))
+		}
+		if _, exists := validated.labels[field]; exists {
+			return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, fmt.Sprintf("label type %d is changed more than once", change.LabelType))
+		}
+		if err := s.validator.ValidateLabelOfType(ctx, userID, change.LabelId, int(change.LabelType)); err != nil {
+			return nil, err
+		}
+		labelID, _ := bson.ObjectIDFromHex(change.LabelId)
+		validated.labels[field] = labelID
+	}
+
+	if patch.GoalId != nil {
+		validated.setGoal = true
+		if *patch.GoalId != "" {
+			goalID, err := bson.ObjectIDFromHex(*patch.GoalId)
+			if err != nil {
+				return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.GoalNotFoundCode, "invalid GoalId")
+			}
+			goal, err := s.goalRepo.GetGoalByID(ctx, goalID)
+			if err != nil {
+				return nil, err
+			}
+			if goal == nil {
+				return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.GoalNotFoundCode, "goal not found")
+			}
+			if goal.UserID != userID {
+				return nil, validation.NewValidationError(common.ErrorCode_ERROR_CODE_PERMISSION_DENIED, app_error.GoalForbidden, "user does not own this goal")
+			}
+			validated.goalID = &goalID
+		}
+	}
+	return validated, nil
+}
+
+// apply returns the work with the patch applied and the fields to set on it.
+func (p *bulkWorkPatch) apply(work *collection.Work, now time.Time) (collection.Work, bson.M) {
+	updated := *work
+	set := bson.M{"last_modified_at": now}
+	for field, labelID := range p.labels {
+		setWorkLabelField(&updated, field, labelID)
+		set[field] = labelID
+	}
+	if p.setGoal {
+		updated.GoalID = p.goalID
+		set["goal_id"] = p.goalID
+	}
+	if p.shift != 0 {
+		if updated.StartDate != nil {
+			startDate := updated.StartDate.Add(p.shift)
+			updated.StartDate = &startDate
+			set["start_date"] = startDate
+		}
+		updated.EndDate = updated.EndDate.Add(p.shift)
+		set["end_date"] = updated.EndDate
+	}
+	updated.LastModifiedAt = now
+	return updated, set
+}
+
+// BulkUpdateWorks applies one patch to the works of the user in a single bulk write.
+// Works which do not exist or belong to another user are reported as not found and left untouched.
+func (s *workService) BulkUpdateWorks(ctx context.Context, req *personal_schedule.BulkUpdateWorksRequest) (*personal_schedule.BulkUpdateWorksResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	if len(req.WorkIds) > workgeneration_constant.MAX_BULK_WORKS {
+		return &personal_schedule.BulkUpdateWorksResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.TooManyWorks, fmt.Errorf("at most %d works can be updated at once", workgeneration_constant.MAX_BULK_WORKS)),
+		}, nil
+	}
+
+	patch, err := s.validateBulkWorkPatch(ctx, req.UserId, req.Patch)
+	if err != nil {
+		s.logger.Warn("Invalid bulk work patch", requestID, zap.String("user_id", req.UserId), zap.Error(err))
+		return &personal_schedule.BulkUpdateWorksResponse{
+			Error: bulkWorkError(ctx, err),
+		}, nil
+	}
+
+	workIDs := parseBulkWorkIDs(req.WorkIds)
+	found := make(map[bson.ObjectID]bool)
+	err = withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		clear(found)
+		works, err := s.workRepo.GetWorksByIDs(txCtx, req.UserId, workIDs.objectIDs())
+		if err != nil {
+			return err
+		}
+		if len(works) == 0 {
+			return nil
+		}
+
+		now := time.Now().UTC()
+		models := make([]mongo.WriteModel, 0, len(works))
+		updatedWorks := make([]collection.Work, 0, len(works))
+		for i := range works {
+			updated, set := patch.apply(&works[i], now)
+			models = append(models, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"_id": works[i].ID, "user_id": req.UserId}).
+				SetUpdate(bson.M{"$set": set}))
+			updatedWorks = append(updatedWorks, updated)
+		}
+		if err := s.workRepo.BulkUpdateWorks(txCtx, models); err != nil {
+			return err
+		}
+
+		for i := range works {
+			if err := s.recordWorkLabelChanges(txCtx, &works[i], &updatedWorks[i], req.UserId); err != nil {
+				return err
+			}
+			if err := s.insertWorkLifecycleEvents(txCtx, lifecycle_constant.WORK_UPDATED, &works[i], &updatedWorks[i], req.UserId); err != nil {
+				return err
+			}
+			found[works[i].ID] = true
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to bulk update works", requestID, zap.String("user_id", req.UserId), zap.Error(err))
+		failure := utils.DatabaseError(ctx, err)
+		return &personal_schedule.BulkUpdateWorksResponse{
+			Results: workIDs.results(ctx, allBulkWorks(workIDs), failure),
+			Error:   failure,
+		}, nil
+	}
+
+	return &personal_schedule.BulkUpdateWorksResponse{
+		IsSuccess:    true,
+		Message:      i18n.T(ctx, i18n.MsgWorksBulkUpdated, len(found)),
+		UpdatedCount: int32(len(found)),
+		Results:      workIDs.results(ctx, found, nil),
+	}, nil
+}
+
+// BulkDeleteWorks deletes the works of the user with their sub tasks in a single bulk write.
+// Deleted occurrences of a repeated series are excluded from it so they are not expanded again.
+func (s *workService) BulkDeleteWorks(ctx context.Context, req *personal_schedule.BulkDeleteWorksRequest) (*personal_schedule.BulkDeleteWorksResponse, error) {
+	requestID := utils.GetRequestIDFromOutgoingContext(ctx)
+
+	if len(req.WorkIds) > workgeneration_constant.MAX_BULK_WORKS {
+		return &personal_schedule.BulkDeleteWorksResponse{
+			Error: utils.CustomError(ctx, common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.TooManyWorks, fmt.Errorf("at most %d works can be deleted at once", workgeneration_constant.MAX_BULK_WORKS)),
+		}, nil
+	}
+
+	workIDs := parseBulkWorkIDs(req.WorkIds)
+	found := make(map[bson.ObjectID]bool)
+	err := withTransaction(ctx, s.mongoConnector, func(txCtx context.Context) error {
+		clear(found)
+		works, err := s.workRepo.GetWorksByIDs(txCtx, req.UserId, workIDs.objectIDs())
+		if err != nil {
+			return err
+		}
+		if len(works) == 0 {
+			return nil
+		}
+
+		models := make([]mongo.WriteModel, 0, len(works))
+		deletedIDs := make([]bson.ObjectID, 0, len(works))
+		for _, work := range works {
+			models = append(models, mongo.NewDeleteOneModel().
+				SetFilter(bson.M{"_id": work.ID, "user_id": req.UserId}))
+			deletedIDs = append(deletedIDs, work.ID)
+		}
+		if err := s.workRepo.BulkUpdateWorks(txCtx, models); err != nil {
+			return err
+		}
+		if err := s.workRepo.DeleteSubTasksByWorkIDs(txCtx, deletedIDs); err != nil {
+			return err
+		}
+
+		for i := range works {
+			work := &works[i]
+			if err := s.insertWorkLifecycleEvents(txCtx, lifecycle_constant.WORK_DELETED, nil, work, req.UserId); err != nil {
+				return err
+			}
+			if work.RepeatedID != nil {
+				exDate := work.StartDate
+				if work.RecurrenceID != nil {
+					exDate = work.RecurrenceID
+				}
+				if exDate != nil {
+					if err := s.workRepo.AddRepeatedSeriesExDate(txCtx, *work.RepeatedID, *exDate); err != nil {
+						return err
+					}
+				}
+			}
+			found[work.ID] = true
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to bulk delete works", requestID, zap.String("user_id", req.UserId), zap.Error(err))
+		failure := utils.DatabaseError(ctx, err)
+		return &personal_schedule.BulkDeleteWorksResponse{
+			Results: workIDs.results(ctx, allBulkWorks(workIDs), failure),
+			Error:   failure,
+		}, nil
+	}
+
+	return &personal_schedule.BulkDeleteWorksResponse{
+		IsSuccess:    true,
+		Message:      i18n.T(ctx, i18n.MsgWorksBulkDeleted, len(found)),
+		DeletedCount: int32(len(found)),
+		Results:      workIDs.results(ctx, found, nil),
+	}, nil
+}
+
+// allBulkWorks marks every well formed id as found, so a failed write reports its error on each of them.
+func allBulkWorks(workIDs bulkWorkIDs) map[bson.ObjectID]bool {
+	found := make(map[bson.ObjectID]bool, len(workIDs.ids))
+	for _, id := range workIDs.ids {
+		found[id] = true
+	}
+	return found
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"math"
 	"personal_schedule_service/global"
+	"slices"
 	"strings"
 	"time"
 	"unicode"
@@ -95,28 +96,62 @@ func SafeInt64(i *int64) int64 {
 	return *i
 }
 
+// RemoveAccent lowercases s without its diacritics, the vietnamese đ is not a diacritic and is folded to d too.
 func RemoveAccent(s string) string {
-	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), runes.Map(foldStroke), norm.NFC)
 	result, _, _ := transform.String(t, s)
 	return strings.ToLower(result)
 }
 
+func foldStroke(r rune) rune {
+	switch r {
+	case 'đ', 'Đ':
+		return 'd'
+	}
+	return r
+}
+
+// NormalizeTags trims and lowercases the tags, dropping the empty and duplicated ones while keeping their order.
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" && !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
+
 func TruncateToDay(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
 }
 
-func VietNameLocalDateRangeUTC(localDate string) (time.Time, time.Time, error) {
-	t, err := time.ParseInLocation("2006-01-02", localDate, global.HCMTimeLocation)
+// LocalDateRangeUTC returns the UTC bounds of the local day, which is shorter or longer on DST changes.
+func LocalDateRangeUTC(localDate string, loc *time.Location) (time.Time, time.Time, error) {
+	t, err := time.ParseInLocation("2006-01-02", localDate, loc)
 	if err != nil {
 		return time.Time{}, time.Time{}, err
 	}
 
-	startLocal := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, global.HCMTimeLocation)
-	endLocal := startLocal.Add(24 * time.Hour)
+	startLocal := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	endLocal := startLocal.AddDate(0, 0, 1)
 
 	return startLocal.UTC(), endLocal.UTC(), nil
 }
 
+// LoadLocationOrDefault loads the IANA time zone, falling back to the service default when it is empty or unknown.
+func LoadLocationOrDefault(timeZone string) *time.Location {
+	if timeZone == "" {
+		return global.HCMTimeLocation
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return global.HCMTimeLocation
+	}
+	return loc
+}
+
 func ToCompactJSON(data any) (string, error) {
 	if data == nil {
 		return "null", nil
@@ -129,12 +164,12 @@ func ToCompactJSON(data any) (string, error) {
 	return string(b), nil
 }
 
-func ParseLocalTimePtrToUTC(value string, layout string) (*time.Time, error) {
+func ParseLocalTimePtrToUTC(value string, layout string, loc *time.Location) (*time.Time, error) {
 	if value == "" {
 		return nil, nil
 	}
 
-	t, err := time.ParseInLocation(layout, value, global.HCMTimeLocation)
+	t, err := time.ParseInLocation(layout, value, loc)
 	if err != nil {
 		return nil, err
 	}
@@ -143,8 +178,8 @@ func ParseLocalTimePtrToUTC(value string, layout string) (*time.Time, error) {
 	return &utc, nil
 }
 
-func ParseLocalTimeToUTC(value string, layout string) (time.Time, error) {
-	t, err := time.ParseInLocation(layout, value, global.HCMTimeLocation)
+func ParseLocalTimeToUTC(value string, layout string, loc *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation(layout, value, loc)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -3,6 +3,7 @@ package utils
 import (
 	"context"
 	"personal_schedule_service/global"
+	"personal_schedule_service/internal/i18n"
 	"personal_schedule_service/proto/common"
 
 	"go.uber.org/zap"
@@ -20,7 +21,7 @@ func DatabaseError(ctx context.Context, err error) *common.Error {
 	logger.Error("Database operation failed", requestId, zap.Error(err))
 	e := &common.Error{
 		Code:    common.ErrorCode_ERROR_CODE_DATABASE_ERROR,
-		Message: "Database operation failed",
+		Message: i18n.T(ctx, i18n.MsgDatabaseError),
 	}
 	return e
 }
@@ -31,7 +32,7 @@ func NotFoundError(ctx context.Context, err error) *common.Error {
 	logger.Error("Resource not found", requestId, zap.Error(err))
 	e := &common.Error{
 		Code:    common.ErrorCode_ERROR_CODE_NOT_FOUND,
-		Message: "Not found error",
+		Message: i18n.T(ctx, i18n.MsgNotFound),
 	}
 	return e
 }
@@ -42,7 +43,7 @@ func RuntimeError(ctx context.Context, err error) *common.Error {
 	logger.Error("An unexpected error occurred: runtime error", requestId, zap.Error(err))
 	e := &common.Error{
 		Code:    common.ErrorCode_ERROR_CODE_RUN_TIME_ERROR,
-		Message: "An unexpected error occurred: runtime error",
+		Message: i18n.T(ctx, i18n.MsgRuntimeError),
 	}
 	return e
 }
@@ -53,7 +54,7 @@ func UnauthorizedError(ctx context.Context, err error) *common.Error {
 	logger.Error("Unauthorized access", requestId, zap.Error(err))
 	e := &common.Error{
 		Code:    common.ErrorCode_ERROR_CODE_UNAUTHORIZED,
-		Message: "Unauthorized access",
+		Message: i18n.T(ctx, i18n.MsgUnauthorized),
 	}
 	return e
 }
@@ -64,7 +65,7 @@ func PermissionDeniedError(ctx context.Context, err error) *common.Error {
 	logger.Error("Permission denied", requestId, zap.Error(err))
 	e := &common.Error{
 		Code:    common.ErrorCode_ERROR_CODE_PERMISSION_DENIED,
-		Message: "Permission denied",
+		Message: i18n.T(ctx, i18n.MsgPermissionDenied),
 	}
 	return e
 }
@@ -75,7 +76,7 @@ func InternalServerError(ctx context.Context, err error) *common.Error {
 	logger.Error("Internal server error", requestId, zap.Error(err))
 	e := &common.Error{
 		Code:    common.ErrorCode_ERROR_CODE_INTERNAL_ERROR,
-		Message: "Internal server error",
+		Message: i18n.T(ctx, i18n.MsgInternalError),
 	}
 	return e
 }
@@ -84,13 +85,18 @@ func InternalServerError(ctx context.Context, err error) *common.Error {
 // with code is the type of common.ErrorCode
 // errorCode is an integer representing a specific error scenario within that type.
 // errorCode is defined in the ErrorMessage const map on each service level.
+// The message is the one of the errorCode in the locale of the request, else the message of err.
 func CustomError(ctx context.Context, code common.ErrorCode, errorCode int32, err error) *common.Error {
 	logger := global.Logger
 	requestId := GetRequestIDFromOutgoingContext(ctx)
 	logger.Error("custom error occurred", requestId, zap.Error(err))
+	message, ok := i18n.ErrorMessage(ctx, errorCode)
+	if !ok {
+		message = err.Error()
+	}
 	e := &common.Error{
 		Code:      code,
-		Message:   err.Error(),
+		Message:   message,
 		ErrorCode: &errorCode,
 	}
 	return e
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"math"
 	"personal_schedule_service/internal/grpc/models"
 	"personal_schedule_service/proto/common"
@@ -46,3 +48,21 @@ func ToPageInfo(page, pageSize, totalItems int32) *common.PageInfo {
 		HasNext:    page < totalPages,
 	}
 }
+
+// EncodeCursor serializes the position of a page into an opaque cursor.
+func EncodeCursor(cursor any) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor reads a cursor of EncodeCursor into out.
+func DecodeCursor(cursor string, out any) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
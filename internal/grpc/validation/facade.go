@@ -5,6 +5,7 @@ import (
 	"personal_schedule_service/global"
 	"personal_schedule_service/internal/collection"
 	event_models "personal_schedule_service/internal/eventbus/models"
+	"personal_schedule_service/internal/grpc/helper"
 	"personal_schedule_service/internal/repos"
 	"personal_schedule_service/proto/personal_schedule"
 )
@@ -14,9 +15,14 @@ type (
 		ValidateUpsertWork(ctx context.Context, req *personal_schedule.UpsertWorkRequest) error
 		ValidatePrompts(req *personal_schedule.GenerateWorksByAIRequest) error
 		ValidateWorkMessages(ctx context.Context, labelMap map[string]collection.Label, workMessages []event_models.WorkMessage) error
+		ValidateLabelOfType(ctx context.Context, userID string, labelID string, labelType int) error
 	}
 	GoalValidator interface {
 		ValidationGoal(ctx context.Context, req *personal_schedule.UpsertGoalRequest) error
+		ValidateLabelOfType(ctx context.Context, userID string, labelID string, labelType int) error
+	}
+	UserPreferenceValidator interface {
+		ValidateUpdateUserPreference(ctx context.Context, req *personal_schedule.UpdateUserPreferenceRequest) error
 	}
 )
 
@@ -25,9 +31,10 @@ func NewWorkValidator(
 	label repos.LabelRepo,
 ) WorkValidator {
 	return &workValidator{
-		workRepo:  workRepo,
-		labelRepo: label,
-		logger:    global.Logger,
+		workRepo:         workRepo,
+		labelRepo:        label,
+		recurrenceHelper: helper.NewRecurrenceHelper(),
+		logger:           global.Logger,
 	}
 }
 
@@ -40,3 +47,11 @@ func NewGoalValidator(
 		labelRepo: label,
 	}
 }
+
+func NewUserPreferenceValidator(
+	label repos.LabelRepo,
+) UserPreferenceValidator {
+	return &userPreferenceValidator{
+		labelRepo: label,
+	}
+}
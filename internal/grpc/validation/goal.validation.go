@@ -18,34 +18,48 @@ type goalValidator struct {
 	labelRepo repos.LabelRepo
 }
 
-func (gv *goalValidator) checkLabel(ctx context.Context, id string, name string) error {
+// checkLabel checks the label can be set by the user, a system label or one of their custom labels, of the type of the field.
+func (gv *goalValidator) checkLabel(ctx context.Context, userID string, id string, labelType int, name string) error {
 	if _, err := bson.ObjectIDFromHex(id); err != nil {
 		return NewValidationError(common.ErrorCode_ERROR_CODE_RUN_TIME_ERROR, app_error.LabelNotFoundCode, fmt.Sprintf("invalid %s format", name))
 	}
-	exists, err := gv.labelRepo.CheckLabelExistence(ctx, id)
+	exists, err := gv.labelRepo.CheckLabelExistence(ctx, userID, id)
 	if err != nil {
 		return err
 	}
 	if !exists {
 		return NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.LabelNotFoundCode, fmt.Sprintf("%s %s not found", name, id))
 	}
+	oid, _ := bson.ObjectIDFromHex(id)
+	label, err := gv.labelRepo.GetLabelByID(ctx, oid)
+	if err != nil {
+		return err
+	}
+	if label.LabelType != labelType {
+		return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, fmt.Sprintf("%s %s is not a label of type %d", name, id, labelType))
+	}
 	return nil
 }
 
+// ValidateLabelOfType checks the label can be set by the user and is of the given label type.
+func (gv *goalValidator) ValidateLabelOfType(ctx context.Context, userID string, labelID string, labelType int) error {
+	return gv.checkLabel(ctx, userID, labelID, labelType, "LabelId")
+}
+
 func (gv *goalValidator) ValidationGoal(ctx context.Context, req *personal_schedule.UpsertGoalRequest) error {
 	if req == nil {
 		return fmt.Errorf("request is nil")
 	}
-	if err := gv.checkLabel(ctx, req.StatusId, "StatusId"); err != nil {
+	if err := gv.checkLabel(ctx, req.UserId, req.StatusId, labels_constant.LabelTypeStatus, "StatusId"); err != nil {
 		return err
 	}
-	if err := gv.checkLabel(ctx, req.DifficultyId, "DifficultyId"); err != nil {
+	if err := gv.checkLabel(ctx, req.UserId, req.DifficultyId, labels_constant.LabelTypeDifficulty, "DifficultyId"); err != nil {
 		return err
 	}
-	if err := gv.checkLabel(ctx, req.PriorityId, "PriorityId"); err != nil {
+	if err := gv.checkLabel(ctx, req.UserId, req.PriorityId, labels_constant.LabelTypePriority, "PriorityId"); err != nil {
 		return err
 	}
-	if err := gv.checkLabel(ctx, req.CategoryId, "CategoryId"); err != nil {
+	if err := gv.checkLabel(ctx, req.UserId, req.CategoryId, labels_constant.LabelTypeCategory, "CategoryId"); err != nil {
 		return err
 	}
 
@@ -54,6 +68,11 @@ func (gv *goalValidator) ValidationGoal(ctx context.Context, req *personal_sched
 		return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidGoalName, "goal name cannot be empty or only contain special characters")
 	}
 
+	req.Tags = utils.NormalizeTags(req.Tags)
+	if err := checkTags(req.Tags); err != nil {
+		return err
+	}
+
 	for _, task := range req.Tasks {
 		if task.Id != nil && *task.Id != "" {
 			if _, err := bson.ObjectIDFromHex(*task.Id); err != nil {
@@ -0,0 +1,108 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	labels_constant "personal_schedule_service/internal/constant/labels"
+	user_constant "personal_schedule_service/internal/constant/user"
+	workgeneration_constant "personal_schedule_service/internal/constant/work"
+	"personal_schedule_service/internal/repos"
+	app_error "personal_schedule_service/pkg/settings/error"
+	"personal_schedule_service/proto/common"
+	"personal_schedule_service/proto/personal_schedule"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+type userPreferenceValidator struct {
+	labelRepo repos.LabelRepo
+}
+
+// checkDefaultLabel checks the label exists and is of the type it is the default of.
+func (uv *userPreferenceValidator) checkDefaultLabel(ctx context.Context, userID string, id *string, labelType int, name string) error {
+	if id == nil || *id == "" {
+		return nil
+	}
+	labelID, err := bson.ObjectIDFromHex(*id)
+	if err != nil {
+		return NewValidationError(common.ErrorCode_ERROR_CODE_RUN_TIME_ERROR, app_error.LabelNotFoundCode, fmt.Sprintf("invalid %s format", name))
+	}
+	label, err := uv.labelRepo.GetLabelByID(ctx, labelID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.LabelNotFoundCode, fmt.Sprintf("%s %s not found", name, *id))
+		}
+		return err
+	}
+	if label.UserID != nil && *label.UserID != userID {
+		return NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.LabelNotFoundCode, fmt.Sprintf("%s %s not found", name, *id))
+	}
+	if label.LabelType != labelType {
+		return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.LabelNotFoundCode, fmt.Sprintf("%s %s is not a label of the expected type", name, *id))
+	}
+	return nil
+}
+
+func (uv *userPreferenceValidator) ValidateUpdateUserPreference(ctx context.Context, req *personal_schedule.UpdateUserPreferenceRequest) error {
+	if req.UserId == "" {
+		return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidUserPreference, "user id is required")
+	}
+	if req.TimeZone != "" {
+		if _, err := time.LoadLocation(req.TimeZone); err != nil {
+			return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidTimeZone, fmt.Sprintf("invalid time zone %q", req.TimeZone))
+		}
+	}
+	if req.Locale != "" && !slices.Contains(user_constant.SUPPORTED_LOCALES, req.Locale) {
+		return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidUserPreference, fmt.Sprintf("unsupported locale %q", req.Locale))
+	}
+	if req.WeekStart < 0 || req.WeekStart > 6 {
+		return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidUserPreference, "week start must be between 0 (sunday) and 6 (saturday)")
+	}
+	if err := ValidateWorkingHours(req.WorkingHours); err != nil {
+		return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidUserPreference, err.Error())
+	}
+	if len(req.ReminderOffsetsMinutes) > user_constant.MAX_REMINDER_OFFSETS {
+		return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidUserPreference, fmt.Sprintf("at most %d reminder offsets", user_constant.MAX_REMINDER_OFFSETS))
+	}
+	for _, offset := range req.ReminderOffsetsMinutes {
+		if offset < 0 || offset > user_constant.MAX_REMINDER_OFFSET_MINUTES {
+			return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidUserPreference, fmt.Sprintf("reminder offset must be between 0 and %d minutes", user_constant.MAX_REMINDER_OFFSET_MINUTES))
+		}
+	}
+	if req.DailyCapacityHours < 0 || req.DailyCapacityHours > user_constant.MAX_DAILY_CAPACITY_HOURS {
+		return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidUserPreference, fmt.Sprintf("daily capacity must be between 0 and %d hours", user_constant.MAX_DAILY_CAPACITY_HOURS))
+	}
+
+	if labels := req.DefaultLabels; labels != nil {
+		if err := uv.checkDefaultLabel(ctx, req.UserId, labels.TypeId, labels_constant.LabelTypeWorkType, "TypeId"); err != nil {
+			return err
+		}
+		if err := uv.checkDefaultLabel(ctx, req.UserId, labels.StatusId, labels_constant.LabelTypeStatus, "StatusId"); err != nil {
+			return err
+		}
+		if err := uv.checkDefaultLabel(ctx, req.UserId, labels.DifficultyId, labels_constant.LabelTypeDifficulty, "DifficultyId"); err != nil {
+			return err
+		}
+		if err := uv.checkDefaultLabel(ctx, req.UserId, labels.PriorityId, labels_constant.LabelTypePriority, "PriorityId"); err != nil {
+			return err
+		}
+		if err := uv.checkDefaultLabel(ctx, req.UserId, labels.CategoryId, labels_constant.LabelTypeCategory, "CategoryId"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateWorkingHours checks every window lies in a single day and is not empty.
+func ValidateWorkingHours(workingHours []*personal_schedule.WorkingHours) error {
+	for _, hours := range workingHours {
+		if hours.Weekday < 0 || hours.Weekday > 6 || hours.StartMinute < 0 || hours.EndMinute > workgeneration_constant.MINUTES_PER_DAY || hours.EndMinute <= hours.StartMinute {
+			return fmt.Errorf("invalid working hours %v", hours)
+		}
+	}
+	return nil
+}
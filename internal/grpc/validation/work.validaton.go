@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"personal_schedule_service/internal/collection"
 	labels_constant "personal_schedule_service/internal/constant/labels"
+	tag_constant "personal_schedule_service/internal/constant/tag"
 	event_models "personal_schedule_service/internal/eventbus/models"
+	"personal_schedule_service/internal/grpc/helper"
 	"personal_schedule_service/internal/grpc/utils"
 	"personal_schedule_service/internal/repos"
 	app_error "personal_schedule_service/pkg/settings/error"
 	"personal_schedule_service/proto/common"
 	"personal_schedule_service/proto/personal_schedule"
 	"time"
+	"unicode/utf8"
 
 	"github.com/thanvuc/go-core-lib/log"
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -19,43 +22,71 @@ import (
 )
 
 type workValidator struct {
-	workRepo  repos.WorkRepo
-	labelRepo repos.LabelRepo
-	logger    log.Logger
+	workRepo         repos.WorkRepo
+	labelRepo        repos.LabelRepo
+	recurrenceHelper helper.RecurrenceHelper
+	logger           log.Logger
 }
 
-func (wv *workValidator) checkLabel(ctx context.Context, id string, name string) error {
+// checkLabel checks the label can be set by the user, a system label or one of their custom labels, of the type of the field.
+func (wv *workValidator) checkLabel(ctx context.Context, userID string, id string, labelType int, name string) error {
 	if _, err := bson.ObjectIDFromHex(id); err != nil {
 		return NewValidationError(common.ErrorCode_ERROR_CODE_RUN_TIME_ERROR, app_error.LabelNotFoundCode, fmt.Sprintf("invalid %s format", name))
 	}
-	exists, err := wv.labelRepo.CheckLabelExistence(ctx, id)
+	exists, err := wv.labelRepo.CheckLabelExistence(ctx, userID, id)
 	if err != nil {
 		return err
 	}
 	if !exists {
 		return NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.LabelNotFoundCode, fmt.Sprintf("%s %s not found", name, id))
 	}
+	oid, _ := bson.ObjectIDFromHex(id)
+	label, err := wv.labelRepo.GetLabelByID(ctx, oid)
+	if err != nil {
+		return err
+	}
+	if label.LabelType != labelType {
+		return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidLabel, fmt.Sprintf("%s %s is not a label of type %d", name, id, labelType))
+	}
 	return nil
 }
 
+// checkTags checks the normalized tags of a work or goal are within the tag limits.
+func checkTags(tags []string) error {
+	if len(tags) > tag_constant.MAX_TAGS_PER_ITEM {
+		return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidTag, fmt.Sprintf("cannot have more than %d tags", tag_constant.MAX_TAGS_PER_ITEM))
+	}
+	for _, tag := range tags {
+		if utf8.RuneCountInString(tag) > tag_constant.MAX_TAG_LENGTH {
+			return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidTag, fmt.Sprintf("tag %q is longer than %d characters", tag, tag_constant.MAX_TAG_LENGTH))
+		}
+	}
+	return nil
+}
+
+// ValidateLabelOfType checks the label can be set by the user and is of the given label type.
+func (wv *workValidator) ValidateLabelOfType(ctx context.Context, userID string, labelID string, labelType int) error {
+	return wv.checkLabel(ctx, userID, labelID, labelType, "LabelId")
+}
+
 func (wv *workValidator) ValidateUpsertWork(ctx context.Context, req *personal_schedule.UpsertWorkRequest) error {
 	if req == nil {
 		return fmt.Errorf("request is nil")
 	}
 
-	if err := wv.checkLabel(ctx, req.TypeId, "TypeId"); err != nil {
+	if err := wv.checkLabel(ctx, req.UserId, req.TypeId, labels_constant.LabelTypeWorkType, "TypeId"); err != nil {
 		return err
 	}
-	if err := wv.checkLabel(ctx, req.StatusId, "StatusId"); err != nil {
+	if err := wv.checkLabel(ctx, req.UserId, req.StatusId, labels_constant.LabelTypeStatus, "StatusId"); err != nil {
 		return err
 	}
-	if err := wv.checkLabel(ctx, req.DifficultyId, "DifficultyId"); err != nil {
+	if err := wv.checkLabel(ctx, req.UserId, req.DifficultyId, labels_constant.LabelTypeDifficulty, "DifficultyId"); err != nil {
 		return err
 	}
-	if err := wv.checkLabel(ctx, req.PriorityId, "PriorityId"); err != nil {
+	if err := wv.checkLabel(ctx, req.UserId, req.PriorityId, labels_constant.LabelTypePriority, "PriorityId"); err != nil {
 		return err
 	}
-	if err := wv.checkLabel(ctx, req.CategoryId, "CategoryId"); err != nil {
+	if err := wv.checkLabel(ctx, req.UserId, req.CategoryId, labels_constant.LabelTypeCategory, "CategoryId"); err != nil {
 		return err
 	}
 
@@ -64,6 +95,11 @@ func (wv *workValidator) ValidateUpsertWork(ctx context.Context, req *personal_s
 		return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidWorkName, "work name cannot be empty or only contain special characters")
 	}
 
+	req.Tags = utils.NormalizeTags(req.Tags)
+	if err := checkTags(req.Tags); err != nil {
+		return err
+	}
+
 	if req.GoalId != nil && *req.GoalId != "" {
 		if _, err := bson.ObjectIDFromHex(*req.GoalId); err != nil {
 			return NewValidationError(common.ErrorCode_ERROR_CODE_NOT_FOUND, app_error.GoalNotFoundCode, "invalid GoalId")
@@ -99,39 +135,38 @@ func (wv *workValidator) ValidateUpsertWork(ctx context.Context, req *personal_s
 		}
 
 		if isRepeated {
-			if req.RepeatStartDate == nil || req.RepeatEndDate == nil {
-				return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.RepeatedWorkMissingDates, "non-repeated work cannot have repeat dates")
-			}
-			if *req.RepeatStartDate >= *req.RepeatEndDate {
-				return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.RepeatedWorkInvalidDates, "invalid repeat dates for non-repeated work")
+			if req.Recurrence == nil || req.Recurrence.Rrule == "" {
+				if req.RepeatStartDate == nil || req.RepeatEndDate == nil {
+					return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.RepeatedWorkMissingDates, "non-repeated work cannot have repeat dates")
+				}
+				if *req.RepeatStartDate >= *req.RepeatEndDate {
+					return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.RepeatedWorkInvalidDates, "invalid repeat dates for non-repeated work")
+				}
 			}
-			baseStart := time.UnixMilli(*req.StartDate).UTC()
-			baseEnd := time.UnixMilli(req.EndDate).UTC()
-			duration := baseEnd.Sub(baseStart)
-
-			hour, min, sec := baseStart.Clock()
-
-			loopDate := time.UnixMilli(*req.RepeatStartDate).UTC()
-			limitDate := time.UnixMilli(*req.RepeatEndDate).UTC()
-
-			firstInstanceStart := time.Date(
-				loopDate.Year(), loopDate.Month(), loopDate.Day(),
-				hour, min, sec, 0, time.UTC,
-			)
 
-			lastInstanceStart := time.Date(
-				limitDate.Year(), limitDate.Month(), limitDate.Day(),
-				hour, min, sec, 0, time.UTC,
-			)
+			rec, err := wv.recurrenceHelper.BuildFromUpsertRequest(req)
+			if err != nil {
+				return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidRecurrenceRule, err.Error())
+			}
+			occurrences, err := wv.recurrenceHelper.Occurrences(rec)
+			if err != nil {
+				return NewValidationError(common.ErrorCode_ERROR_CODE_INTERNAL_ERROR, app_error.InvalidRecurrenceRule, err.Error())
+			}
 
-			rangeStart := firstInstanceStart
-			rangeEnd := lastInstanceStart.Add(duration)
+			duration := time.UnixMilli(req.EndDate).Sub(time.UnixMilli(*req.StartDate))
+			rangeStart := occurrences[0]
+			rangeEnd := occurrences[len(occurrences)-1].Add(duration)
 
 			var excludeWorkID *bson.ObjectID
+			var excludeRepeatedID *bson.ObjectID
 			if req.Id != nil && *req.Id != "" {
 				workID, err := bson.ObjectIDFromHex(*req.Id)
 				if err == nil {
 					excludeWorkID = &workID
+					// instances of the same series are moved together, they cannot overlap each other
+					if currentWork, err := wv.workRepo.GetWorkByID(ctx, workID); err == nil && currentWork != nil {
+						excludeRepeatedID = currentWork.RepeatedID
+					}
 				}
 			}
 
@@ -151,11 +186,7 @@ func (wv *workValidator) ValidateUpsertWork(ctx context.Context, req *personal_s
 				)
 			}
 
-			for !utils.TruncateToDay(loopDate).After(utils.TruncateToDay(limitDate)) {
-
-				y, m, d := loopDate.Date()
-
-				instanceStart := time.Date(y, m, d, hour, min, sec, 0, time.UTC)
+			for _, instanceStart := range occurrences {
 				instanceEnd := instanceStart.Add(duration)
 
 				for _, w := range existingWorks {
@@ -163,6 +194,9 @@ func (wv *workValidator) ValidateUpsertWork(ctx context.Context, req *personal_s
 					if w.StartDate == nil {
 						continue
 					}
+					if excludeRepeatedID != nil && w.RepeatedID != nil && *w.RepeatedID == *excludeRepeatedID {
+						continue
+					}
 
 					existingStart := *w.StartDate
 					existingEnd := w.EndDate
@@ -180,8 +214,6 @@ func (wv *workValidator) ValidateUpsertWork(ctx context.Context, req *personal_s
 						)
 					}
 				}
-
-				loopDate = loopDate.AddDate(0, 0, 1)
 			}
 		}
 
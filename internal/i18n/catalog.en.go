@@ -0,0 +1,245 @@
+package i18n
+
+import (
+	labels_constant "personal_schedule_service/internal/constant/labels"
+	app_error "personal_schedule_service/pkg/settings/error"
+)
+
+var enCatalog = catalog{
+	messages: map[string]string{
+		MsgDatabaseError:    "Database operation failed",
+		MsgNotFound:         "Resource not found",
+		MsgRuntimeError:     "An unexpected error occurred",
+		MsgUnauthorized:     "Unauthorized access",
+		MsgPermissionDenied: "Permission denied",
+		MsgInternalError:    "Internal server error",
+		MsgValidationError:  "Validation error",
+		MsgInvalidData:      "Invalid data format: %s",
+
+		MsgLabelDeleted:    "Label deleted successfully",
+		MsgLabelDeprecated: "Label deprecated successfully",
+
+		MsgGoalUpserted:       "Goal upserted successfully",
+		MsgGoalCreateFailed:   "Failed to create goal",
+		MsgGoalUpdateFailed:   "Failed to update goal",
+		MsgGoalNotFound:       "Goal not found",
+		MsgGoalForbidden:      "You do not own this goal",
+		MsgGoalHasLinkedWorks: "Goal has %d linked works",
+		MsgGoalLabelUpdated:   "Goal label updated successfully",
+
+		MsgWorkUpserted:             "Work upserted successfully",
+		MsgWorkUpsertFailed:         "Failed to upsert work",
+		MsgWorkNotFound:             "Work not found",
+		MsgWorkEndBeforeStart:       "End time must be after start time",
+		MsgWorkLabelUpdated:         "Label updated successfully",
+		MsgNotificationEventFailed:  "Failed to build notification event",
+		MsgOverlapCheckFailed:       "Failed to check overlapping works",
+		MsgRepeatedWorksCreated:     "Created %d repeated works",
+		MsgRepeatedWorksUpdated:     "Updated %d works in chain",
+		MsgWorkNotRepeated:          "This work is not part of a repeated chain",
+		MsgRepeatedWorkMissingStart: "Repeated work must have a start date",
+		MsgRecoverTimesInvalid:      "Failed to parse recover times",
+		MsgRecoverSourceFailed:      "Failed to get source works",
+		MsgRecoverCloneFailed:       "Failed to clone work",
+		MsgRecoverInsertFailed:      "Failed to insert recovered works",
+		MsgWorksRecovered:           "Works recovered successfully",
+		MsgDraftLabelFailed:         "Failed to get draft label",
+		MsgDraftWorksFailed:         "Failed to get draft works",
+		MsgNoDraftWorks:             "No draft works to accept",
+		MsgDraftWorksOverlap:        "Some draft works overlap with existing works",
+		MsgDraftCommitFailed:        "Failed to commit drafts",
+		MsgDraftsCommitted:          "Recovery drafts committed successfully",
+		MsgDraftDeleteFailed:        "Failed to delete draft works",
+		MsgDraftsDeleted:            "Draft works deleted successfully",
+		MsgWorkGenerationSubmitted:  "Work generation request submitted successfully, processing in background",
+		MsgWorksBulkUpdated:         "Updated %d works",
+		MsgWorksBulkDeleted:         "Deleted %d works",
+
+		MsgFeedTokenRevoked: "Calendar feed token revoked",
+
+		MsgTagRenamed: "Tag renamed successfully",
+		MsgTagsMerged: "Tags merged successfully",
+
+		MsgWorkGenerationFailedTitle:    "AI work generation failed",
+		MsgWorkGenerationFailedMessage:  "Something went wrong while generating your works. Please try again later.",
+		MsgWorkGenerationSuccessTitle:   "AI work generation succeeded",
+		MsgWorkGenerationSuccessMessage: "Your works have been generated. Please check them in the app.",
+		MsgWorksOverdueTitle:            "Overdue works",
+		MsgWorksOverdueMessage:          "You have %d overdue works which are not completed yet",
+		MsgWorksGiveUpTitle:             "Given up works",
+		MsgWorksGiveUpMessage:           "%d overdue works have been moved to given up",
+	},
+	errors: map[int32]string{
+		app_error.LabelNotFoundCode:        "Label not found",
+		app_error.NotificationCannotBeSent: "Notification cannot be sent",
+		app_error.HoursOverlap:             "Hours overlap",
+		app_error.GoalNotFoundCode:         "Goal not found",
+		app_error.InvalidDateFormat:        "Invalid date format",
+		app_error.EndDateBeforeStart:       "End time must be after start time",
+		app_error.TimeOverlap:              "Time overlaps with another work",
+		app_error.WorkNotFound:             "Work not found",
+		app_error.WorkForbidden:            "You do not own this work",
+		app_error.SubTaskNotFound:          "Sub task not found",
+		app_error.ZeroDuration:             "Duration must be greater than zero",
+		app_error.DraftNotFound:            "Draft not found",
+		app_error.GoalForbidden:            "You do not own this goal",
+		app_error.GoalLimitExceeded:        "Goal limit exceeded",
+		app_error.RepeatedWorkMissingDates: "Repeated work must have start and end dates",
+		app_error.RepeatedWorkInvalidDates: "Repeated work has invalid dates",
+		app_error.InvalidGoalName:          "Invalid goal name",
+		app_error.InvalidWorkName:          "Invalid work name",
+		app_error.InvalidRecurrenceRule:    "Invalid recurrence rule",
+		app_error.GoalHasLinkedWorks:       "Goal has linked works",
+		app_error.InvalidTimeZone:          "Invalid time zone",
+		app_error.InvalidUserPreference:    "Invalid user preference",
+		app_error.InvalidCalendar:          "Invalid calendar",
+		app_error.FeedTokenExists:          "Calendar feed token already exists",
+		app_error.FeedTokenNotFound:        "Calendar feed token not found",
+		app_error.InvalidCursor:            "Invalid cursor",
+		app_error.InvalidSearchQuery:       "Invalid search query",
+		app_error.InvalidLabel:             "Invalid label",
+		app_error.LabelNameExists:          "Label name already exists",
+		app_error.LabelInUse:               "Label is in use",
+		app_error.CustomLabelLimitExceeded: "Custom label limit exceeded",
+		app_error.InvalidTag:               "Invalid tag",
+		app_error.LabelKeyExists:           "Label key already exists",
+		app_error.ProtectedLabel:           "Built-in system labels can not be changed",
+		app_error.EmptyWorkPatch:           "The patch has no change to apply to the works",
+		app_error.InvalidWorkingHours:      "Invalid working hours",
+		app_error.RangeTooLong:             "The time range is too long",
+		app_error.TooManyWorks:             "Too many works in one request",
+	},
+	labels: map[string]LabelText{
+		// Work Type
+		labels_constant.LabelRepeated: {
+			Name:    "Repeated",
+			Meaning: "Work done periodically, repeating on a cycle",
+			Note:    "Usually for recurring tasks or daily habits",
+		},
+		labels_constant.LabelInDay: {
+			Name:    "In Day",
+			Meaning: "Work done within that day only",
+			Note:    "Usually for tasks which can be finished within the day",
+		},
+		labels_constant.LabelGroup: {
+			Name:    "Group",
+			Meaning: "Work synced from your groups, it disappears when completed or deleted in the group",
+			Note:    "Your group work, handled in the group schedule management",
+		},
+
+		// Status
+		labels_constant.LabelPending: {
+			Name:    "Pending",
+			Meaning: "Work planned but not started yet",
+			Note:    "Waiting for the right conditions or resources to start",
+		},
+		labels_constant.LabelInProgress: {
+			Name:    "In Progress",
+			Meaning: "Work being done",
+			Note:    "Being processed, its progress needs to be followed",
+		},
+		labels_constant.LabelCompleted: {
+			Name:    "Completed",
+			Meaning: "Work completed successfully",
+			Note:    "The goals and requirements have been met",
+		},
+		labels_constant.LabelOverDue: {
+			Name:    "Overdue",
+			Meaning: "Work past its deadline (but still within the day)",
+			Note:    "Overdue work not marked as completed yet, it can still be completed while the day is not over",
+		},
+		labels_constant.LabelGiveUp: {
+			Name:    "Given Up",
+			Meaning: "Work not completed by the end of the day",
+			Note:    "The system marks the overdue works automatically once the day is over",
+		},
+
+		// Difficulty
+		labels_constant.LabelDifficultyEasy: {
+			Name:    "Easy",
+			Meaning: "Simple work, requiring neither high skills nor much effort",
+			Note:    "Can be done quickly, under 1h",
+		},
+		labels_constant.LabelDifficultyMedium: {
+			Name:    "Medium",
+			Meaning: "Work of moderate complexity",
+			Note:    "Needs some experience and skills, usually takes 1 - 3h to complete",
+		},
+		labels_constant.LabelDifficultyHard: {
+			Name:    "Hard",
+			Meaning: "Work requiring high focus or a lot of time",
+			Note:    "Needs much time, resources and deep expertise, usually takes over 3h to complete",
+		},
+
+		// Priority
+		labels_constant.LabelPriorityImportantUrgent: {
+			Name:    "Important & Urgent",
+			Meaning: "Highest priority - to be handled immediately",
+			Note:    "Usually emergencies or incidents to solve right away",
+		},
+		labels_constant.LabelPriorityImportantNotUrgent: {
+			Name:    "Important & Not Urgent",
+			Meaning: "Important but can be planned",
+			Note:    "Long term goals, strategic growth",
+		},
+		labels_constant.LabelPriorityNotImportantUrgent: {
+			Name:    "Not Important & Urgent",
+			Meaning: "To be handled quickly but with little impact",
+			Note:    "Can be delegated or handled quickly",
+		},
+		labels_constant.LabelPriorityNotImportantNotUrgent: {
+			Name:    "Not Important & Not Urgent",
+			Meaning: "Lowest priority - can be postponed",
+			Note:    "Limit the time spent on this kind of work",
+		},
+
+		// Category
+		labels_constant.LabelCategoryWork: {
+			Name:    "Work",
+			Meaning: "Tasks related to your career, company, business or ongoing projects",
+			Note:    "Includes meetings, projects, reports and deadlines.",
+		},
+		labels_constant.LabelCategoryPersonal: {
+			Name:    "Personal",
+			Meaning: "Private activities outside of work",
+			Note:    "Hobbies, personal goals, self care",
+		},
+		labels_constant.LabelCategoryStudy: {
+			Name:    "Study",
+			Meaning: "Learning, research or training activities.",
+			Note:    "For students, professional courses or self-study.",
+		},
+		labels_constant.LabelCategoryFamily: {
+			Name:    "Family",
+			Meaning: "Housework and family responsibilities",
+			Note:    "Cleaning, repairs, shopping, cooking, daily chores",
+		},
+		labels_constant.LabelCategoryFinance: {
+			Name:    "Finance",
+			Meaning: "Money management and administrative work",
+			Note:    "Paying bills, budgeting, taxes/ paperwork",
+		},
+		labels_constant.LabelCategoryHealth: {
+			Name:    "Health",
+			Meaning: "Physical and mental care activities",
+			Note:    "Exercising, medical checkups, meditation or health care",
+		},
+		labels_constant.LabelCategorySocial: {
+			Name:    "Social",
+			Meaning: "Physical and mental care activities",
+			Note:    "Exercising, medical checkups, meditation or health care",
+		},
+		labels_constant.LabelCategoryTravel: {
+			Name:    "Travel",
+			Meaning: "Going from one place to another",
+			Note:    "Commuting, business trips, travelling or running errands",
+		},
+
+		// Draft
+		labels_constant.LabelDraft: {
+			Name:    "Draft",
+			Meaning: "Work not saved yet",
+			Note:    "Work generated by AI or recovered from the day before, but not saved yet",
+		},
+	},
+}
@@ -0,0 +1,245 @@
+package i18n
+
+import (
+	labels_constant "personal_schedule_service/internal/constant/labels"
+	app_error "personal_schedule_service/pkg/settings/error"
+)
+
+var viCatalog = catalog{
+	messages: map[string]string{
+		MsgDatabaseError:    "Thao tác với cơ sở dữ liệu thất bại",
+		MsgNotFound:         "Không tìm thấy dữ liệu",
+		MsgRuntimeError:     "Đã xảy ra lỗi không mong muốn",
+		MsgUnauthorized:     "Bạn chưa được xác thực",
+		MsgPermissionDenied: "Bạn không có quyền thực hiện thao tác này",
+		MsgInternalError:    "Lỗi hệ thống",
+		MsgValidationError:  "Dữ liệu không hợp lệ",
+		MsgInvalidData:      "Định dạng dữ liệu không hợp lệ: %s",
+
+		MsgLabelDeleted:    "Đã xóa nhãn",
+		MsgLabelDeprecated: "Đã ngừng sử dụng nhãn",
+
+		MsgGoalUpserted:       "Đã lưu mục tiêu",
+		MsgGoalCreateFailed:   "Không thể tạo mục tiêu",
+		MsgGoalUpdateFailed:   "Không thể cập nhật mục tiêu",
+		MsgGoalNotFound:       "Không tìm thấy mục tiêu",
+		MsgGoalForbidden:      "Bạn không sở hữu mục tiêu này",
+		MsgGoalHasLinkedWorks: "Mục tiêu còn %d công việc liên kết",
+		MsgGoalLabelUpdated:   "Đã cập nhật nhãn của mục tiêu",
+
+		MsgWorkUpserted:             "Đã lưu công việc",
+		MsgWorkUpsertFailed:         "Không thể lưu công việc",
+		MsgWorkNotFound:             "Không tìm thấy công việc",
+		MsgWorkEndBeforeStart:       "Thời gian kết thúc phải sau thời gian bắt đầu",
+		MsgWorkLabelUpdated:         "Đã cập nhật nhãn",
+		MsgNotificationEventFailed:  "Không thể tạo thông báo cho công việc",
+		MsgOverlapCheckFailed:       "Không thể kiểm tra các công việc trùng lịch",
+		MsgRepeatedWorksCreated:     "Đã tạo %d công việc lặp lại",
+		MsgRepeatedWorksUpdated:     "Đã cập nhật %d công việc trong chuỗi",
+		MsgWorkNotRepeated:          "Công việc này không thuộc chuỗi lặp lại",
+		MsgRepeatedWorkMissingStart: "Công việc lặp lại phải có thời gian bắt đầu",
+		MsgRecoverTimesInvalid:      "Thời gian khôi phục không hợp lệ",
+		MsgRecoverSourceFailed:      "Không thể lấy các công việc cần khôi phục",
+		MsgRecoverCloneFailed:       "Không thể sao chép công việc",
+		MsgRecoverInsertFailed:      "Không thể lưu các công việc đã khôi phục",
+		MsgWorksRecovered:           "Đã khôi phục công việc",
+		MsgDraftLabelFailed:         "Không thể lấy nhãn bản nháp",
+		MsgDraftWorksFailed:         "Không thể lấy các công việc nháp",
+		MsgNoDraftWorks:             "Không có công việc nháp nào để lưu",
+		MsgDraftWorksOverlap:        "Một số công việc nháp trùng lịch với công việc hiện có",
+		MsgDraftCommitFailed:        "Không thể lưu các công việc nháp",
+		MsgDraftsCommitted:          "Đã lưu các công việc nháp",
+		MsgDraftDeleteFailed:        "Không thể xóa các công việc nháp",
+		MsgDraftsDeleted:            "Đã xóa các công việc nháp",
+		MsgWorkGenerationSubmitted:  "Đã gửi yêu cầu tạo công việc, hệ thống đang xử lý",
+		MsgWorksBulkUpdated:         "Đã cập nhật %d công việc",
+		MsgWorksBulkDeleted:         "Đã xóa %d công việc",
+
+		MsgFeedTokenRevoked: "Đã thu hồi liên kết lịch",
+
+		MsgTagRenamed: "Đã đổi tên thẻ",
+		MsgTagsMerged: "Đã gộp các thẻ",
+
+		MsgWorkGenerationFailedTitle:    "Tạo công việc với AI thất bại",
+		MsgWorkGenerationFailedMessage:  "Hệ thống gặp lỗi khi tạo công việc cho bạn. Vui lòng thử lại sau.",
+		MsgWorkGenerationSuccessTitle:   "Tạo công việc với AI thành công",
+		MsgWorkGenerationSuccessMessage: "Hệ thống đã tạo công việc cho bạn thành công. Vui lòng kiểm tra trong ứng dụng.",
+		MsgWorksOverdueTitle:            "Công việc quá hạn",
+		MsgWorksOverdueMessage:          "Bạn có %d công việc đã quá hạn nhưng chưa hoàn thành",
+		MsgWorksGiveUpTitle:             "Công việc bỏ cuộc",
+		MsgWorksGiveUpMessage:           "%d công việc quá hạn đã được chuyển sang trạng thái bỏ cuộc",
+	},
+	errors: map[int32]string{
+		app_error.LabelNotFoundCode:        "Không tìm thấy nhãn",
+		app_error.NotificationCannotBeSent: "Không thể gửi thông báo",
+		app_error.HoursOverlap:             "Các khung giờ bị trùng nhau",
+		app_error.GoalNotFoundCode:         "Không tìm thấy mục tiêu",
+		app_error.InvalidDateFormat:        "Định dạng ngày không hợp lệ",
+		app_error.EndDateBeforeStart:       "Thời gian kết thúc phải sau thời gian bắt đầu",
+		app_error.TimeOverlap:              "Thời gian bị trùng với công việc khác",
+		app_error.WorkNotFound:             "Không tìm thấy công việc",
+		app_error.WorkForbidden:            "Bạn không sở hữu công việc này",
+		app_error.SubTaskNotFound:          "Không tìm thấy công việc con",
+		app_error.ZeroDuration:             "Thời lượng phải lớn hơn 0",
+		app_error.DraftNotFound:            "Không tìm thấy bản nháp",
+		app_error.GoalForbidden:            "Bạn không sở hữu mục tiêu này",
+		app_error.GoalLimitExceeded:        "Đã vượt quá số lượng mục tiêu cho phép",
+		app_error.RepeatedWorkMissingDates: "Công việc lặp lại phải có thời gian bắt đầu và kết thúc",
+		app_error.RepeatedWorkInvalidDates: "Thời gian của công việc lặp lại không hợp lệ",
+		app_error.InvalidGoalName:          "Tên mục tiêu không hợp lệ",
+		app_error.InvalidWorkName:          "Tên công việc không hợp lệ",
+		app_error.InvalidRecurrenceRule:    "Quy tắc lặp lại không hợp lệ",
+		app_error.GoalHasLinkedWorks:       "Mục tiêu còn công việc liên kết",
+		app_error.InvalidTimeZone:          "Múi giờ không hợp lệ",
+		app_error.InvalidUserPreference:    "Cài đặt người dùng không hợp lệ",
+		app_error.InvalidCalendar:          "Tệp lịch không hợp lệ",
+		app_error.FeedTokenExists:          "Liên kết lịch đã tồn tại",
+		app_error.FeedTokenNotFound:        "Không tìm thấy liên kết lịch",
+		app_error.InvalidCursor:            "Con trỏ phân trang không hợp lệ",
+		app_error.InvalidSearchQuery:       "Từ khóa tìm kiếm không hợp lệ",
+		app_error.InvalidLabel:             "Nhãn không hợp lệ",
+		app_error.LabelNameExists:          "Tên nhãn đã tồn tại",
+		app_error.LabelInUse:               "Nhãn đang được sử dụng",
+		app_error.CustomLabelLimitExceeded: "Đã vượt quá số lượng nhãn tùy chỉnh cho phép",
+		app_error.InvalidTag:               "Thẻ không hợp lệ",
+		app_error.LabelKeyExists:           "Mã nhãn đã tồn tại",
+		app_error.ProtectedLabel:           "Không thể thay đổi nhãn mặc định của hệ thống",
+		app_error.EmptyWorkPatch:           "Chưa có thay đổi nào để áp dụng cho các công việc",
+		app_error.InvalidWorkingHours:      "Khung giờ làm việc không hợp lệ",
+		app_error.RangeTooLong:             "Khoảng thời gian quá dài",
+		app_error.TooManyWorks:             "Số lượng công việc vượt quá giới hạn cho phép",
+	},
+	labels: map[string]LabelText{
+		// Work Type
+		labels_constant.LabelRepeated: {
+			Name:    "Lặp Lại",
+			Meaning: "Công việc được thực hiện định kỳ, lặp lại theo chu kỳ",
+			Note:    "Thường dùng cho các công việc, hay những thói quen hằng ngày",
+		},
+		labels_constant.LabelInDay: {
+			Name:    "Trong Ngày",
+			Meaning: "Công việc thực hiện chỉ trong ngày hôm đó",
+			Note:    "Thường dùng cho các tác vụ giải quyết được trong ngày, không cần kéo dài",
+		},
+		labels_constant.LabelGroup: {
+			Name:    "Nhóm",
+			Meaning: "Công việc được đồng bộ từ các nhóm của bạn, sẽ biến mất khi hoàn thành hoặc xóa trong nhóm",
+			Note:    "Công việc nhóm của bạn, được xử lý ở chức năng quản lý lịch trình nhóm",
+		},
+
+		// Status
+		labels_constant.LabelPending: {
+			Name:    "Chờ Làm",
+			Meaning: "Công việc đã được lên kế hoạch nhưng chưa bắt đầu",
+			Note:    "Đang chờ điều kiện phù hợp hoặc tài nguyên để bắt đầu",
+		},
+		labels_constant.LabelInProgress: {
+			Name:    "Đang Làm",
+			Meaning: "Công việc đang được thực hiện",
+			Note:    "Đang trong quá trình xử lý, cần theo dõi tiến độ",
+		},
+		labels_constant.LabelCompleted: {
+			Name:    "Hoàn Thành",
+			Meaning: "Công việc đã được hoàn thành thành công",
+			Note:    "Đã đạt được mục tiêu và yêu cầu đề ra",
+		},
+		labels_constant.LabelOverDue: {
+			Name:    "Quá Hạn",
+			Meaning: "Công việc đã vượt quá thời hạn quy định (nhưng vẫn nằm trong ngày)",
+			Note:    "Là các công việc quá hạn thời gian nhưng chưa đánh dấu hoàn thành, có thể đánh dấu hoàn thành sau nếu vẫn còn trong ngày",
+		},
+		labels_constant.LabelGiveUp: {
+			Name:    "Bỏ Cuộc",
+			Meaning: "Công việc đã hết ngày nhưng chưa hoàn thành",
+			Note:    "Hệ thống tự động đánh dấu các công việc quá hạn và hết ngày",
+		},
+
+		// Difficulty
+		labels_constant.LabelDifficultyEasy: {
+			Name:    "Dễ",
+			Meaning: "Công việc đơn giản, không yêu cầu kỹ năng cao và công sức nhiều",
+			Note:    "Có thể hoàn thành nhanh chóng, dưới 1h",
+		},
+		labels_constant.LabelDifficultyMedium: {
+			Name:    "Trung Bình",
+			Meaning: "Công việc có độ phức tạp vừa phải",
+			Note:    "Cần có kinh nghiệm và kỹ năng nhất định để thực hiện, Thường tốn vừa phải thời gian 1 - 3h để hoàn thành",
+		},
+		labels_constant.LabelDifficultyHard: {
+			Name:    "Khó",
+			Meaning: "Công việc yêu cầu sự tập trung cao hoặc thời gian lớn",
+			Note:    "Cần nhiều thời gian, tài nguyên và chuyên môn sâu, thời gian hoàn thành thường là trên 3h",
+		},
+
+		// Priority
+		labels_constant.LabelPriorityImportantUrgent: {
+			Name:    "Quan Trọng & Khẩn Cấp",
+			Meaning: "Ưu tiên cao nhất - cần xử lý ngay lập tức",
+			Note:    "Thường là các vấn đề khẩn cấp, sự cố cần giải quyết ngay",
+		},
+		labels_constant.LabelPriorityImportantNotUrgent: {
+			Name:    "Quan Trọng & Không Khẩn Cấp",
+			Meaning: "Quan trọng nhưng có thể lên kế hoạch thực hiện",
+			Note:    "Các mục tiêu dài hạn, phát triển chiến lược",
+		},
+		labels_constant.LabelPriorityNotImportantUrgent: {
+			Name:    "Không Quan Trọng & Khẩn Cấp",
+			Meaning: "Cần xử lý nhanh nhưng không ảnh hưởng lớn",
+			Note:    "Có thể ủy quyền hoặc xử lý nhanh gọn",
+		},
+		labels_constant.LabelPriorityNotImportantNotUrgent: {
+			Name:    "Không Quan Trọng & Không Khẩn Cấp",
+			Meaning: "Ưu tiên thấp nhất - có thể hoãn lại",
+			Note:    "Nên hạn chế thời gian dành cho loại công việc này",
+		},
+
+		// Category
+		labels_constant.LabelCategoryWork: {
+			Name:    "Công Việc",
+			Meaning: "Nhiệm vụ liên quan đến nghề nghiệp, công ty, kinh doanh hoặc dự án đang làm",
+			Note:    "Bao gồm họp, dự án, báo cáo, và các hạn chót.",
+		},
+		labels_constant.LabelCategoryPersonal: {
+			Name:    "Cá Nhân",
+			Meaning: "Hoạt động riêng tư ngoài công việc",
+			Note:    "Sở thích, mục tiêu cá nhân, chăm sóc bản thân",
+		},
+		labels_constant.LabelCategoryStudy: {
+			Name:    "Học Tập",
+			Meaning: "Các hoạt động học hỏi, nghiên cứu, hoặc đào tạo.",
+			Note:    "Dành cho học sinh, sinh viên, khóa học chuyên môn, hoặc tự học.",
+		},
+		labels_constant.LabelCategoryFamily: {
+			Name:    "Gia Đình",
+			Meaning: "Công việc nhà và trách nhiệm trong gia đình",
+			Note:    "Dọn dẹp, sửa chữa, mua sắm, nấu ăn, việc vặt hằng ngày",
+		},
+		labels_constant.LabelCategoryFinance: {
+			Name:    "Tài Chính",
+			Meaning: "Quản lý tiền bạc và công việc hành chính",
+			Note:    "Thanh toán hóa đơn, lập ngân sách, thuế/ giấy tờ",
+		},
+		labels_constant.LabelCategoryHealth: {
+			Name:    "Sức Khỏe",
+			Meaning: "Các hoạt động chăm sóc thể chất và tinh thần",
+			Note:    "Tập thể dục, khám bệnh, thiền, hoặc chăm sóc sức khỏe",
+		},
+		labels_constant.LabelCategorySocial: {
+			Name:    "Xã Hội",
+			Meaning: "Các hoạt động chăm sóc thể chất và tinh thần",
+			Note:    "Tập thể dục, khám bệnh, thiền, hoặc chăm sóc sức khỏe",
+		},
+		labels_constant.LabelCategoryTravel: {
+			Name:    "Di Chuyển",
+			Meaning: "Việc đi lại từ nơi này đến nơi khác",
+			Note:    "Đi làm, công tác, du lịch, hoặc chạy việc vặt",
+		},
+
+		// Draft
+		labels_constant.LabelDraft: {
+			Name:    "Bản Nháp",
+			Meaning: "Công việc chưa được lưu trữ",
+			Note:    "Công việc được sinh ra từ AI hay khôi phục từ hôm trước, nhưng chưa được lưu",
+		},
+	},
+}
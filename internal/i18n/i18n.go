@@ -0,0 +1,132 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	user_constant "personal_schedule_service/internal/constant/user"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys the caller may set its locale with, the first one wins.
+const (
+	LocaleMetadataKey         = "x-locale"
+	AcceptLanguageMetadataKey = "accept-language"
+)
+
+// LabelText is the localized text of a system label.
+type LabelText struct {
+	Name    string
+	Meaning string
+	Note    string
+}
+
+type catalog struct {
+	messages map[string]string
+	errors   map[int32]string
+	labels   map[string]LabelText
+}
+
+var catalogs = map[string]catalog{
+	"vi": viCatalog,
+	"en": enCatalog,
+}
+
+type localeContextKey struct{}
+
+// WithLocale returns a context carrying the resolved locale of the request.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locale)
+}
+
+// LocaleFromContext returns the locale resolved for the request, else the one of its metadata, else the default one.
+func LocaleFromContext(ctx context.Context) string {
+	if locale, ok := ctx.Value(localeContextKey{}).(string); ok && locale != "" {
+		return locale
+	}
+	if locale, ok := LocaleFromMetadata(ctx); ok {
+		return locale
+	}
+	return user_constant.DEFAULT_LOCALE
+}
+
+// LocaleFromMetadata returns the first supported locale of the incoming metadata.
+func LocaleFromMetadata(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, key := range []string{LocaleMetadataKey, AcceptLanguageMetadataKey} {
+		for _, value := range md.Get(key) {
+			if locale, ok := NormalizeLocale(value); ok {
+				return locale, true
+			}
+		}
+	}
+	return "", false
+}
+
+// NormalizeLocale returns the first supported locale of a language tag or an Accept-Language list,
+// "en-US,en;q=0.9" gives "en". Entries are taken in the given order, the ones weighted 0 are skipped.
+func NormalizeLocale(value string) (string, bool) {
+	for entry := range strings.SplitSeq(value, ",") {
+		tag, params, _ := strings.Cut(entry, ";")
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok && strings.Trim(q, "0.") == "" {
+			continue
+		}
+		base, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+		base, _, _ = strings.Cut(base, "_")
+		base = strings.ToLower(base)
+		if slices.Contains(user_constant.SUPPORTED_LOCALES, base) {
+			return base, true
+		}
+	}
+	return "", false
+}
+
+// UserLocale returns the locale of a user preference when it is supported, else the default one.
+func UserLocale(preference string) string {
+	if locale, ok := NormalizeLocale(preference); ok {
+		return locale
+	}
+	return user_constant.DEFAULT_LOCALE
+}
+
+// T returns the message of the locale of the request.
+func T(ctx context.Context, id string, args ...any) string {
+	return Message(LocaleFromContext(ctx), id, args...)
+}
+
+// Message returns the message in the locale, else in the default locale, else its ID.
+// The message is formatted with the args when there are some.
+func Message(locale string, id string, args ...any) string {
+	format, ok := catalogs[locale].messages[id]
+	if !ok {
+		format, ok = catalogs[user_constant.DEFAULT_LOCALE].messages[id]
+	}
+	if !ok {
+		format = id
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// ErrorMessage returns the message of an app error code in the locale of the request, false when it has none.
+func ErrorMessage(ctx context.Context, code int32) (string, bool) {
+	locale := LocaleFromContext(ctx)
+	message, ok := catalogs[locale].errors[code]
+	if !ok {
+		message, ok = catalogs[user_constant.DEFAULT_LOCALE].errors[code]
+	}
+	return message, ok
+}
+
+// Label returns the text of the system label of the key in the locale, false when the catalog has none.
+func Label(locale string, key string) (LabelText, bool) {
+	text, ok := catalogs[locale].labels[key]
+	return text, ok
+}
@@ -0,0 +1,73 @@
+package i18n
+
+// Message IDs of the catalogs
+const (
+	// Generic errors
+	MsgDatabaseError    = "error.database"
+	MsgNotFound         = "error.not_found"
+	MsgRuntimeError     = "error.runtime"
+	MsgUnauthorized     = "error.unauthorized"
+	MsgPermissionDenied = "error.permission_denied"
+	MsgInternalError    = "error.internal"
+	MsgValidationError  = "error.validation"
+	MsgInvalidData      = "error.invalid_data"
+
+	// Labels
+	MsgLabelDeleted    = "label.deleted"
+	MsgLabelDeprecated = "label.deprecated"
+
+	// Goals
+	MsgGoalUpserted       = "goal.upserted"
+	MsgGoalCreateFailed   = "goal.create_failed"
+	MsgGoalUpdateFailed   = "goal.update_failed"
+	MsgGoalNotFound       = "goal.not_found"
+	MsgGoalForbidden      = "goal.forbidden"
+	MsgGoalHasLinkedWorks = "goal.has_linked_works"
+	MsgGoalLabelUpdated   = "goal.label_updated"
+
+	// Works
+	MsgWorkUpserted             = "work.upserted"
+	MsgWorkUpsertFailed         = "work.upsert_failed"
+	MsgWorkNotFound             = "work.not_found"
+	MsgWorkEndBeforeStart       = "work.end_before_start"
+	MsgWorkLabelUpdated         = "work.label_updated"
+	MsgNotificationEventFailed  = "work.notification_event_failed"
+	MsgOverlapCheckFailed       = "work.overlap_check_failed"
+	MsgRepeatedWorksCreated     = "work.repeated_created"
+	MsgRepeatedWorksUpdated     = "work.repeated_updated"
+	MsgWorkNotRepeated          = "work.not_repeated"
+	MsgRepeatedWorkMissingStart = "work.repeated_missing_start"
+	MsgRecoverTimesInvalid      = "work.recover_times_invalid"
+	MsgRecoverSourceFailed      = "work.recover_source_failed"
+	MsgRecoverCloneFailed       = "work.recover_clone_failed"
+	MsgRecoverInsertFailed      = "work.recover_insert_failed"
+	MsgWorksRecovered           = "work.recovered"
+	MsgDraftLabelFailed         = "work.draft_label_failed"
+	MsgDraftWorksFailed         = "work.draft_works_failed"
+	MsgNoDraftWorks             = "work.no_draft_works"
+	MsgDraftWorksOverlap        = "work.draft_works_overlap"
+	MsgDraftCommitFailed        = "work.draft_commit_failed"
+	MsgDraftsCommitted          = "work.drafts_committed"
+	MsgDraftDeleteFailed        = "work.draft_delete_failed"
+	MsgDraftsDeleted            = "work.drafts_deleted"
+	MsgWorkGenerationSubmitted  = "work.generation_submitted"
+	MsgWorksBulkUpdated         = "work.bulk_updated"
+	MsgWorksBulkDeleted         = "work.bulk_deleted"
+
+	// Calendar
+	MsgFeedTokenRevoked = "calendar.feed_token_revoked"
+
+	// Tags
+	MsgTagRenamed = "tag.renamed"
+	MsgTagsMerged = "tag.merged"
+
+	// Notifications
+	MsgWorkGenerationFailedTitle    = "notification.work_generation_failed.title"
+	MsgWorkGenerationFailedMessage  = "notification.work_generation_failed.message"
+	MsgWorkGenerationSuccessTitle   = "notification.work_generation_success.title"
+	MsgWorkGenerationSuccessMessage = "notification.work_generation_success.message"
+	MsgWorksOverdueTitle            = "notification.works_overdue.title"
+	MsgWorksOverdueMessage          = "notification.works_overdue.message"
+	MsgWorksGiveUpTitle             = "notification.works_give_up.title"
+	MsgWorksGiveUpMessage           = "notification.works_give_up.message"
+)
@@ -0,0 +1,84 @@
+package i18n
+
+import (
+	user_constant "personal_schedule_service/internal/constant/user"
+	"personal_schedule_service/proto/personal_schedule"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// LocalizeLabel returns the stored text of the system label of the key as shown in the locale.
+// A field is translated only while it is still the shipped text of the default locale, so the edits
+// of the operators show in every locale, and a label without shipped text keeps its stored one.
+func LocalizeLabel(locale string, key string, stored LabelText) LabelText {
+	if locale == user_constant.DEFAULT_LOCALE || key == "" {
+		return stored
+	}
+	shipped, ok := Label(user_constant.DEFAULT_LOCALE, key)
+	if !ok {
+		return stored
+	}
+	text, ok := Label(locale, key)
+	if !ok {
+		return stored
+	}
+
+	localized := stored
+	if stored.Name == shipped.Name {
+		localized.Name = text.Name
+	}
+	if stored.Meaning == shipped.Meaning {
+		localized.Meaning = text.Meaning
+	}
+	if stored.Note == shipped.Note {
+		localized.Note = text.Note
+	}
+	return localized
+}
+
+// LocalizeLabels replaces the text of the system labels found in a response by the one of the locale.
+func LocalizeLabels(m proto.Message, locale string) {
+	if m == nil || locale == user_constant.DEFAULT_LOCALE {
+		return
+	}
+	localizeMessage(m.ProtoReflect(), locale)
+}
+
+func localizeMessage(m protoreflect.Message, locale string) {
+	if !m.IsValid() {
+		return
+	}
+
+	switch label := m.Interface().(type) {
+	case *personal_schedule.Label:
+		text := LocalizeLabel(locale, label.Key, LabelText{Name: label.Name, Meaning: label.Meaning, Note: label.Note})
+		label.Name, label.Meaning, label.Note = text.Name, text.Meaning, text.Note
+		return
+	case *personal_schedule.LabelInfo:
+		label.Name = LocalizeLabel(locale, label.Key, LabelText{Name: label.Name}).Name
+		return
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+					localizeMessage(value.Message(), locale)
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					localizeMessage(list.Get(i).Message(), locale)
+				}
+			}
+		case fd.Message() != nil:
+			localizeMessage(v.Message(), locale)
+		}
+		return true
+	})
+}
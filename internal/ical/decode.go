@@ -0,0 +1,268 @@
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Decode parses the first component of an iCalendar stream, usually a VCALENDAR.
+// Folded lines are joined, parameter values are unquoted, property values are kept encoded.
+func Decode(r io.Reader) (*Component, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var root *Component
+	var stack []*Component
+	for i, line := range lines {
+		prop, err := parseLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		switch strings.ToUpper(prop.Name) {
+		case "BEGIN":
+			c := NewComponent(strings.ToUpper(prop.Value))
+			if len(stack) > 0 {
+				stack[len(stack)-1].AddComponent(c)
+			} else if root == nil {
+				root = c
+			} else {
+				return nil, fmt.Errorf("line %d: more than one top level component", i+1)
+			}
+			stack = append(stack, c)
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].Name != strings.ToUpper(prop.Value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", i+1, prop.Value)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("line %d: property %s outside of a component", i+1, prop.Name)
+			}
+			prop.Name = strings.ToUpper(prop.Name)
+			stack[len(stack)-1].Properties = append(stack[len(stack)-1].Properties, prop)
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("no component found")
+	}
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("missing END:%s", stack[len(stack)-1].Name)
+	}
+	return root, nil
+}
+
+// unfoldLines splits the stream in content lines, joining the continuation lines starting with a space or a tab.
+func unfoldLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// parseLine splits a content line in its name, parameters and value. Colons and semicolons inside quoted
+// parameter values do not end the parameter.
+func parseLine(line string) (Property, error) {
+	var prop Property
+	i := strings.IndexAny(line, ";:")
+	if i <= 0 {
+		return prop, fmt.Errorf("invalid content line %q", line)
+	}
+	prop.Name = line[:i]
+
+	for line[i] == ';' {
+		rest := line[i+1:]
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return prop, fmt.Errorf("invalid parameter in %q", line)
+		}
+		param := Param{Name: strings.ToUpper(rest[:eq])}
+		j := i + 1 + eq + 1
+		if j < len(line) && line[j] == '"' {
+			end := strings.IndexByte(line[j+1:], '"')
+			if end < 0 {
+				return prop, fmt.Errorf("unterminated quoted parameter in %q", line)
+			}
+			param.Value = line[j+1 : j+1+end]
+			j += end + 2
+		} else {
+			end := strings.IndexAny(line[j:], ";:")
+			if end < 0 {
+				return prop, fmt.Errorf("missing value in %q", line)
+			}
+			param.Value = line[j : j+end]
+			j += end
+		}
+		prop.Params = append(prop.Params, param)
+		if j >= len(line) {
+			return prop, fmt.Errorf("missing value in %q", line)
+		}
+		i = j
+	}
+	if line[i] != ':' {
+		return prop, fmt.Errorf("invalid content line %q", line)
+	}
+	prop.Value = line[i+1:]
+	return prop, nil
+}
+
+var textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+
+// UnescapeText decodes a TEXT value.
+func UnescapeText(value string) string {
+	return textUnescaper.Replace(value)
+}
+
+// Text returns the decoded TEXT value of the first property of the given name.
+func (c *Component) Text(name string) string {
+	p, ok := c.Property(name)
+	if !ok {
+		return ""
+	}
+	return UnescapeText(p.Value)
+}
+
+// Texts returns the decoded values of a comma separated TEXT list, eg. CATEGORIES, over every property of the name.
+func (c *Component) Texts(name string) []string {
+	var values []string
+	for _, p := range c.Properties {
+		if !strings.EqualFold(p.Name, name) {
+			continue
+		}
+		for _, value := range splitList(p.Value) {
+			if value = strings.TrimSpace(UnescapeText(value)); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// splitList splits a list value on the commas which are not escaped.
+func splitList(value string) []string {
+	var values []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case ',':
+			values = append(values, value[start:i])
+			start = i + 1
+		}
+	}
+	return append(values, value[start:])
+}
+
+// Times parses the DATE or DATE-TIME values of a property. Local times are read in their TZID, or in defaultLoc
+// when they are floating or the TZID is unknown. isDate reports DATE values, which are midnights of defaultLoc.
+func (p Property) Times(defaultLoc *time.Location) (times []time.Time, isDate bool, err error) {
+	loc := defaultLoc
+	if tzid := p.Param("TZID"); tzid != "" {
+		if l, err := time.LoadLocation(strings.TrimPrefix(tzid, "/")); err == nil {
+			loc = l
+		}
+	}
+	isDate = strings.EqualFold(p.Param("VALUE"), "DATE")
+	for _, value := range strings.Split(p.Value, ",") {
+		value = strings.TrimSpace(value)
+		var t time.Time
+		switch {
+		case isDate || len(value) == len(DateLayout):
+			isDate = true
+			t, err = time.ParseInLocation(DateLayout, value, defaultLoc)
+		case strings.HasSuffix(value, "Z"):
+			t, err = time.Parse(UTCDateTimeLayout, value)
+		default:
+			t, err = time.ParseInLocation(LocalDateTimeLayout, value, loc)
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid %s value %q", p.Name, value)
+		}
+		times = append(times, t)
+	}
+	return times, isDate, nil
+}
+
+// Time parses the single DATE or DATE-TIME value of a property, see Times.
+func (p Property) Time(defaultLoc *time.Location) (time.Time, bool, error) {
+	times, isDate, err := p.Times(defaultLoc)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if len(times) != 1 {
+		return time.Time{}, false, fmt.Errorf("%s must have a single value", p.Name)
+	}
+	return times[0], isDate, nil
+}
+
+// ParseDuration parses a DURATION value, eg. PT1H30M or P1D.
+func ParseDuration(value string) (time.Duration, error) {
+	s := strings.ToUpper(strings.TrimSpace(value))
+	sign := time.Duration(1)
+	if strings.HasPrefix(s, "-") {
+		sign = -1
+	}
+	s = strings.TrimLeft(s, "+-")
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, fmt.Errorf("invalid DURATION %q", value)
+	}
+	s = s[1:]
+
+	units := map[byte]time.Duration{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour}
+	timeUnits := map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}
+	var total time.Duration
+	inTime := false
+	number := ""
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			number += string(c)
+		case c == 'T' && !inTime && number == "":
+			inTime = true
+		default:
+			unit, ok := units[c]
+			if inTime {
+				unit, ok = timeUnits[c]
+			}
+			n, err := strconv.Atoi(number)
+			if !ok || err != nil {
+				return 0, fmt.Errorf("invalid DURATION %q", value)
+			}
+			total += time.Duration(n) * unit
+			number = ""
+		}
+	}
+	if number != "" {
+		return 0, fmt.Errorf("invalid DURATION %q", value)
+	}
+	return sign * total, nil
+}
+
+// Children returns the sub components of the given name.
+func (c *Component) Children(name string) []*Component {
+	var children []*Component
+	for _, child := range c.Components {
+		if child.Name == name {
+			children = append(children, child)
+		}
+	}
+	return children
+}
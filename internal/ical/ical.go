@@ -0,0 +1,172 @@
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Date and time layouts of RFC 5545 values.
+const (
+	DateLayout          = "20060102"
+	LocalDateTimeLayout = "20060102T150405"
+	UTCDateTimeLayout   = "20060102T150405Z"
+)
+
+// maxLineOctets is the length content lines are folded at.
+const maxLineOctets = 75
+
+// Param is a property parameter, eg. TZID=Asia/Ho_Chi_Minh.
+type Param struct {
+	Name  string
+	Value string
+}
+
+// Property is a content line, eg. DTSTART;TZID=Asia/Ho_Chi_Minh:20250101T090000.
+// Value is kept in its encoded form, TEXT values are escaped by AddText.
+type Property struct {
+	Name   string
+	Params []Param
+	Value  string
+}
+
+// Param returns the value of the named parameter, or "".
+func (p Property) Param(name string) string {
+	for _, param := range p.Params {
+		if strings.EqualFold(param.Name, name) {
+			return param.Value
+		}
+	}
+	return ""
+}
+
+// Component is a BEGIN/END block, eg. VCALENDAR, VEVENT or VTODO.
+type Component struct {
+	Name       string
+	Properties []Property
+	Components []*Component
+}
+
+func NewComponent(name string) *Component {
+	return &Component{Name: name}
+}
+
+// NewCalendar creates a VCALENDAR of the GREGORIAN scale published by prodID.
+func NewCalendar(prodID string) *Component {
+	cal := NewComponent("VCALENDAR")
+	cal.Add("VERSION", "2.0")
+	cal.Add("PRODID", prodID)
+	cal.Add("CALSCALE", "GREGORIAN")
+	cal.Add("METHOD", "PUBLISH")
+	return cal
+}
+
+// Add appends a property with an already encoded value.
+func (c *Component) Add(name, value string, params ...Param) {
+	c.Properties = append(c.Properties, Property{Name: name, Params: params, Value: value})
+}
+
+// AddText appends a TEXT property, escaping the value.
+func (c *Component) AddText(name, text string, params ...Param) {
+	c.Add(name, EscapeText(text), params...)
+}
+
+// AddTime appends a DATE-TIME property, in UTC when loc is nil or UTC, else as a local time of loc with its TZID.
+func (c *Component) AddTime(name string, t time.Time, loc *time.Location) {
+	if loc == nil || loc == time.UTC {
+		c.Add(name, t.UTC().Format(UTCDateTimeLayout))
+		return
+	}
+	c.Add(name, t.In(loc).Format(LocalDateTimeLayout), Param{Name: "TZID", Value: loc.String()})
+}
+
+func (c *Component) AddComponent(child *Component) {
+	c.Components = append(c.Components, child)
+}
+
+// Property returns the first property of the given name.
+func (c *Component) Property(name string) (Property, bool) {
+	for _, p := range c.Properties {
+		if strings.EqualFold(p.Name, name) {
+			return p, true
+		}
+	}
+	return Property{}, false
+}
+
+// Encode writes the component with CRLF line endings and the long lines folded.
+func (c *Component) Encode(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	c.encode(bw)
+	return bw.Flush()
+}
+
+func (c *Component) String() string {
+	var sb strings.Builder
+	_ = c.Encode(&sb)
+	return sb.String()
+}
+
+func (c *Component) encode(w *bufio.Writer) {
+	writeLine(w, "BEGIN:"+c.Name)
+	for _, p := range c.Properties {
+		var line strings.Builder
+		line.WriteString(p.Name)
+		for _, param := range p.Params {
+			line.WriteString(";" + param.Name + "=" + quoteParam(param.Value))
+		}
+		line.WriteString(":" + p.Value)
+		writeLine(w, line.String())
+	}
+	for _, child := range c.Components {
+		child.encode(w)
+	}
+	writeLine(w, "END:"+c.Name)
+}
+
+// writeLine folds the line every 75 octets without splitting a UTF-8 sequence.
+func writeLine(w *bufio.Writer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		// the leading space of a continuation line counts in its length
+		limit = maxLineOctets - 1
+	}
+	w.WriteString(line + "\r\n")
+}
+
+func quoteParam(value string) string {
+	if strings.ContainsAny(value, ":;,") {
+		return `"` + strings.ReplaceAll(value, `"`, "") + `"`
+	}
+	return value
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// EscapeText escapes a TEXT value.
+func EscapeText(text string) string {
+	return textEscaper.Replace(text)
+}
+
+// FormatOffset formats a UTC offset in seconds as a UTC-OFFSET value, eg. +0700.
+func FormatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	value := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds%3600/60)
+	if seconds%60 != 0 {
+		value += fmt.Sprintf("%02d", seconds%60)
+	}
+	return value
+}
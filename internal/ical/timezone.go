@@ -0,0 +1,84 @@
+package ical
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeZone builds the VTIMEZONE of loc as observed in year. A zone without daylight saving gets a single
+// STANDARD observance, the others one observance per transition, repeating on the same weekday of the month.
+func TimeZone(loc *time.Location, year int) *Component {
+	tz := NewComponent("VTIMEZONE")
+	tz.Add("TZID", loc.String())
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	transitions := zoneTransitions(start, start.AddDate(1, 0, 0))
+	if len(transitions) == 0 {
+		name, offset := start.Zone()
+		standard := NewComponent("STANDARD")
+		standard.Add("DTSTART", "19700101T000000")
+		standard.Add("TZOFFSETFROM", FormatOffset(offset))
+		standard.Add("TZOFFSETTO", FormatOffset(offset))
+		standard.AddText("TZNAME", name)
+		tz.AddComponent(standard)
+		return tz
+	}
+
+	for _, at := range transitions {
+		_, offsetFrom := at.Add(-time.Second).Zone()
+		name, offsetTo := at.Zone()
+		observance := NewComponent("STANDARD")
+		if at.IsDST() {
+			observance.Name = "DAYLIGHT"
+		}
+		// DTSTART is the wall clock time of the transition in the offset being left
+		wall := at.UTC().Add(time.Duration(offsetFrom) * time.Second)
+		observance.Add("DTSTART", wall.Format(LocalDateTimeLayout))
+		observance.Add("TZOFFSETFROM", FormatOffset(offsetFrom))
+		observance.Add("TZOFFSETTO", FormatOffset(offsetTo))
+		observance.Add("RRULE", yearlyRule(wall))
+		observance.AddText("TZNAME", name)
+		tz.AddComponent(observance)
+	}
+	return tz
+}
+
+// zoneTransitions returns the instants the UTC offset of the location changes in [from, to), to the minute.
+func zoneTransitions(from, to time.Time) []time.Time {
+	var transitions []time.Time
+	_, offset := from.Zone()
+	for day := from; day.Before(to); day = day.Add(24 * time.Hour) {
+		next := day.Add(24 * time.Hour)
+		if _, nextOffset := next.Zone(); nextOffset == offset {
+			continue
+		}
+		lo, hi := day, next
+		for hi.Sub(lo) > time.Minute {
+			mid := lo.Add(hi.Sub(lo) / 2)
+			if _, midOffset := mid.Zone(); midOffset == offset {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		at := hi.Truncate(time.Minute)
+		if _, atOffset := at.Zone(); atOffset == offset {
+			at = at.Add(time.Minute)
+		}
+		transitions = append(transitions, at)
+		_, offset = next.Zone()
+	}
+	return transitions
+}
+
+// yearlyRule returns the RRULE repeating a transition on the same weekday of the month, eg. the last sunday of march.
+func yearlyRule(wall time.Time) string {
+	n := (wall.Day()-1)/7 + 1
+	daysInMonth := time.Date(wall.Year(), wall.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if wall.Day()+7 > daysInMonth {
+		n = -1
+	}
+	return fmt.Sprintf("FREQ=YEARLY;BYMONTH=%d;BYDAY=%d%s", wall.Month(), n, weekdayCodes[wall.Weekday()])
+}
+
+var weekdayCodes = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
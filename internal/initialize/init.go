@@ -26,7 +26,22 @@ func startGrpcSerivces(ctx context.Context, wg *sync.WaitGroup) {
 	personalScheduleService.runServers(ctx, wg)
 }
 
-func gracefulShutdown(wg *sync.WaitGroup, logger log.Logger) {
+func startCalendarFeedServer(ctx context.Context, wg *sync.WaitGroup) {
+	if global.Config.Feed.Port == 0 {
+		global.Logger.Info("Calendar feed server is disabled, no feed port configured", "")
+		return
+	}
+	calendarFeedServer := NewCalendarFeedServer()
+	wg.Add(1)
+	go calendarFeedServer.run(ctx, wg)
+}
+
+// gracefulShutdown closes the resources once the outbox relay finished the event in progress,
+// so an event is never published without being marked as processed.
+func gracefulShutdown(wg *sync.WaitGroup, relayWg *sync.WaitGroup, logger log.Logger) {
+	relayWg.Wait()
+	logger.Info("Outbox relay drained", "")
+
 	wg.Add(1)
 	global.CronJobManager.Shutdown(wg)
 
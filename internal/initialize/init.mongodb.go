@@ -74,11 +74,19 @@ func seedData() error {
 	labelService := services.NewLabelService(
 		repos.NewLabelRepo(),
 		mapper.NewLabelMapper(),
+		repos.NewUserRepo(),
+		repos.NewWorkRepo(),
+		repos.NewOutboxRepo(),
 	)
 	err := labelService.SeedLabels(context.Background())
 	if err != nil {
 		return err
 	}
 
+	// names normalized before the search are normalized again, so that the folded queries match them
+	if _, err := repos.NewSearchRepo().BackfillNormalizedNames(context.Background()); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -6,6 +6,8 @@ import (
 	"net"
 	"personal_schedule_service/global"
 	"personal_schedule_service/internal/grpc/controller"
+	"personal_schedule_service/internal/grpc/interceptor"
+	"personal_schedule_service/internal/repos"
 	"personal_schedule_service/internal/wire"
 	"personal_schedule_service/pkg/settings"
 	"personal_schedule_service/proto/personal_schedule"
@@ -17,20 +19,36 @@ import (
 )
 
 type PersonalScheduleServer struct {
-	logger             log.Logger
-	config             *settings.Server
-	labelServiceServer *controller.LabelController
-	goalServiceServer  *controller.GoalController
-	workServiceServer  *controller.WorkController
+	logger               log.Logger
+	config               *settings.Server
+	labelServiceServer   *controller.LabelController
+	labelAdminServer     *controller.LabelAdminController
+	goalServiceServer    *controller.GoalController
+	workServiceServer    *controller.WorkController
+	analyticsServer      *controller.AnalyticsController
+	userPreferenceServer *controller.UserPreferenceController
+	calendarServer       *controller.CalendarController
+	searchServer         *controller.SearchController
+	tagServer            *controller.TagController
+	localeInterceptor    grpc.UnaryServerInterceptor
+	adminInterceptor     grpc.UnaryServerInterceptor
 }
 
 func NewPersonalScheduleService() *PersonalScheduleServer {
 	return &PersonalScheduleServer{
-		logger:             global.Logger,
-		config:             &global.Config.Server,
-		labelServiceServer: wire.InjectLabelController(),
-		goalServiceServer:  wire.InjectGoalController(),
-		workServiceServer:  wire.InjectWorkController(),
+		logger:               global.Logger,
+		config:               &global.Config.Server,
+		labelServiceServer:   wire.InjectLabelController(),
+		labelAdminServer:     wire.InjectLabelAdminController(),
+		goalServiceServer:    wire.InjectGoalController(),
+		workServiceServer:    wire.InjectWorkController(),
+		analyticsServer:      wire.InjectAnalyticsController(),
+		userPreferenceServer: wire.InjectUserPreferenceController(),
+		calendarServer:       wire.InjectCalendarController(),
+		searchServer:         wire.InjectSearchController(),
+		tagServer:            wire.InjectTagController(),
+		localeInterceptor:    interceptor.NewLocaleInterceptor(repos.NewUserRepo()),
+		adminInterceptor:     interceptor.NewAdminInterceptor(global.Config.Admin.Token),
 	}
 }
 
@@ -41,11 +59,22 @@ func (ps *PersonalScheduleServer) runServers(ctx context.Context, wg *sync.WaitG
 
 // create server factory
 func (ps *PersonalScheduleServer) createServer() *grpc.Server {
-	server := grpc.NewServer()
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(ps.adminInterceptor, ps.localeInterceptor))
 
 	personal_schedule.RegisterLabelServiceServer(server, ps.labelServiceServer)
+	// the operator tools are only served when they have a token to authenticate with
+	if global.Config.Admin.Token != "" {
+		personal_schedule.RegisterLabelAdminServiceServer(server, ps.labelAdminServer)
+	} else {
+		ps.logger.Info("Label admin service is disabled, no admin token configured", "")
+	}
 	personal_schedule.RegisterGoalServiceServer(server, ps.goalServiceServer)
 	personal_schedule.RegisterWorkServiceServer(server, ps.workServiceServer)
+	personal_schedule.RegisterAnalyticsServiceServer(server, ps.analyticsServer)
+	personal_schedule.RegisterUserPreferenceServiceServer(server, ps.userPreferenceServer)
+	personal_schedule.RegisterCalendarServiceServer(server, ps.calendarServer)
+	personal_schedule.RegisterSearchServiceServer(server, ps.searchServer)
+	personal_schedule.RegisterTagServiceServer(server, ps.tagServer)
 
 	return server
 }
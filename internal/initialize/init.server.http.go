@@ -0,0 +1,74 @@
+package initialize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"personal_schedule_service/global"
+	calendar_constant "personal_schedule_service/internal/constant/calendar"
+	"personal_schedule_service/internal/wire"
+	"sync"
+	"time"
+
+	"github.com/thanvuc/go-core-lib/log"
+	"go.uber.org/zap"
+)
+
+const (
+	feedReadHeaderTimeout = 10 * time.Second
+	feedWriteTimeout      = 30 * time.Second
+	feedShutdownTimeout   = 10 * time.Second
+)
+
+// CalendarFeedServer is the HTTP listener of the calendar subscription feeds.
+type CalendarFeedServer struct {
+	logger log.Logger
+	server *http.Server
+}
+
+func NewCalendarFeedServer() *CalendarFeedServer {
+	mux := http.NewServeMux()
+	mux.Handle(calendar_constant.FEED_PATH, wire.InjectCalendarFeedHandler())
+
+	return &CalendarFeedServer{
+		logger: global.Logger,
+		server: &http.Server{
+			Addr:              fmt.Sprintf("%s:%d", global.Config.Server.Host, global.Config.Feed.Port),
+			Handler:           mux,
+			ReadHeaderTimeout: feedReadHeaderTimeout,
+			WriteTimeout:      feedWriteTimeout,
+		},
+	}
+}
+
+func (fs *CalendarFeedServer) run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	stopped := make(chan struct{})
+	go fs.gracefullyShutdownServer(ctx, stopped)
+
+	fs.logger.Info(fmt.Sprintf("Calendar feed server listening on %s", fs.server.Addr), "")
+	if err := fs.server.ListenAndServe(); err != nil {
+		if errors.Is(err, http.ErrServerClosed) {
+			// the requests in flight are still finished by Shutdown
+			<-stopped
+			fs.logger.Info("Calendar feed server exited normally", "")
+		} else {
+			fs.logger.Error("Failed to serve calendar feed server", "", zap.Error(err))
+		}
+	}
+}
+
+func (fs *CalendarFeedServer) gracefullyShutdownServer(ctx context.Context, stopped chan<- struct{}) {
+	defer close(stopped)
+	<-ctx.Done()
+	fs.logger.Info("Calendar feed server is shutting down...", "")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), feedShutdownTimeout)
+	defer cancel()
+	if err := fs.server.Shutdown(shutdownCtx); err != nil {
+		fs.logger.Error("Failed to shut down calendar feed server", "", zap.Error(err))
+		return
+	}
+	fs.logger.Info("Calendar feed server stopped gracefully!", "")
+}
@@ -7,6 +7,7 @@ import (
 	"personal_schedule_service/global"
 	cronjob_run "personal_schedule_service/internal/cronjob"
 	"personal_schedule_service/internal/eventbus/consumer"
+	"personal_schedule_service/internal/wire"
 	"sync"
 	"syscall"
 
@@ -25,12 +26,20 @@ func Run() {
 	// Start gRPC services
 	startGrpcSerivces(ctx, wg)
 
+	// Start calendar subscription feed server
+	startCalendarFeedServer(ctx, wg)
+
 	// Start event consumers
 	consumer.RunConsumer(ctx)
 
 	// start cron jobs
 	go cronjob_run.RunCronJob(ctx)
 
+	// start outbox relay, it is waited for on its own before the connectors it uses are closed
+	relayWg := &sync.WaitGroup{}
+	relayWg.Add(1)
+	go wire.InjectOutboxRelay().Run(ctx, relayWg)
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 
@@ -39,5 +48,5 @@ func Run() {
 
 	cancel()
 
-	gracefulShutdown(wg, global.Logger)
+	gracefulShutdown(wg, relayWg, global.Logger)
 }
@@ -0,0 +1,199 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"personal_schedule_service/global"
+	"personal_schedule_service/internal/collection"
+	lifecycle_constant "personal_schedule_service/internal/constant/lifecycle"
+	notifications_constant "personal_schedule_service/internal/constant/notifications"
+	outbox_constant "personal_schedule_service/internal/constant/outbox"
+	"personal_schedule_service/internal/repos"
+	"sync"
+	"time"
+
+	"github.com/thanvuc/go-core-lib/eventbus"
+	"github.com/thanvuc/go-core-lib/log"
+	"go.uber.org/zap"
+)
+
+// route is where the events of one type are published.
+type route struct {
+	exchange     eventbus.ExchangeName
+	exchangeType eventbus.ExchangeType
+	routingKey   string
+}
+
+var routes = map[string]route{
+	outbox_constant.EventTypeScheduledNotification: {
+		exchange:     notifications_constant.NOTIFICATION_EXCHANGE,
+		exchangeType: eventbus.ExchangeTypeTopic,
+		routingKey:   notifications_constant.NOTIFICATION_ROUTING_KEY,
+	},
+}
+
+func init() {
+	// lifecycle events are routed by their type, eg. "work.created"
+	for _, eventType := range lifecycle_constant.EVENT_TYPES {
+		routes[eventType] = route{
+			exchange:     lifecycle_constant.LIFECYCLE_EXCHANGE,
+			exchangeType: eventbus.ExchangeTypeTopic,
+			routingKey:   eventType,
+		}
+	}
+}
+
+// errNoRoute is permanent, retrying such an event cannot succeed.
+var errNoRoute = errors.New("no route for event type")
+
+// Relay publishes the pending outbox events to RabbitMQ. Failed events are retried with an
+// exponential backoff and dead-lettered (FAILED status) after maxRetries attempts.
+type Relay struct {
+	logger            log.Logger
+	outboxRepo        repos.OutboxRepo
+	eventbusConnector *eventbus.RabbitMQConnector
+	publishers        map[eventbus.ExchangeName]eventbus.Publisher
+	maxRetries        int32
+	baseBackoff       time.Duration
+	maxBackoff        time.Duration
+}
+
+func NewRelay(outboxRepo repos.OutboxRepo) *Relay {
+	cfg := global.Config.Outbox
+	maxRetries := outbox_constant.DefaultMaxRetries
+	if cfg.MaxRetries > 0 {
+		maxRetries = cfg.MaxRetries
+	}
+	baseBackoff := outbox_constant.DefaultBaseBackoff
+	if cfg.BaseBackoffSeconds > 0 {
+		baseBackoff = time.Duration(cfg.BaseBackoffSeconds) * time.Second
+	}
+	maxBackoff := outbox_constant.DefaultMaxBackoff
+	if cfg.MaxBackoffSeconds > 0 {
+		maxBackoff = time.Duration(cfg.MaxBackoffSeconds) * time.Second
+	}
+
+	return &Relay{
+		logger:            global.Logger,
+		outboxRepo:        outboxRepo,
+		eventbusConnector: global.EventBusConnector,
+		publishers:        make(map[eventbus.ExchangeName]eventbus.Publisher),
+		maxRetries:        int32(maxRetries),
+		baseBackoff:       baseBackoff,
+		maxBackoff:        maxBackoff,
+	}
+}
+
+// Run polls the outbox until ctx is cancelled, the batch in progress is finished before it returns.
+func (r *Relay) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(outbox_constant.RelayPollInterval)
+	defer ticker.Stop()
+
+	r.logger.Info("Outbox relay started", "")
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Outbox relay stopped", "")
+			return
+		case <-ticker.C:
+			if err := r.RelayPending(ctx); err != nil {
+				r.logger.Error("Failed to relay outbox events", "", zap.Error(err))
+			}
+		}
+	}
+}
+
+// RelayPending publishes at most RelayBatchSize due events. It stops early when ctx is cancelled,
+// but the bookkeeping of an event already published is never interrupted.
+func (r *Relay) RelayPending(ctx context.Context) error {
+	workCtx := context.WithoutCancel(ctx)
+	for i := 0; i < outbox_constant.RelayBatchSize && ctx.Err() == nil; i++ {
+		event, err := r.outboxRepo.ClaimOutboxEvent(workCtx, time.Now().UTC(), outbox_constant.RelayClaimLease)
+		if err != nil {
+			return err
+		}
+		if event == nil {
+			return nil
+		}
+
+		if err := r.publish(workCtx, event); err != nil {
+			if err := r.handleFailure(workCtx, event, err); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := r.outboxRepo.MarkOutboxEventProcessed(workCtx, event.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Relay) handleFailure(ctx context.Context, event *collection.OutboxEvent, publishErr error) error {
+	attempts := event.RetryCount + 1
+	if errors.Is(publishErr, errNoRoute) || attempts >= r.maxRetries {
+		r.logger.Error("Outbox event dead-lettered", event.RequestID,
+			zap.String("event_id", event.ID.Hex()),
+			zap.String("event_type", event.EventType),
+			zap.Int32("attempts", attempts),
+			zap.Error(publishErr),
+		)
+		return r.outboxRepo.MarkOutboxEventDeadLettered(ctx, event.ID, publishErr.Error())
+	}
+
+	delay := r.backoff(event.RetryCount)
+	r.logger.Warn("Failed to publish outbox event, retrying", event.RequestID,
+		zap.String("event_id", event.ID.Hex()),
+		zap.Int32("attempts", attempts),
+		zap.Duration("retry_in", delay),
+		zap.Error(publishErr),
+	)
+	return r.outboxRepo.ScheduleOutboxRetry(ctx, event.ID, publishErr.Error(), time.Now().UTC().Add(delay))
+}
+
+// backoff doubles the delay on every retry, up to maxBackoff.
+func (r *Relay) backoff(retryCount int32) time.Duration {
+	delay := r.baseBackoff
+	for i := int32(0); i < retryCount && delay < r.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > r.maxBackoff {
+		delay = r.maxBackoff
+	}
+	return delay
+}
+
+func (r *Relay) publish(ctx context.Context, event *collection.OutboxEvent) error {
+	rt, ok := routes[event.EventType]
+	if !ok {
+		return fmt.Errorf("%w %q", errNoRoute, event.EventType)
+	}
+
+	publisher, err := r.publisherFor(rt)
+	if err != nil {
+		return err
+	}
+	headers := map[string]interface{}{
+		"event_type": event.EventType,
+	}
+	return publisher.Publish(ctx, event.RequestID, []string{rt.routingKey}, event.Payload, headers)
+}
+
+// publisherFor reuses one publisher per exchange. eventbus.NewPublisher panics when the broker
+// is unreachable, that is turned into an error so the event is retried instead.
+func (r *Relay) publisherFor(rt route) (publisher eventbus.Publisher, err error) {
+	if publisher, ok := r.publishers[rt.exchange]; ok {
+		return publisher, nil
+	}
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("failed to create publisher for %s: %v", rt.exchange, rec)
+		}
+	}()
+
+	publisher = eventbus.NewPublisher(r.eventbusConnector, rt.exchange, rt.exchangeType, nil, nil, false)
+	r.publishers[rt.exchange] = publisher
+	return publisher, nil
+}
@@ -0,0 +1,297 @@
+package recurrence
+
+import (
+	"sort"
+	"time"
+)
+
+// MaxOccurrences caps how many occurrences a single series can produce.
+const MaxOccurrences = 1000
+
+// maxPeriods is the number of FREQ periods walked before giving up on finding a new occurrence,
+// it guards the expansion loop against rules which never match (eg. BYMONTHDAY=31;BYMONTH=2).
+const maxPeriods = 10000
+
+// Recurrence is a rule anchored at DTStart. Occurrences keep the wall clock time of DTStart
+// in Location, so a 07:00 work stays at 07:00 across DST changes.
+type Recurrence struct {
+	Rule     *Rule
+	DTStart  time.Time
+	ExDates  []time.Time
+	Location *time.Location
+}
+
+// New creates a recurrence from a RRULE value.
+func New(rrule string, dtStart time.Time, exDates []time.Time, loc *time.Location) (*Recurrence, error) {
+	rule, err := Parse(rrule)
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &Recurrence{
+		Rule:     rule,
+		DTStart:  dtStart.In(loc),
+		ExDates:  exDates,
+		Location: loc,
+	}, nil
+}
+
+// All returns every occurrence of the series, at most limit of them (or MaxOccurrences when limit <= 0).
+func (r *Recurrence) All(limit int) []time.Time {
+	if limit <= 0 {
+		limit = MaxOccurrences
+	}
+	var result []time.Time
+	r.iterate(func(t time.Time) bool {
+		result = append(result, t)
+		return len(result) < limit
+	})
+	return result
+}
+
+// Between returns the occurrences starting in [from, to).
+func (r *Recurrence) Between(from, to time.Time) []time.Time {
+	var result []time.Time
+	r.iterate(func(t time.Time) bool {
+		if !t.Before(to) {
+			return false
+		}
+		if !t.Before(from) {
+			result = append(result, t)
+		}
+		return len(result) < MaxOccurrences
+	})
+	return result
+}
+
+// Last returns the final occurrence of a bounded series, ok is false when the series is empty or unbounded.
+func (r *Recurrence) Last() (time.Time, bool) {
+	if !r.Rule.IsBounded() {
+		return time.Time{}, false
+	}
+	var last time.Time
+	found := false
+	r.iterate(func(t time.Time) bool {
+		last = t
+		found = true
+		return true
+	})
+	return last, found
+}
+
+// iterate walks the occurrences in order until yield returns false or the rule ends.
+// COUNT is consumed by excluded dates as well, as RFC 5545 requires.
+func (r *Recurrence) iterate(yield func(time.Time) bool) {
+	excluded := make(map[int64]struct{}, len(r.ExDates))
+	for _, ex := range r.ExDates {
+		excluded[ex.Unix()] = struct{}{}
+	}
+
+	count := 0
+	emptyPeriods := 0
+	for period := 0; period < maxPeriods*r.Rule.Interval; period += r.Rule.Interval {
+		candidates := r.expandPeriod(period)
+		if len(candidates) == 0 {
+			emptyPeriods++
+			if emptyPeriods >= maxPeriods {
+				return
+			}
+			continue
+		}
+		emptyPeriods = 0
+
+		for _, t := range candidates {
+			if t.Before(r.DTStart) {
+				continue
+			}
+			if r.Rule.Until != nil && t.After(*r.Rule.Until) {
+				return
+			}
+			count++
+			if r.Rule.Count > 0 && count > r.Rule.Count {
+				return
+			}
+			if _, ok := excluded[t.Unix()]; ok {
+				continue
+			}
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// expandPeriod returns the sorted candidates of the n-th period after the one containing DTStart.
+func (r *Recurrence) expandPeriod(n int) []time.Time {
+	start := r.DTStart
+	var days []time.Time
+
+	switch r.Rule.Freq {
+	case Daily:
+		day := r.date(start.Year(), start.Month(), start.Day()+n)
+		if r.matchMonth(day) && r.matchMonthDay(day) && r.matchWeekday(day) {
+			days = append(days, day)
+		}
+	case Weekly:
+		offset := (int(start.Weekday()) - int(r.Rule.WeekStart) + 7) % 7
+		weekStart := r.date(start.Year(), start.Month(), start.Day()-offset+7*n)
+		for i := 0; i < 7; i++ {
+			day := weekStart.AddDate(0, 0, i)
+			if len(r.Rule.ByDay) == 0 && day.Weekday() != start.Weekday() {
+				continue
+			}
+			if r.matchWeekday(day) && r.matchMonth(day) {
+				days = append(days, day)
+			}
+		}
+	case Monthly:
+		month := r.date(start.Year(), start.Month()+time.Month(n), 1)
+		if r.matchMonth(month) {
+			days = r.daysOfMonth(month.Year(), month.Month())
+		}
+	case Yearly:
+		year := start.Year() + n
+		switch {
+		case len(r.Rule.ByMonth) > 0:
+			for _, m := range r.Rule.ByMonth {
+				days = append(days, r.daysOfMonth(year, m)...)
+			}
+		case len(r.Rule.ByMonthDay) > 0:
+			// without BYMONTH the month days repeat in every month of the year
+			for m := time.January; m <= time.December; m++ {
+				days = append(days, r.daysOfMonth(year, m)...)
+			}
+		case len(r.Rule.ByDay) > 0:
+			days = r.weekdaysInRange(r.date(year, time.January, 1), r.date(year+1, time.January, 1))
+		default:
+			days = r.daysOfMonth(year, start.Month())
+		}
+	}
+
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	days = r.applySetPos(days)
+
+	result := make([]time.Time, 0, len(days))
+	for _, day := range days {
+		result = append(result, time.Date(day.Year(), day.Month(), day.Day(),
+			start.Hour(), start.Minute(), start.Second(), 0, r.Location))
+	}
+	return result
+}
+
+// daysOfMonth returns the matching days of a month for MONTHLY and YEARLY rules.
+func (r *Recurrence) daysOfMonth(year int, month time.Month) []time.Time {
+	first := r.date(year, month, 1)
+	next := first.AddDate(0, 1, 0)
+	lastDay := next.AddDate(0, 0, -1).Day()
+
+	switch {
+	case len(r.Rule.ByMonthDay) > 0:
+		var days []time.Time
+		for _, md := range r.Rule.ByMonthDay {
+			d := md
+			if d < 0 {
+				d = lastDay + d + 1
+			}
+			if d < 1 || d > lastDay {
+				continue
+			}
+			day := r.date(year, month, d)
+			if r.matchWeekday(day) {
+				days = append(days, day)
+			}
+		}
+		return days
+	case len(r.Rule.ByDay) > 0:
+		return r.weekdaysInRange(first, next)
+	default:
+		if r.DTStart.Day() > lastDay {
+			return nil
+		}
+		return []time.Time{r.date(year, month, r.DTStart.Day())}
+	}
+}
+
+// weekdaysInRange resolves BYDAY, including ordinals, over the days in [from, to).
+func (r *Recurrence) weekdaysInRange(from, to time.Time) []time.Time {
+	var days []time.Time
+	for _, wd := range r.Rule.ByDay {
+		var matches []time.Time
+		for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+			if day.Weekday() == wd.Day {
+				matches = append(matches, day)
+			}
+		}
+		switch {
+		case wd.N == 0:
+			days = append(days, matches...)
+		case wd.N > 0 && wd.N <= len(matches):
+			days = append(days, matches[wd.N-1])
+		case wd.N < 0 && -wd.N <= len(matches):
+			days = append(days, matches[len(matches)+wd.N])
+		}
+	}
+	return days
+}
+
+func (r *Recurrence) applySetPos(days []time.Time) []time.Time {
+	if len(r.Rule.BySetPos) == 0 || len(days) == 0 {
+		return days
+	}
+	var result []time.Time
+	for _, pos := range r.Rule.BySetPos {
+		idx := pos - 1
+		if pos < 0 {
+			idx = len(days) + pos
+		}
+		if idx >= 0 && idx < len(days) {
+			result = append(result, days[idx])
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+	return result
+}
+
+func (r *Recurrence) matchWeekday(day time.Time) bool {
+	if len(r.Rule.ByDay) == 0 {
+		return true
+	}
+	for _, wd := range r.Rule.ByDay {
+		if wd.Day == day.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Recurrence) matchMonth(day time.Time) bool {
+	if len(r.Rule.ByMonth) == 0 {
+		return true
+	}
+	for _, m := range r.Rule.ByMonth {
+		if m == day.Month() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Recurrence) matchMonthDay(day time.Time) bool {
+	if len(r.Rule.ByMonthDay) == 0 {
+		return true
+	}
+	lastDay := r.date(day.Year(), day.Month()+1, 0).Day()
+	for _, md := range r.Rule.ByMonthDay {
+		if md == day.Day() || (md < 0 && lastDay+md+1 == day.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+// date builds a local midnight, normalizing overflowed months and days like time.Date does.
+func (r *Recurrence) date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, r.Location)
+}
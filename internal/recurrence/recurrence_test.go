@@ -0,0 +1,58 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+)
+
+func TestYearlyByMonthDayExpandsEveryMonth(t *testing.T) {
+	dtStart := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		rrule string
+		want  []time.Time
+	}{
+		{
+			name:  "month day",
+			rrule: "FREQ=YEARLY;BYMONTHDAY=1;COUNT=12",
+			want: func() []time.Time {
+				var days []time.Time
+				for m := time.March; m <= time.December; m++ {
+					days = append(days, time.Date(2025, m, 1, 9, 0, 0, 0, time.UTC))
+				}
+				return append(days,
+					time.Date(2026, time.January, 1, 9, 0, 0, 0, time.UTC),
+					time.Date(2026, time.February, 1, 9, 0, 0, 0, time.UTC))
+			}(),
+		},
+		{
+			// Friday the 13th
+			name:  "month day and weekday",
+			rrule: "FREQ=YEARLY;BYMONTHDAY=13;BYDAY=FR;COUNT=3",
+			want: []time.Time{
+				time.Date(2025, time.June, 13, 9, 0, 0, 0, time.UTC),
+				time.Date(2026, time.February, 13, 9, 0, 0, 0, time.UTC),
+				time.Date(2026, time.March, 13, 9, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := New(tt.rrule, dtStart, nil, time.UTC)
+			if err != nil {
+				t.Fatalf("New(%q): %v", tt.rrule, err)
+			}
+			got := rec.All(0)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d occurrences %v, want %d", len(got), got, len(tt.want))
+			}
+			for i := range tt.want {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("occurrence %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
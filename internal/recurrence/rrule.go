@@ -0,0 +1,274 @@
+package recurrence
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ part of an RFC 5545 RRULE.
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+// rruleDateTimeLayout is the UTC form of an RFC 5545 DATE-TIME value, eg. 20250131T170000Z.
+const rruleDateTimeLayout = "20060102T150405Z"
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+var weekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// WeekdayNum is a BYDAY entry. N is the optional ordinal (eg. 2 for "2MO", -1 for "-1FR"),
+// 0 means every such weekday of the period.
+type WeekdayNum struct {
+	Day time.Weekday
+	N   int
+}
+
+func (w WeekdayNum) String() string {
+	if w.N == 0 {
+		return weekdayNames[w.Day]
+	}
+	return strconv.Itoa(w.N) + weekdayNames[w.Day]
+}
+
+// Rule is the parsed form of an RFC 5545 RRULE.
+// Only the parts needed for personal scheduling are supported:
+// FREQ (DAILY/WEEKLY/MONTHLY/YEARLY), INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY, BYMONTH, BYSETPOS and WKST.
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      *time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []time.Month
+	BySetPos   []int
+	WeekStart  time.Weekday
+}
+
+// Parse parses a RRULE value, with or without the "RRULE:" prefix.
+func Parse(value string) (*Rule, error) {
+	value = strings.TrimSpace(value)
+	value = strings.TrimPrefix(strings.ToUpper(value), "RRULE:")
+	if value == "" {
+		return nil, fmt.Errorf("rrule is empty")
+	}
+
+	rule := &Rule{Interval: 1, WeekStart: time.Monday}
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return nil, fmt.Errorf("invalid rrule part %q", part)
+		}
+
+		switch key {
+		case "FREQ":
+			switch Frequency(val) {
+			case Daily, Weekly, Monthly, Yearly:
+				rule.Freq = Frequency(val)
+			default:
+				return nil, fmt.Errorf("unsupported FREQ %q", val)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL %q", val)
+			}
+			rule.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid COUNT %q", val)
+			}
+			rule.Count = n
+		case "UNTIL":
+			until, err := parseUntil(val)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, item := range strings.Split(val, ",") {
+				wd, err := parseWeekdayNum(item)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, wd)
+			}
+		case "BYMONTHDAY":
+			days, err := parseIntList(val, -31, 31)
+			if err != nil {
+				return nil, fmt.Errorf("invalid BYMONTHDAY %q", val)
+			}
+			rule.ByMonthDay = days
+		case "BYMONTH":
+			months, err := parseIntList(val, 1, 12)
+			if err != nil {
+				return nil, fmt.Errorf("invalid BYMONTH %q", val)
+			}
+			for _, m := range months {
+				rule.ByMonth = append(rule.ByMonth, time.Month(m))
+			}
+		case "BYSETPOS":
+			pos, err := parseIntList(val, -366, 366)
+			if err != nil {
+				return nil, fmt.Errorf("invalid BYSETPOS %q", val)
+			}
+			rule.BySetPos = pos
+		case "WKST":
+			wd, ok := weekdayCodes[val]
+			if !ok {
+				return nil, fmt.Errorf("invalid WKST %q", val)
+			}
+			rule.WeekStart = wd
+		default:
+			return nil, fmt.Errorf("unsupported rrule part %q", key)
+		}
+	}
+
+	if err := rule.validate(); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *Rule) validate() error {
+	if r.Freq == "" {
+		return fmt.Errorf("FREQ is required")
+	}
+	if r.Count > 0 && r.Until != nil {
+		return fmt.Errorf("COUNT and UNTIL cannot be used together")
+	}
+	for _, wd := range r.ByDay {
+		if wd.N != 0 && r.Freq != Monthly && r.Freq != Yearly {
+			return fmt.Errorf("BYDAY ordinals are only allowed with MONTHLY or YEARLY")
+		}
+	}
+	if len(r.ByMonthDay) > 0 && r.Freq == Weekly {
+		return fmt.Errorf("BYMONTHDAY cannot be used with WEEKLY")
+	}
+	if len(r.BySetPos) > 0 && len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 && len(r.ByMonth) == 0 {
+		return fmt.Errorf("BYSETPOS requires another BYxxx part")
+	}
+	return nil
+}
+
+// IsBounded reports whether the rule ends on its own, through COUNT or UNTIL.
+func (r *Rule) IsBounded() bool {
+	return r.Count > 0 || r.Until != nil
+}
+
+// String formats the rule back to its RRULE value, without the "RRULE:" prefix.
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(rruleDateTimeLayout))
+	}
+	if len(r.ByMonth) > 0 {
+		months := make([]int, len(r.ByMonth))
+		for i, m := range r.ByMonth {
+			months[i] = int(m)
+		}
+		parts = append(parts, "BYMONTH="+joinInts(months))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, wd := range r.ByDay {
+			days[i] = wd.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.BySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinInts(r.BySetPos))
+	}
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayNames[r.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+func parseUntil(val string) (time.Time, error) {
+	if t, err := time.Parse(rruleDateTimeLayout, val); err == nil {
+		return t, nil
+	}
+	// floating DATE-TIME and DATE values are read as UTC
+	if t, err := time.Parse("20060102T150405", val); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("20060102", val); err == nil {
+		// a DATE value includes the whole day
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL %q", val)
+}
+
+func parseWeekdayNum(val string) (WeekdayNum, error) {
+	val = strings.TrimSpace(val)
+	if len(val) < 2 {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", val)
+	}
+	code := val[len(val)-2:]
+	wd, ok := weekdayCodes[code]
+	if !ok {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", val)
+	}
+	n := 0
+	if prefix := val[:len(val)-2]; prefix != "" {
+		var err error
+		n, err = strconv.Atoi(strings.TrimPrefix(prefix, "+"))
+		if err != nil || n == 0 || n < -53 || n > 53 {
+			return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", val)
+		}
+	}
+	return WeekdayNum{Day: wd, N: n}, nil
+}
+
+func parseIntList(val string, min, max int) ([]int, error) {
+	items := strings.Split(val, ",")
+	result := make([]int, 0, len(items))
+	for _, item := range items {
+		n, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(item), "+"))
+		if err != nil || n == 0 || n < min || n > max {
+			return nil, fmt.Errorf("invalid value %q", item)
+		}
+		result = append(result, n)
+	}
+	sort.Ints(result)
+	return result, nil
+}
+
+func joinInts(values []int) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.Itoa(v)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,130 @@
+package repos
+
+import (
+	"context"
+	"personal_schedule_service/internal/collection"
+	"strings"
+	"time"
+
+	"github.com/thanvuc/go-core-lib/log"
+	"github.com/thanvuc/go-core-lib/mongolib"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+)
+
+type analyticsRepo struct {
+	logger         log.Logger
+	mongoConnector *mongolib.MongoConnector
+}
+
+// AnalyticsLabelGroup counts the works of a bucket having a label.
+type AnalyticsLabelGroup struct {
+	Bucket     time.Time     `bson:"bucket"`
+	LabelID    bson.ObjectID `bson:"label_id"`
+	Count      int32         `bson:"count"`
+	DurationMs int64         `bson:"duration_ms"`
+}
+
+// AnalyticsSubTaskGroup counts the subtasks of the works of a bucket.
+type AnalyticsSubTaskGroup struct {
+	Bucket    time.Time `bson:"bucket"`
+	Total     int32     `bson:"total"`
+	Completed int32     `bson:"completed"`
+}
+
+type WorkStats struct {
+	Status     []AnalyticsLabelGroup   `bson:"status"`
+	Category   []AnalyticsLabelGroup   `bson:"category"`
+	Priority   []AnalyticsLabelGroup   `bson:"priority"`
+	Difficulty []AnalyticsLabelGroup   `bson:"difficulty"`
+	SubTasks   []AnalyticsSubTaskGroup `bson:"subTasks"`
+}
+
+// GetWorkStats groups the non draft works of the user in [from, to) by bucket and label.
+// A work belongs to the bucket of its start date, or of its end date when it has none,
+// buckets are cut in timeZone with the given $dateTrunc unit.
+func (r *analyticsRepo) GetWorkStats(ctx context.Context, userID string, from, to time.Time, unit string, timeZone string, weekStart time.Weekday) (*WorkStats, error) {
+	coll := r.mongoConnector.GetCollection(collection.WorksCollection)
+
+	groupByLabel := func(field string) mongo.Pipeline {
+		return mongo.Pipeline{
+			{{Key: "$group", Value: bson.M{
+				"_id":         bson.M{"bucket": "$bucket", "label_id": "$" + field},
+				"count":       bson.M{"$sum": 1},
+				"duration_ms": bson.M{"$sum": "$duration_ms"},
+			}}},
+			{{Key: "$project", Value: bson.M{
+				"_id":         0,
+				"bucket":      "$_id.bucket",
+				"label_id":    "$_id.label_id",
+				"count":       1,
+				"duration_ms": 1,
+			}}},
+		}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"user_id":  userID,
+			"draft_id": nil,
+			"$or": bson.A{
+				bson.M{"start_date": bson.M{"$gte": from, "$lt": to}},
+				bson.M{"start_date": nil, "end_date": bson.M{"$gte": from, "$lt": to}},
+			},
+		}}},
+		{{Key: "$addFields", Value: bson.M{
+			"duration_ms": bson.M{"$cond": bson.A{
+				bson.M{"$ifNull": bson.A{"$start_date", false}},
+				bson.M{"$subtract": bson.A{"$end_date", "$start_date"}},
+				0,
+			}},
+			"bucket": bson.M{"$dateTrunc": bson.M{
+				"date":        bson.M{"$ifNull": bson.A{"$start_date", "$end_date"}},
+				"unit":        unit,
+				"timezone":    timeZone,
+				"startOfWeek": strings.ToLower(weekStart.String()),
+			}},
+		}}},
+		{{Key: "$facet", Value: bson.M{
+			"status":     groupByLabel("status_id"),
+			"category":   groupByLabel("category_id"),
+			"priority":   groupByLabel("priority_id"),
+			"difficulty": groupByLabel("difficulty_id"),
+			"subTasks": mongo.Pipeline{
+				{{Key: "$lookup", Value: bson.M{
+					"from":         collection.SubTasksCollection,
+					"localField":   "_id",
+					"foreignField": "work_id",
+					"as":           "subTasks",
+				}}},
+				{{Key: "$group", Value: bson.M{
+					"_id":   "$bucket",
+					"total": bson.M{"$sum": bson.M{"$size": "$subTasks"}},
+					"completed": bson.M{"$sum": bson.M{"$size": bson.M{"$filter": bson.M{
+						"input": "$subTasks",
+						"cond":  "$$this.is_completed",
+					}}}},
+				}}},
+				{{Key: "$project", Value: bson.M{"_id": 0, "bucket": "$_id", "total": 1, "completed": 1}}},
+			},
+		}}},
+	}
+
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("Failed to aggregate work stats", "", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []WorkStats
+	if err := cursor.All(ctx, &results); err != nil {
+		r.logger.Error("Failed to decode work stats", "", zap.Error(err))
+		return nil, err
+	}
+	if len(results) == 0 {
+		return &WorkStats{}, nil
+	}
+	return &results[0], nil
+}
@@ -15,17 +15,44 @@ import (
 type (
 	UserRepo interface {
 		UpsertSyncUser(ctx context.Context, payload models.UserOutboxPayload, requestId string) error
+		GetUserTimeZone(ctx context.Context, userID string) (string, error)
+		GetUserLocale(ctx context.Context, userID string) (string, error)
+		GetUserLocales(ctx context.Context, userIDs []string) (map[string]string, error)
+		GetUserIDsByTimeZone(ctx context.Context) (map[string][]string, error)
+		GetUserByID(ctx context.Context, userID string) (*collection.User, error)
+		UpdateUserPreference(ctx context.Context, user *collection.User) (*collection.User, error)
+		CreateFeedToken(ctx context.Context, userID string, token collection.UserFeedToken) (bool, error)
+		ReplaceFeedToken(ctx context.Context, userID string, token collection.UserFeedToken) (bool, error)
+		DeleteFeedToken(ctx context.Context, userID string) (bool, error)
+		GetUserByFeedTokenHash(ctx context.Context, tokenHash string) (*collection.User, error)
 	}
 
 	LabelRepo interface {
-		CountLabels(ctx context.Context) (int, error)
-		InsertLabels(ctx context.Context, labels *[]collection.Label) error
-		GetLabels(ctx context.Context) ([]collection.Label, error)
-		GetLabelsByTypeIDs(ctx context.Context, typeIDs int32) ([]collection.Label, error)
+		UpsertSystemLabels(ctx context.Context, labels []collection.Label) (int64, error)
+		GetLabels(ctx context.Context, userID string) ([]collection.Label, error)
+		GetLabelsVersion(ctx context.Context, userID string) (*CalendarVersion, error)
+		GetLabelsByTypeIDs(ctx context.Context, typeIDs int32, userID string) ([]collection.Label, error)
 		GetLabelByKey(ctx context.Context, key string) (*collection.Label, error)
-		CheckLabelExistence(ctx context.Context, id string) (bool, error)
+		CheckLabelExistence(ctx context.Context, userID string, id string) (bool, error)
 		CountGoalByLabelKey(ctx context.Context, key string) (int64, error)
 		GetLabelByID(ctx context.Context, labelID bson.ObjectID) (*collection.Label, error)
+		CreateLabel(ctx context.Context, label *collection.Label) (bson.ObjectID, error)
+		UpdateLabel(ctx context.Context, label *collection.Label) error
+		DeleteLabel(ctx context.Context, userID string, labelID bson.ObjectID) error
+		CheckLabelNameExistence(ctx context.Context, userID string, labelType int, name string, excludeLabelID *bson.ObjectID) (bool, error)
+		GetCustomLabels(ctx context.Context, userID string, labelType int) ([]collection.Label, error)
+		ReorderLabels(ctx context.Context, userID string, labelIDs []bson.ObjectID) error
+		CountLabelUsage(ctx context.Context, userID string, field string, labelID bson.ObjectID) (*LabelUsage, error)
+		ReassignLabel(ctx context.Context, userID string, field string, fromID, toID bson.ObjectID) error
+		GetWorksByLabel(ctx context.Context, userID string, field string, labelID bson.ObjectID, limit int64) ([]collection.Work, error)
+		GetGoalsByLabel(ctx context.Context, userID string, field string, labelID bson.ObjectID, limit int64) ([]collection.Goal, error)
+		ReassignWorksLabel(ctx context.Context, workIDs []bson.ObjectID, field string, fromID, toID bson.ObjectID) (int64, error)
+		ReassignGoalsLabel(ctx context.Context, goalIDs []bson.ObjectID, field string, fromID, toID bson.ObjectID) (int64, error)
+		GetSystemLabels(ctx context.Context, includeDeprecated bool) ([]collection.Label, error)
+		DeprecateSystemLabel(ctx context.Context, labelID bson.ObjectID, replacementID bson.ObjectID) error
+		DeleteSystemLabel(ctx context.Context, labelID bson.ObjectID) error
+		CountSystemLabelUsage(ctx context.Context, field string, labelID bson.ObjectID) (*LabelUsage, error)
+		ReassignSystemLabel(ctx context.Context, field string, fromID, toID bson.ObjectID) error
 	}
 
 	GoalRepo interface {
@@ -36,12 +63,42 @@ type (
 		BulkWriteTasks(ctx context.Context, operations []mongo.WriteModel) (*mongo.BulkWriteResult, error)
 		GetGoalByID(ctx context.Context, goalID bson.ObjectID) (*collection.Goal, error)
 		GetAggregatedGoalByID(ctx context.Context, goalID bson.ObjectID) (*AggregatedGoal, error)
-		DeleteTasksByGoalID(ctx context.Context, goalID bson.ObjectID) error
+		DeleteTasksByGoalID(ctx context.Context, goalID bson.ObjectID) (int64, error)
+		GetWorksByGoalID(ctx context.Context, goalID bson.ObjectID) ([]collection.Work, error)
+		CountSeriesByGoalID(ctx context.Context, goalID bson.ObjectID) (int64, error)
+		DetachWorksFromGoal(ctx context.Context, goalID bson.ObjectID) (int64, error)
+		DeleteWorksByGoalID(ctx context.Context, goalID bson.ObjectID, workIDs []bson.ObjectID) (int64, int64, error)
 		DeleteGoal(ctx context.Context, goalID bson.ObjectID) error
 		GetGoalsForDialog(ctx context.Context, userID string) ([]collection.Goal, error)
 		UpdateGoalField(ctx context.Context, goalID bson.ObjectID, fieldName string, labelID bson.ObjectID) error
 		GetLabelByKey(ctx context.Context, key string) (*collection.Label, error)
 		CheckNameExistence(ctx context.Context, userID string, nameNormalized string, excludeGoalID *bson.ObjectID) (bool, error)
+		InsertGoalEvents(ctx context.Context, events []interface{}) error
+	}
+
+	AnalyticsRepo interface {
+		GetWorkStats(ctx context.Context, userID string, from, to time.Time, unit string, timeZone string, weekStart time.Weekday) (*WorkStats, error)
+	}
+
+	SearchRepo interface {
+		SearchWorks(ctx context.Context, userID string, search string, limit int64) ([]SearchResult, error)
+		SearchGoals(ctx context.Context, userID string, search string, limit int64) ([]SearchResult, error)
+		SearchGoalTasks(ctx context.Context, userID string, search string, limit int64) ([]SearchResult, error)
+		SearchSubTasks(ctx context.Context, userID string, search string, limit int64) ([]SearchResult, error)
+		BackfillNormalizedNames(ctx context.Context) (int64, error)
+	}
+
+	TagRepo interface {
+		ListTags(ctx context.Context, userID string) ([]TagUsage, error)
+		ReplaceTags(ctx context.Context, userID string, sourceTags []string, targetTag string) (int64, int64, error)
+	}
+
+	OutboxRepo interface {
+		InsertOutboxEvents(ctx context.Context, events []interface{}) error
+		ClaimOutboxEvent(ctx context.Context, now time.Time, lease time.Duration) (*collection.OutboxEvent, error)
+		MarkOutboxEventProcessed(ctx context.Context, eventID bson.ObjectID) error
+		ScheduleOutboxRetry(ctx context.Context, eventID bson.ObjectID, errMsg string, nextAttemptAt time.Time) error
+		MarkOutboxEventDeadLettered(ctx context.Context, eventID bson.ObjectID, errMsg string) error
 	}
 
 	WorkRepo interface {
@@ -50,7 +107,7 @@ type (
 		UpdateWork(ctx context.Context, workID bson.ObjectID, work *collection.Work) error
 		GetSubTasksByWorkID(ctx context.Context, workID bson.ObjectID) ([]collection.SubTask, error)
 		BulkWriteSubTasks(ctx context.Context, operations []mongo.WriteModel) (*mongo.BulkWriteResult, error)
-		GetWorks(ctx context.Context, req *personal_schedule.GetWorksRequest) ([]AggregatedWork, int32, error)
+		GetWorks(ctx context.Context, req *personal_schedule.GetWorksRequest, page models.Pagination, after *models.WorkCursor) (*WorksPage, error)
 		GetAggregatedWorkByID(ctx context.Context, workID bson.ObjectID) (*AggregatedWork, error)
 		CountOverlappingWorks(ctx context.Context, userID string, startDate, endDate int64, excludeWorkID *bson.ObjectID) (int64, error)
 		DeleteSubTaskByWorkID(ctx context.Context, workID bson.ObjectID) error
@@ -69,9 +126,37 @@ type (
 		GetFutureRepeatedWorks(ctx context.Context, repeatedID bson.ObjectID, fromTargetDate time.Time) ([]collection.Work, error)
 		GetSeriesBoundaries(ctx context.Context, repeatedID bson.ObjectID) (*SeriesBoundaries, error)
 		DeleteSubTasksByWorkIDs(ctx context.Context, workIDs []bson.ObjectID) error
-		GetExistingTimes(ctx context.Context, userID string, localDate string) ([]*models.TimeRange, error)
-		DeleteDraftBefore(ctx context.Context, before time.Time) error
+		GetExistingTimes(ctx context.Context, userID string, localDate string, loc *time.Location) ([]*models.TimeRange, error)
+		DeleteDraftBefore(ctx context.Context, before time.Time, userIDs []string, excludeUserIDs bool) error
+		DeleteDraftRepeatedSeriesBefore(ctx context.Context, before time.Time, userIDs []string, excludeUserIDs bool) error
 		GetWorksInRange(ctx context.Context, userID string, startMs, endMs int64, excludeWorkID *bson.ObjectID) ([]collection.Work, error)
+		DeleteWorksByIDs(ctx context.Context, workIDs []bson.ObjectID) error
+		CreateRepeatedSeries(ctx context.Context, series *collection.RepeatedSeries) error
+		GetRepeatedSeriesByID(ctx context.Context, repeatedID bson.ObjectID) (*collection.RepeatedSeries, error)
+		UpdateRepeatedSeriesRule(ctx context.Context, repeatedID bson.ObjectID, rrule string, endsAt *time.Time) error
+		UpdateRepeatedSeriesTemplate(ctx context.Context, repeatedID bson.ObjectID, template collection.RepeatedSeriesTemplate) error
+		GetRepeatedSeriesBehind(ctx context.Context, userID string, before time.Time) ([]collection.RepeatedSeries, error)
+		AdvanceRepeatedSeriesMaterializedUntil(ctx context.Context, repeatedID bson.ObjectID, until time.Time) error
+		UpsertRepeatedWorks(ctx context.Context, works []collection.Work) ([]bson.ObjectID, error)
+		GetWorksToTransition(ctx context.Context, statusIDs []bson.ObjectID, endBefore time.Time, limit int64) ([]collection.Work, error)
+		TransitionWorkStatus(ctx context.Context, workID bson.ObjectID, fromStatusID, toStatusID bson.ObjectID) (bool, error)
+		InsertWorkEvents(ctx context.Context, events []interface{}) error
+		GetWorkEvents(ctx context.Context, workID bson.ObjectID) ([]AggregatedWorkEvent, error)
+		GetCompletionTimes(ctx context.Context, userID string, completedStatusID bson.ObjectID, from, to time.Time) (map[bson.ObjectID]time.Time, error)
+		AddRepeatedSeriesExDate(ctx context.Context, repeatedID bson.ObjectID, exDate time.Time) error
+		GetUnscheduledWorks(ctx context.Context, userID string, from, to time.Time, excludedStatusIDs []bson.ObjectID) ([]collection.Work, error)
+		GetWorksByIDs(ctx context.Context, userID string, workIDs []bson.ObjectID) ([]collection.Work, error)
+		GetDraftsScheduledFrom(ctx context.Context, userID string, sourceIDs []bson.ObjectID) ([]collection.Work, error)
+		UpdateWorkSchedule(ctx context.Context, workID bson.ObjectID, startDate, endDate time.Time) error
+		GetWorksForCalendar(ctx context.Context, userID string, from, to time.Time) ([]collection.Work, error)
+		GetSubTasksByWorkIDs(ctx context.Context, workIDs []bson.ObjectID) ([]collection.SubTask, error)
+		GetRepeatedSeriesByIDs(ctx context.Context, repeatedIDs []bson.ObjectID) ([]collection.RepeatedSeries, error)
+		GetRepeatedSeriesForCalendar(ctx context.Context, userID string, from, to time.Time) ([]collection.RepeatedSeries, error)
+		GetImportedUIDs(ctx context.Context, userID string, uids []string) (map[string]bool, error)
+		SaveDraftRepeatedSeries(ctx context.Context, userID string, draftID bson.ObjectID) error
+		DeleteDraftRepeatedSeries(ctx context.Context, userID string) error
+		GetCalendarVersion(ctx context.Context, userID string, from, to time.Time) (*CalendarVersion, error)
+		GetRepeatedSeriesVersion(ctx context.Context, userID string) (*CalendarVersion, error)
 	}
 )
 
@@ -102,3 +187,31 @@ func NewWorkRepo() WorkRepo {
 		mongoConnector: global.MongoDbConntector,
 	}
 }
+
+func NewOutboxRepo() OutboxRepo {
+	return &outboxRepo{
+		logger:         global.Logger,
+		mongoConnector: global.MongoDbConntector,
+	}
+}
+
+func NewAnalyticsRepo() AnalyticsRepo {
+	return &analyticsRepo{
+		logger:         global.Logger,
+		mongoConnector: global.MongoDbConntector,
+	}
+}
+
+func NewSearchRepo() SearchRepo {
+	return &searchRepo{
+		logger:         global.Logger,
+		mongoConnector: global.MongoDbConntector,
+	}
+}
+
+func NewTagRepo() TagRepo {
+	return &tagRepo{
+		logger:         global.Logger,
+		mongoConnector: global.MongoDbConntector,
+	}
+}
@@ -3,8 +3,10 @@ package repos
 import (
 	"context"
 	"personal_schedule_service/internal/collection"
+	labels_constant "personal_schedule_service/internal/constant/labels"
 	"personal_schedule_service/internal/grpc/utils"
 	"personal_schedule_service/proto/personal_schedule"
+	"regexp"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -34,9 +36,22 @@ type AggregatedGoal struct {
 	Difficulty          []collection.Label `bson:"difficultyInfo"`
 	Category            []collection.Label `bson:"categoryInfo"`
 	Overdue             []collection.Label `bson:"overdue,omitempty"`
+	Progress            GoalProgress       `bson:"progress"`
+	Tags                []string           `bson:"tags,omitempty"`
 	CreatedAt           time.Time          `bson:"created_at"`
 }
 
+type GoalProgress struct {
+	TotalTasks           int32   `bson:"total_tasks"`
+	CompletedTasks       int32   `bson:"completed_tasks"`
+	TotalWorks           int32   `bson:"total_works"`
+	CompletedWorks       int32   `bson:"completed_works"`
+	TotalWorkTimeMs      int64   `bson:"total_work_time_ms"`
+	CompletedWorkTimeMs  int64   `bson:"completed_work_time_ms"`
+	CompletionPercentage float64 `bson:"completion_percentage"`
+	TimeRemainingMs      *int64  `bson:"time_remaining_ms,omitempty"`
+}
+
 type totalCountResult struct {
 	Total int32 `bson:"total"`
 }
@@ -52,7 +67,7 @@ func (r *goalRepo) GetGoals(ctx context.Context, req *personal_schedule.GetGoals
 		matchStage = append(matchStage, bson.E{
 			Key: "name_normalized",
 			Value: bson.M{
-				"$regex":   searchNorm,
+				"$regex":   regexp.QuoteMeta(searchNorm),
 				"$options": "i",
 			},
 		})
@@ -66,6 +81,7 @@ func (r *goalRepo) GetGoals(ctx context.Context, req *personal_schedule.GetGoals
 			r.logger.Warn("Invalid filter_by_status_id format", "", zap.String("status_id", *req.StatusId))
 		}
 	}
+	matchStage = append(matchStage, tagsFilter(req.IncludeTags, req.ExcludeTags)...)
 
 	// Lookup stages
 	lookupStatus := bson.D{{
@@ -116,6 +132,11 @@ func (r *goalRepo) GetGoals(ctx context.Context, req *personal_schedule.GetGoals
 		{{Key: "$skip", Value: pagination.Offset}},
 		{{Key: "$limit", Value: pagination.Limit}},
 	}
+	progressStages, err := r.goalProgressStages(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	pipelineData = append(pipelineData, progressStages...)
 
 	// Pipeline for count
 	pipelineCount := mongo.Pipeline{
@@ -155,6 +176,88 @@ func (r *goalRepo) GetGoals(ctx context.Context, req *personal_schedule.GetGoals
 	return goals, totalGoals, nil
 }
 
+// goalProgressStages computes the progress field from the goal tasks and the non draft works linked to each goal,
+// they are meant to run after the pagination so only the returned goals are looked up.
+func (r *goalRepo) goalProgressStages(ctx context.Context) (mongo.Pipeline, error) {
+	completedLabel, err := r.GetLabelByKey(ctx, labels_constant.LabelCompleted)
+	if err != nil {
+		return nil, err
+	}
+
+	isCompleted := bson.M{"$eq": bson.A{"$status_id", completedLabel.ID}}
+	workDuration := bson.M{"$cond": bson.A{
+		bson.M{"$ifNull": bson.A{"$start_date", false}},
+		bson.M{"$subtract": bson.A{"$end_date", "$start_date"}},
+		0,
+	}}
+	statOf := func(field string, stats string) bson.M {
+		return bson.M{"$ifNull": bson.A{bson.M{"$arrayElemAt": bson.A{"$" + stats + "." + field, 0}}, 0}}
+	}
+
+	return mongo.Pipeline{
+		{{Key: "$lookup", Value: bson.M{
+			"from": collection.GoalTasksCollection,
+			"let":  bson.M{"goalId": "$_id"},
+			"pipeline": mongo.Pipeline{
+				{{Key: "$match", Value: bson.M{"$expr": bson.M{"$eq": bson.A{"$goal_id", "$$goalId"}}}}},
+				{{Key: "$group", Value: bson.M{
+					"_id":       nil,
+					"total":     bson.M{"$sum": 1},
+					"completed": bson.M{"$sum": bson.M{"$cond": bson.A{"$is_completed", 1, 0}}},
+				}}},
+			},
+			"as": "taskStats",
+		}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from": collection.WorksCollection,
+			"let":  bson.M{"goalId": "$_id"},
+			"pipeline": mongo.Pipeline{
+				{{Key: "$match", Value: bson.M{
+					"$expr":    bson.M{"$eq": bson.A{"$goal_id", "$$goalId"}},
+					"draft_id": nil,
+				}}},
+				{{Key: "$group", Value: bson.M{
+					"_id":             nil,
+					"total":           bson.M{"$sum": 1},
+					"completed":       bson.M{"$sum": bson.M{"$cond": bson.A{isCompleted, 1, 0}}},
+					"totalTimeMs":     bson.M{"$sum": workDuration},
+					"completedTimeMs": bson.M{"$sum": bson.M{"$cond": bson.A{isCompleted, workDuration, 0}}},
+				}}},
+			},
+			"as": "workStats",
+		}}},
+		{{Key: "$addFields", Value: bson.M{
+			"progress": bson.M{
+				"total_tasks":            statOf("total", "taskStats"),
+				"completed_tasks":        statOf("completed", "taskStats"),
+				"total_works":            statOf("total", "workStats"),
+				"completed_works":        statOf("completed", "workStats"),
+				"total_work_time_ms":     statOf("totalTimeMs", "workStats"),
+				"completed_work_time_ms": statOf("completedTimeMs", "workStats"),
+				"time_remaining_ms": bson.M{"$cond": bson.A{
+					bson.M{"$ifNull": bson.A{"$end_date", false}},
+					bson.M{"$max": bson.A{bson.M{"$subtract": bson.A{"$end_date", "$$NOW"}}, 0}},
+					"$$REMOVE",
+				}},
+			},
+		}}},
+		{{Key: "$addFields", Value: bson.M{
+			"progress.completion_percentage": bson.M{"$let": bson.M{
+				"vars": bson.M{
+					"done":  bson.M{"$add": bson.A{"$progress.completed_tasks", "$progress.completed_works"}},
+					"total": bson.M{"$add": bson.A{"$progress.total_tasks", "$progress.total_works"}},
+				},
+				"in": bson.M{"$cond": bson.A{
+					bson.M{"$gt": bson.A{"$$total", 0}},
+					bson.M{"$multiply": bson.A{bson.M{"$divide": bson.A{"$$done", "$$total"}}, 100}},
+					0,
+				}},
+			}},
+		}}},
+		{{Key: "$project", Value: bson.M{"taskStats": 0, "workStats": 0}}},
+	}, nil
+}
+
 func (r *goalRepo) GetGoalByID(ctx context.Context, goalID bson.ObjectID) (*collection.Goal, error) {
 	coll := r.mongoConnector.GetCollection(collection.GoalsCollection)
 	var goal collection.Goal
@@ -192,6 +295,7 @@ func (r *goalRepo) UpdateGoal(ctx context.Context, goalID bson.ObjectID, goalDB
 		"difficulty_id":        goalDB.DifficultyID,
 		"priority_id":          goalDB.PriorityID,
 		"category_id":          goalDB.CategoryID,
+		"tags":                 goalDB.Tags,
 		"last_modified_at":     now,
 	}
 	_, err := coll.UpdateOne(ctx, bson.M{"_id": goalID}, bson.M{"$set": updates})
@@ -269,6 +373,11 @@ func (r *goalRepo) GetAggregatedGoalByID(ctx context.Context, goalID bson.Object
 		lookupCategory,
 		{{Key: "$limit", Value: 1}},
 	}
+	progressStages, err := r.goalProgressStages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pipeline = append(pipeline, progressStages...)
 
 	cursor, err := goalCollection.Aggregate(ctx, pipeline)
 	if err != nil {
@@ -302,10 +411,83 @@ func (r *goalRepo) DeleteGoal(ctx context.Context, goalID bson.ObjectID) error {
 	return nil
 }
 
-func (r *goalRepo) DeleteTasksByGoalID(ctx context.Context, goalID bson.ObjectID) error {
+func (r *goalRepo) DeleteTasksByGoalID(ctx context.Context, goalID bson.ObjectID) (int64, error) {
 	coll := r.mongoConnector.GetCollection(collection.GoalTasksCollection)
-	_, err := coll.DeleteMany(ctx, bson.M{"goal_id": goalID})
-	return err
+	result, err := coll.DeleteMany(ctx, bson.M{"goal_id": goalID})
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
+func (r *goalRepo) GetWorksByGoalID(ctx context.Context, goalID bson.ObjectID) ([]collection.Work, error) {
+	coll := r.mongoConnector.GetCollection(collection.WorksCollection)
+	cursor, err := coll.Find(ctx, bson.M{"goal_id": goalID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var works []collection.Work
+	if err := cursor.All(ctx, &works); err != nil {
+		return nil, err
+	}
+	return works, nil
+}
+
+// CountSeriesByGoalID counts the repeated series whose template links their future occurrences to the goal.
+func (r *goalRepo) CountSeriesByGoalID(ctx context.Context, goalID bson.ObjectID) (int64, error) {
+	coll := r.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	return coll.CountDocuments(ctx, bson.M{"template.goal_id": goalID})
+}
+
+// DetachWorksFromGoal unlinks the works and the series templates from the goal, it returns the number of detached works.
+func (r *goalRepo) DetachWorksFromGoal(ctx context.Context, goalID bson.ObjectID) (int64, error) {
+	now := time.Now().UTC()
+	worksColl := r.mongoConnector.GetCollection(collection.WorksCollection)
+	result, err := worksColl.UpdateMany(ctx,
+		bson.M{"goal_id": goalID},
+		bson.M{"$set": bson.M{"goal_id": nil, "last_modified_at": now}},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// later occurrences must not be materialized with the deleted goal
+	seriesColl := r.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	if _, err := seriesColl.UpdateMany(ctx,
+		bson.M{"template.goal_id": goalID},
+		bson.M{"$set": bson.M{"template.goal_id": nil, "last_modified_at": now}},
+	); err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// DeleteWorksByGoalID deletes the works of the goal with their subtasks, and the series materializing them.
+// It returns the number of deleted works and subtasks.
+func (r *goalRepo) DeleteWorksByGoalID(ctx context.Context, goalID bson.ObjectID, workIDs []bson.ObjectID) (int64, int64, error) {
+	var deletedSubTasks int64
+	if len(workIDs) > 0 {
+		subTasksColl := r.mongoConnector.GetCollection(collection.SubTasksCollection)
+		result, err := subTasksColl.DeleteMany(ctx, bson.M{"work_id": bson.M{"$in": workIDs}})
+		if err != nil {
+			return 0, 0, err
+		}
+		deletedSubTasks = result.DeletedCount
+	}
+
+	worksColl := r.mongoConnector.GetCollection(collection.WorksCollection)
+	result, err := worksColl.DeleteMany(ctx, bson.M{"goal_id": goalID})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	seriesColl := r.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	if _, err := seriesColl.DeleteMany(ctx, bson.M{"template.goal_id": goalID}); err != nil {
+		return 0, 0, err
+	}
+	return result.DeletedCount, deletedSubTasks, nil
 }
 
 func (r *goalRepo) GetGoalsForDialog(ctx context.Context, userID string) ([]collection.Goal, error) {
@@ -370,3 +552,12 @@ func (r *goalRepo) CheckNameExistence(ctx context.Context, userID string, nameNo
 	}
 	return count > 0, nil
 }
+
+func (r *goalRepo) InsertGoalEvents(ctx context.Context, events []interface{}) error {
+	if len(events) == 0 {
+		return nil
+	}
+	coll := r.mongoConnector.GetCollection(collection.WorkEventsCollection)
+	_, err := coll.InsertMany(ctx, events)
+	return err
+}
@@ -3,10 +3,14 @@ package repos
 import (
 	"context"
 	"personal_schedule_service/internal/collection"
+	labels_constant "personal_schedule_service/internal/constant/labels"
+	"regexp"
+	"time"
 
 	"github.com/thanvuc/go-core-lib/log"
 	"github.com/thanvuc/go-core-lib/mongolib"
 	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
@@ -15,33 +19,56 @@ type labelRepo struct {
 	mongoConnector *mongolib.MongoConnector
 }
 
-func (lr *labelRepo) CountLabels(ctx context.Context) (int, error) {
-	count, err := lr.mongoConnector.GetCollection(collection.LabelsCollection).CountDocuments(ctx, bson.M{})
-	if err != nil {
-		return 0, err
-	}
+// LabelUsage is the number of documents of every user referencing a system label.
+type LabelUsage struct {
+	Works           int64
+	Goals           int64
+	SeriesTemplates int64
+	DefaultLabels   int64
+}
 
-	return int(count), nil
+func (u *LabelUsage) Total() int64 {
+	return u.Works + u.Goals + u.SeriesTemplates + u.DefaultLabels
 }
 
-func (lr *labelRepo) InsertLabels(ctx context.Context, labels *[]collection.Label) error {
-	if labels == nil || len(*labels) == 0 {
-		return nil
+// UpsertSystemLabels inserts the system labels whose key is missing, the existing ones are left as the operators changed them.
+func (lr *labelRepo) UpsertSystemLabels(ctx context.Context, labels []collection.Label) (int64, error) {
+	if len(labels) == 0 {
+		return 0, nil
 	}
 
-	collection := lr.mongoConnector.GetCollection(collection.LabelsCollection)
-	_, err := collection.InsertMany(ctx, *labels)
+	models := make([]mongo.WriteModel, 0, len(labels))
+	for _, label := range labels {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"key": label.Key}).
+			SetUpdate(bson.M{"$setOnInsert": label}).
+			SetUpsert(true))
+	}
+	result, err := lr.mongoConnector.GetCollection(collection.LabelsCollection).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
 	if err != nil {
-		return err
+		return 0, err
+	}
+	return result.UpsertedCount, nil
+}
+
+// visibleLabelsFilter matches the system labels which are not deprecated and the custom labels of the user.
+func visibleLabelsFilter(userID string) bson.M {
+	return bson.M{
+		"$or": bson.A{
+			bson.M{"user_id": nil},
+			bson.M{"user_id": userID},
+		},
+		"deprecated_at": nil,
 	}
-	return nil
 }
 
-func (lr *labelRepo) GetLabels(ctx context.Context) ([]collection.Label, error) {
+// GetLabels returns the system labels then the custom labels of the user, an empty user has only the system labels.
+func (lr *labelRepo) GetLabels(ctx context.Context, userID string) ([]collection.Label, error) {
 	var labels []collection.Label
 	collection := lr.mongoConnector.GetCollection(collection.LabelsCollection)
 
-	cursor, err := collection.Find(ctx, bson.M{})
+	options := options.Find().SetSort(bson.D{{Key: "user_id", Value: 1}, {Key: "order", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := collection.Find(ctx, visibleLabelsFilter(userID), options)
 	if err != nil {
 		return nil, err
 	}
@@ -52,12 +79,18 @@ func (lr *labelRepo) GetLabels(ctx context.Context) ([]collection.Label, error)
 	return labels, nil
 }
 
-func (lr *labelRepo) GetLabelsByTypeIDs(ctx context.Context, typeID int32) ([]collection.Label, error) {
+// GetLabelsVersion summarizes the labels GetLabels returns for the user, renames and deprecations move it.
+func (lr *labelRepo) GetLabelsVersion(ctx context.Context, userID string) (*CalendarVersion, error) {
+	coll := lr.mongoConnector.GetCollection(collection.LabelsCollection)
+	return aggregateVersion(ctx, coll, visibleLabelsFilter(userID))
+}
+
+func (lr *labelRepo) GetLabelsByTypeIDs(ctx context.Context, typeID int32, userID string) ([]collection.Label, error) {
 	var labels []collection.Label
 	collection := lr.mongoConnector.GetCollection(collection.LabelsCollection)
-	println("typeID:", typeID)
-	filter := bson.M{"label_type": typeID}
-	options := options.Find().SetSort(bson.D{{Key: "color", Value: 1}})
+	filter := visibleLabelsFilter(userID)
+	filter["label_type"] = typeID
+	options := options.Find().SetSort(bson.D{{Key: "user_id", Value: 1}, {Key: "order", Value: 1}, {Key: "color", Value: 1}})
 	cursor, err := collection.Find(ctx, filter, options)
 	if err != nil {
 		return nil, err
@@ -78,14 +111,17 @@ func (lr *labelRepo) GetLabelByKey(ctx context.Context, key string) (*collection
 	return &label, nil
 }
 
-func (lr *labelRepo) CheckLabelExistence(ctx context.Context, id string) (bool, error) {
+// CheckLabelExistence checks the label is a system label or a custom label of the user.
+func (lr *labelRepo) CheckLabelExistence(ctx context.Context, userID string, id string) (bool, error) {
 	oid, err := bson.ObjectIDFromHex(id)
 	if err != nil {
 		return false, nil
 	}
 
 	coll := lr.mongoConnector.GetCollection(collection.LabelsCollection)
-	count, err := coll.CountDocuments(ctx, bson.M{"_id": oid})
+	filter := visibleLabelsFilter(userID)
+	filter["_id"] = oid
+	count, err := coll.CountDocuments(ctx, filter)
 	if err != nil {
 		return false, err
 	}
@@ -110,3 +146,288 @@ func (lr *labelRepo) GetLabelByID(ctx context.Context, labelID bson.ObjectID) (*
 	}
 	return &label, nil
 }
+
+func (lr *labelRepo) CreateLabel(ctx context.Context, label *collection.Label) (bson.ObjectID, error) {
+	coll := lr.mongoConnector.GetCollection(collection.LabelsCollection)
+	result, err := coll.InsertOne(ctx, label)
+	if err != nil {
+		return bson.NilObjectID, err
+	}
+	return result.InsertedID.(bson.ObjectID), nil
+}
+
+// UpdateLabel updates the display fields of a custom label of the user, or of a system label when it has no user.
+func (lr *labelRepo) UpdateLabel(ctx context.Context, label *collection.Label) error {
+	coll := lr.mongoConnector.GetCollection(collection.LabelsCollection)
+	updates := bson.M{
+		"name":             label.Name,
+		"color":            label.Color,
+		"meaning":          label.Meaning,
+		"note":             label.Note,
+		"last_modified_at": time.Now(),
+	}
+	_, err := coll.UpdateOne(ctx, bson.M{"_id": label.ID, "user_id": label.UserID}, bson.M{"$set": updates})
+	return err
+}
+
+func (lr *labelRepo) DeleteLabel(ctx context.Context, userID string, labelID bson.ObjectID) error {
+	coll := lr.mongoConnector.GetCollection(collection.LabelsCollection)
+	_, err := coll.DeleteOne(ctx, bson.M{"_id": labelID, "user_id": userID})
+	return err
+}
+
+// CheckLabelNameExistence checks, ignoring the case, whether a label of the type visible to the user already has the name.
+func (lr *labelRepo) CheckLabelNameExistence(ctx context.Context, userID string, labelType int, name string, excludeLabelID *bson.ObjectID) (bool, error) {
+	coll := lr.mongoConnector.GetCollection(collection.LabelsCollection)
+	filter := visibleLabelsFilter(userID)
+	filter["label_type"] = labelType
+	filter["name"] = bson.M{"$regex": "^" + regexp.QuoteMeta(name) + "$", "$options": "i"}
+	if excludeLabelID != nil {
+		filter["_id"] = bson.M{"$ne": *excludeLabelID}
+	}
+	count, err := coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetCustomLabels returns the custom labels of the user for the type, in their order.
+func (lr *labelRepo) GetCustomLabels(ctx context.Context, userID string, labelType int) ([]collection.Label, error) {
+	coll := lr.mongoConnector.GetCollection(collection.LabelsCollection)
+	options := options.Find().SetSort(bson.D{{Key: "order", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := coll.Find(ctx, bson.M{"user_id": userID, "label_type": labelType}, options)
+	if err != nil {
+		return nil, err
+	}
+	var labels []collection.Label
+	if err = cursor.All(ctx, &labels); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// ReorderLabels sets the order of the custom labels of the user to their position in labelIDs.
+func (lr *labelRepo) ReorderLabels(ctx context.Context, userID string, labelIDs []bson.ObjectID) error {
+	if len(labelIDs) == 0 {
+		return nil
+	}
+	coll := lr.mongoConnector.GetCollection(collection.LabelsCollection)
+	now := time.Now()
+	models := make([]mongo.WriteModel, 0, len(labelIDs))
+	for i, labelID := range labelIDs {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": labelID, "user_id": userID}).
+			SetUpdate(bson.M{"$set": bson.M{"order": int32(i), "last_modified_at": now}}))
+	}
+	_, err := coll.BulkWrite(ctx, models)
+	return err
+}
+
+// CountLabelUsage counts the works, goals, series templates and default labels of the user referencing the label through field.
+func (lr *labelRepo) CountLabelUsage(ctx context.Context, userID string, field string, labelID bson.ObjectID) (*LabelUsage, error) {
+	var usage LabelUsage
+	for _, count := range []struct {
+		collName string
+		filter   bson.M
+		total    *int64
+	}{
+		{collection.WorksCollection, bson.M{"user_id": userID, field: labelID}, &usage.Works},
+		{collection.GoalsCollection, bson.M{"user_id": userID, field: labelID}, &usage.Goals},
+		{collection.RepeatedSeriesCollection, bson.M{"user_id": userID, "template." + field: labelID}, &usage.SeriesTemplates},
+		{collection.UsersCollection, bson.M{"_id": userID, "default_labels." + field: labelID}, &usage.DefaultLabels},
+	} {
+		total, err := lr.mongoConnector.GetCollection(count.collName).CountDocuments(ctx, count.filter)
+		if err != nil {
+			return nil, err
+		}
+		*count.total = total
+	}
+	return &usage, nil
+}
+
+// ReassignLabel moves the series templates and default labels of the user from a label to its replacement.
+// The works and goals are moved through ReassignWorksLabel and ReassignGoalsLabel, which come with their events.
+func (lr *labelRepo) ReassignLabel(ctx context.Context, userID string, field string, fromID, toID bson.ObjectID) error {
+	now := time.Now()
+
+	// future occurrences are materialized from the template
+	templateField := "template." + field
+	_, err := lr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection).UpdateMany(ctx,
+		bson.M{"user_id": userID, templateField: fromID},
+		bson.M{"$set": bson.M{templateField: toID, "last_modified_at": now}},
+	)
+	if err != nil {
+		return err
+	}
+
+	defaultField := "default_labels." + field
+	_, err = lr.mongoConnector.GetCollection(collection.UsersCollection).UpdateOne(ctx,
+		bson.M{"_id": userID, defaultField: fromID},
+		bson.M{"$set": bson.M{defaultField: toID, "last_modified_at": now}},
+	)
+	return err
+}
+
+// GetWorksByLabel returns up to limit works of the user referencing the label through field, of every user when userID is empty.
+func (lr *labelRepo) GetWorksByLabel(ctx context.Context, userID string, field string, labelID bson.ObjectID, limit int64) ([]collection.Work, error) {
+	var works []collection.Work
+	if err := lr.findByLabel(ctx, collection.WorksCollection, userID, field, labelID, limit, &works); err != nil {
+		return nil, err
+	}
+	return works, nil
+}
+
+// GetGoalsByLabel returns up to limit goals of the user referencing the label through field, of every user when userID is empty.
+func (lr *labelRepo) GetGoalsByLabel(ctx context.Context, userID string, field string, labelID bson.ObjectID, limit int64) ([]collection.Goal, error) {
+	var goals []collection.Goal
+	if err := lr.findByLabel(ctx, collection.GoalsCollection, userID, field, labelID, limit, &goals); err != nil {
+		return nil, err
+	}
+	return goals, nil
+}
+
+func (lr *labelRepo) findByLabel(ctx context.Context, collName string, userID string, field string, labelID bson.ObjectID, limit int64, results any) error {
+	filter := bson.M{field: labelID}
+	if userID != "" {
+		filter["user_id"] = userID
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(limit)
+	cursor, err := lr.mongoConnector.GetCollection(collName).Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	return cursor.All(ctx, results)
+}
+
+// ReassignWorksLabel moves the given works still referencing a label through field to its replacement.
+func (lr *labelRepo) ReassignWorksLabel(ctx context.Context, workIDs []bson.ObjectID, field string, fromID, toID bson.ObjectID) (int64, error) {
+	return lr.reassignByIDs(ctx, collection.WorksCollection, workIDs, field, fromID, toID)
+}
+
+// ReassignGoalsLabel moves the given goals still referencing a label through field to its replacement.
+func (lr *labelRepo) ReassignGoalsLabel(ctx context.Context, goalIDs []bson.ObjectID, field string, fromID, toID bson.ObjectID) (int64, error) {
+	return lr.reassignByIDs(ctx, collection.GoalsCollection, goalIDs, field, fromID, toID)
+}
+
+func (lr *labelRepo) reassignByIDs(ctx context.Context, collName string, ids []bson.ObjectID, field string, fromID, toID bson.ObjectID) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	result, err := lr.mongoConnector.GetCollection(collName).UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": ids}, field: fromID},
+		bson.M{"$set": bson.M{field: toID, "last_modified_at": time.Now()}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// GetSystemLabels returns the system labels by type then order, with the deprecated ones when includeDeprecated is set.
+func (lr *labelRepo) GetSystemLabels(ctx context.Context, includeDeprecated bool) ([]collection.Label, error) {
+	coll := lr.mongoConnector.GetCollection(collection.LabelsCollection)
+	filter := bson.M{"user_id": nil}
+	if !includeDeprecated {
+		filter["deprecated_at"] = nil
+	}
+	options := options.Find().SetSort(bson.D{{Key: "label_type", Value: 1}, {Key: "order", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := coll.Find(ctx, filter, options)
+	if err != nil {
+		return nil, err
+	}
+	var labels []collection.Label
+	if err = cursor.All(ctx, &labels); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// DeprecateSystemLabel hides a system label from the users and records its replacement.
+func (lr *labelRepo) DeprecateSystemLabel(ctx context.Context, labelID bson.ObjectID, replacementID bson.ObjectID) error {
+	coll := lr.mongoConnector.GetCollection(collection.LabelsCollection)
+	now := time.Now()
+	_, err := coll.UpdateOne(ctx,
+		bson.M{"_id": labelID, "user_id": nil},
+		bson.M{"$set": bson.M{"deprecated_at": now, "replaced_by_id": replacementID, "last_modified_at": now}},
+	)
+	return err
+}
+
+func (lr *labelRepo) DeleteSystemLabel(ctx context.Context, labelID bson.ObjectID) error {
+	coll := lr.mongoConnector.GetCollection(collection.LabelsCollection)
+	_, err := coll.DeleteOne(ctx, bson.M{"_id": labelID, "user_id": nil})
+	return err
+}
+
+// CountSystemLabelUsage counts the works, goals, series templates and default labels of every user referencing the label through field.
+func (lr *labelRepo) CountSystemLabelUsage(ctx context.Context, field string, labelID bson.ObjectID) (*LabelUsage, error) {
+	var usage LabelUsage
+	for _, count := range []struct {
+		collName string
+		field    string
+		total    *int64
+	}{
+		{collection.WorksCollection, field, &usage.Works},
+		{collection.GoalsCollection, field, &usage.Goals},
+		{collection.RepeatedSeriesCollection, "template." + field, &usage.SeriesTemplates},
+		{collection.UsersCollection, "default_labels." + field, &usage.DefaultLabels},
+	} {
+		total, err := lr.mongoConnector.GetCollection(count.collName).CountDocuments(ctx, bson.M{count.field: labelID})
+		if err != nil {
+			return nil, err
+		}
+		*count.total = total
+	}
+	return &usage, nil
+}
+
+// ReassignSystemLabel moves the series templates and default labels of every user from a label to its replacement,
+// LabelReassignBatchSize documents per update. It can be run again to resume after a failure.
+// The works and goals are moved through ReassignWorksLabel and ReassignGoalsLabel, which come with their events.
+func (lr *labelRepo) ReassignSystemLabel(ctx context.Context, field string, fromID, toID bson.ObjectID) error {
+	if _, err := lr.reassignInBatches(ctx, collection.RepeatedSeriesCollection, "template."+field, fromID, toID); err != nil {
+		return err
+	}
+	_, err := lr.reassignInBatches(ctx, collection.UsersCollection, "default_labels."+field, fromID, toID)
+	return err
+}
+
+func (lr *labelRepo) reassignInBatches(ctx context.Context, collName string, field string, fromID, toID bson.ObjectID) (int64, error) {
+	coll := lr.mongoConnector.GetCollection(collName)
+	findOptions := options.Find().SetProjection(bson.M{"_id": 1}).SetLimit(labels_constant.LabelReassignBatchSize)
+
+	var modified int64
+	for {
+		cursor, err := coll.Find(ctx, bson.M{field: fromID}, findOptions)
+		if err != nil {
+			return modified, err
+		}
+		var docs []struct {
+			ID any `bson:"_id"`
+		}
+		if err := cursor.All(ctx, &docs); err != nil {
+			return modified, err
+		}
+		if len(docs) == 0 {
+			return modified, nil
+		}
+
+		ids := make(bson.A, 0, len(docs))
+		for _, doc := range docs {
+			ids = append(ids, doc.ID)
+		}
+		result, err := coll.UpdateMany(ctx,
+			bson.M{"_id": bson.M{"$in": ids}, field: fromID},
+			bson.M{"$set": bson.M{field: toID, "last_modified_at": time.Now()}},
+		)
+		if err != nil {
+			return modified, err
+		}
+		modified += result.ModifiedCount
+
+		if len(docs) < labels_constant.LabelReassignBatchSize {
+			return modified, nil
+		}
+	}
+}
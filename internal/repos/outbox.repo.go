@@ -0,0 +1,90 @@
+package repos
+
+import (
+	"context"
+	"personal_schedule_service/internal/collection"
+	"personal_schedule_service/proto/common"
+	"time"
+
+	"github.com/thanvuc/go-core-lib/log"
+	"github.com/thanvuc/go-core-lib/mongolib"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type outboxRepo struct {
+	logger         log.Logger
+	mongoConnector *mongolib.MongoConnector
+}
+
+func (r *outboxRepo) InsertOutboxEvents(ctx context.Context, events []interface{}) error {
+	if len(events) == 0 {
+		return nil
+	}
+	coll := r.mongoConnector.GetCollection(collection.OutboxEventsCollection)
+	_, err := coll.InsertMany(ctx, events)
+	return err
+}
+
+// ClaimOutboxEvent picks the oldest due pending event and leases it until now+lease, so concurrent
+// relays do not publish it twice. It returns nil when no event is due.
+func (r *outboxRepo) ClaimOutboxEvent(ctx context.Context, now time.Time, lease time.Duration) (*collection.OutboxEvent, error) {
+	coll := r.mongoConnector.GetCollection(collection.OutboxEventsCollection)
+	filter := bson.M{
+		"status":          common.OutboxStatus_OUTBOX_STATUS_PENDING.String(),
+		"next_attempt_at": bson.M{"$lte": now},
+	}
+	update := bson.M{"$set": bson.M{"next_attempt_at": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "occurred_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var event collection.OutboxEvent
+	err := coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&event)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *outboxRepo) MarkOutboxEventProcessed(ctx context.Context, eventID bson.ObjectID) error {
+	coll := r.mongoConnector.GetCollection(collection.OutboxEventsCollection)
+	_, err := coll.UpdateOne(ctx, bson.M{"_id": eventID}, bson.M{
+		"$set": bson.M{
+			"status":       common.OutboxStatus_OUTBOX_STATUS_PROCESSED.String(),
+			"processed_at": time.Now().UTC(),
+		},
+		"$unset": bson.M{"error_message": ""},
+	})
+	return err
+}
+
+// ScheduleOutboxRetry records a failed publish attempt, the event stays pending until nextAttemptAt.
+func (r *outboxRepo) ScheduleOutboxRetry(ctx context.Context, eventID bson.ObjectID, errMsg string, nextAttemptAt time.Time) error {
+	coll := r.mongoConnector.GetCollection(collection.OutboxEventsCollection)
+	_, err := coll.UpdateOne(ctx, bson.M{"_id": eventID}, bson.M{
+		"$set": bson.M{
+			"error_message":   errMsg,
+			"next_attempt_at": nextAttemptAt,
+		},
+		"$inc": bson.M{"retry_count": 1},
+	})
+	return err
+}
+
+// MarkOutboxEventDeadLettered gives up on an event, it is kept with the FAILED status for inspection.
+func (r *outboxRepo) MarkOutboxEventDeadLettered(ctx context.Context, eventID bson.ObjectID, errMsg string) error {
+	coll := r.mongoConnector.GetCollection(collection.OutboxEventsCollection)
+	_, err := coll.UpdateOne(ctx, bson.M{"_id": eventID}, bson.M{
+		"$set": bson.M{
+			"status":        common.OutboxStatus_OUTBOX_STATUS_FAILED.String(),
+			"error_message": errMsg,
+		},
+		"$inc": bson.M{"retry_count": 1},
+	})
+	return err
+}
@@ -0,0 +1,224 @@
+package repos
+
+import (
+	"context"
+	"personal_schedule_service/internal/collection"
+	search_constant "personal_schedule_service/internal/constant/search"
+	"personal_schedule_service/internal/grpc/utils"
+	"time"
+
+	"github.com/thanvuc/go-core-lib/log"
+	"github.com/thanvuc/go-core-lib/mongolib"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/zap"
+)
+
+type searchRepo struct {
+	logger         log.Logger
+	mongoConnector *mongolib.MongoConnector
+}
+
+// SearchResult is a document matched by a $text search, with the parent owning it for the goal tasks and subtasks.
+type SearchResult struct {
+	ID                bson.ObjectID  `bson:"_id"`
+	Name              string         `bson:"name"`
+	ShortDescriptions *string        `bson:"short_descriptions,omitempty"`
+	StartDate         *time.Time     `bson:"start_date,omitempty"`
+	EndDate           *time.Time     `bson:"end_date,omitempty"`
+	ParentID          *bson.ObjectID `bson:"parent_id,omitempty"`
+	ParentName        *string        `bson:"parent_name,omitempty"`
+	Score             float64        `bson:"score"`
+}
+
+// searchParent is the collection owning the documents of a collection without user_id.
+type searchParent struct {
+	from       string
+	localField string
+	filter     bson.D
+}
+
+// SearchWorks searches the non draft works of the user with idx_work_text.
+func (r *searchRepo) SearchWorks(ctx context.Context, userID string, search string, limit int64) ([]SearchResult, error) {
+	return r.search(ctx, collection.WorksCollection, userID, search, bson.D{{Key: "draft_id", Value: nil}}, nil, limit)
+}
+
+// SearchGoals searches the goals of the user with idx_goal_text.
+func (r *searchRepo) SearchGoals(ctx context.Context, userID string, search string, limit int64) ([]SearchResult, error) {
+	return r.search(ctx, collection.GoalsCollection, userID, search, nil, nil, limit)
+}
+
+// SearchGoalTasks searches the tasks of the goals of the user with idx_goal_task_text.
+func (r *searchRepo) SearchGoalTasks(ctx context.Context, userID string, search string, limit int64) ([]SearchResult, error) {
+	return r.search(ctx, collection.GoalTasksCollection, userID, search, nil, &searchParent{
+		from:       collection.GoalsCollection,
+		localField: "goal_id",
+	}, limit)
+}
+
+// SearchSubTasks searches the subtasks of the non draft works of the user with idx_subtask_text.
+func (r *searchRepo) SearchSubTasks(ctx context.Context, userID string, search string, limit int64) ([]SearchResult, error) {
+	return r.search(ctx, collection.SubTasksCollection, userID, search, nil, &searchParent{
+		from:       collection.WorksCollection,
+		localField: "work_id",
+		filter:     bson.D{{Key: "draft_id", Value: nil}},
+	}, limit)
+}
+
+// search runs the $text search on the collection, best score first.
+// The documents of a collection with a parent have no user_id, so the parents of the user are read first
+// and the $text stage is restricted to their documents.
+func (r *searchRepo) search(ctx context.Context, collName string, userID string, search string, filter bson.D, parent *searchParent, limit int64) ([]SearchResult, error) {
+	coll := r.mongoConnector.GetCollection(collName)
+
+	match := bson.D{{Key: "$text", Value: bson.M{"$search": search}}}
+	if parent == nil {
+		match = append(match, bson.E{Key: "user_id", Value: userID})
+		match = append(match, filter...)
+	} else {
+		parentIDs, err := r.parentIDs(ctx, userID, parent)
+		if err != nil {
+			return nil, err
+		}
+		if len(parentIDs) == 0 {
+			return nil, nil
+		}
+		match = append(match, bson.E{Key: parent.localField, Value: bson.M{"$in": parentIDs}})
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$addFields", Value: bson.M{"score": bson.M{"$meta": "textScore"}}}},
+	}
+
+	if parent != nil {
+		pipeline = append(pipeline,
+			bson.D{{Key: "$lookup", Value: bson.M{
+				"from":         parent.from,
+				"localField":   parent.localField,
+				"foreignField": "_id",
+				"as":           "parent",
+			}}},
+			bson.D{{Key: "$unwind", Value: "$parent"}},
+			bson.D{{Key: "$addFields", Value: bson.M{
+				"parent_id":   "$parent._id",
+				"parent_name": "$parent.name",
+			}}},
+		)
+	}
+
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{
+			{Key: "score", Value: -1},
+			{Key: "last_modified_at", Value: -1},
+			{Key: "_id", Value: 1},
+		}}},
+		bson.D{{Key: "$limit", Value: limit}},
+		bson.D{{Key: "$project", Value: bson.M{
+			"name":               1,
+			"short_descriptions": 1,
+			"start_date":         1,
+			"end_date":           1,
+			"parent_id":          1,
+			"parent_name":        1,
+			"score":              1,
+		}}},
+	)
+
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []SearchResult
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// parentIDs returns the IDs of the parents of the user matching the filter of parent.
+func (r *searchRepo) parentIDs(ctx context.Context, userID string, parent *searchParent) ([]bson.ObjectID, error) {
+	filter := bson.D{{Key: "user_id", Value: userID}}
+	filter = append(filter, parent.filter...)
+
+	var ids []bson.ObjectID
+	err := r.mongoConnector.GetCollection(parent.from).Distinct(ctx, "_id", filter).Decode(&ids)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// BackfillNormalizedNames sets name_normalized on the documents written before it was folded or stored,
+// the works and goals normalized with an đ and the goal tasks and subtasks without one.
+func (r *searchRepo) BackfillNormalizedNames(ctx context.Context) (int64, error) {
+	stale := bson.M{"name_normalized": bson.M{"$regex": "đ"}}
+	missing := bson.M{"name_normalized": bson.M{"$exists": false}}
+
+	var total int64
+	for collName, filter := range map[string]bson.M{
+		collection.WorksCollection:     stale,
+		collection.GoalsCollection:     stale,
+		collection.GoalTasksCollection: missing,
+		collection.SubTasksCollection:  missing,
+	} {
+		count, err := r.backfillNormalizedNames(ctx, collName, filter)
+		if err != nil {
+			return total, err
+		}
+		if count > 0 {
+			r.logger.Info("BackfillNormalizedNames", "", zap.String("collection", collName), zap.Int64("count", count))
+		}
+		total += count
+	}
+	return total, nil
+}
+
+func (r *searchRepo) backfillNormalizedNames(ctx context.Context, collName string, filter bson.M) (int64, error) {
+	coll := r.mongoConnector.GetCollection(collName)
+
+	cursor, err := coll.Find(ctx, filter, options.Find().SetProjection(bson.M{"name": 1}))
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var modified int64
+	flush := func(models []mongo.WriteModel) error {
+		if len(models) == 0 {
+			return nil
+		}
+		result, err := coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			return err
+		}
+		modified += result.ModifiedCount
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, search_constant.BACKFILL_BATCH_SIZE)
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID   bson.ObjectID `bson:"_id"`
+			Name string        `bson:"name"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return modified, err
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": doc.ID}).
+			SetUpdate(bson.M{"$set": bson.M{"name_normalized": utils.RemoveAccent(doc.Name)}}))
+		if len(models) == search_constant.BACKFILL_BATCH_SIZE {
+			if err := flush(models); err != nil {
+				return modified, err
+			}
+			models = models[:0]
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return modified, err
+	}
+	return modified, flush(models)
+}
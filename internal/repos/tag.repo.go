@@ -0,0 +1,129 @@
+package repos
+
+import (
+	"context"
+	"personal_schedule_service/internal/collection"
+	"personal_schedule_service/internal/grpc/utils"
+	"time"
+
+	"github.com/thanvuc/go-core-lib/log"
+	"github.com/thanvuc/go-core-lib/mongolib"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+type tagRepo struct {
+	logger         log.Logger
+	mongoConnector *mongolib.MongoConnector
+}
+
+// TagUsage is a tag of the user with the number of works and goals carrying it.
+type TagUsage struct {
+	Name      string `bson:"_id"`
+	WorkCount int64  `bson:"work_count"`
+	GoalCount int64  `bson:"goal_count"`
+}
+
+// ListTags returns the tags of the non draft works and the goals of the user, most used first.
+func (r *tagRepo) ListTags(ctx context.Context, userID string) ([]TagUsage, error) {
+	coll := r.mongoConnector.GetCollection(collection.WorksCollection)
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user_id": userID, "draft_id": nil, "tags.0": bson.M{"$exists": true}}}},
+		{{Key: "$project", Value: bson.M{"tags": 1, "is_work": bson.M{"$literal": 1}}}},
+		{{Key: "$unionWith", Value: bson.M{
+			"coll": collection.GoalsCollection,
+			"pipeline": mongo.Pipeline{
+				{{Key: "$match", Value: bson.M{"user_id": userID, "tags.0": bson.M{"$exists": true}}}},
+				{{Key: "$project", Value: bson.M{"tags": 1, "is_work": bson.M{"$literal": 0}}}},
+			},
+		}}},
+		{{Key: "$unwind", Value: "$tags"}},
+		{{Key: "$group", Value: bson.M{
+			"_id":        "$tags",
+			"work_count": bson.M{"$sum": "$is_work"},
+			"goal_count": bson.M{"$sum": bson.M{"$subtract": bson.A{1, "$is_work"}}},
+		}}},
+		{{Key: "$addFields", Value: bson.M{"total": bson.M{"$add": bson.A{"$work_count", "$goal_count"}}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "total", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tags []TagUsage
+	if err := cursor.All(ctx, &tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// ReplaceTags replaces the source tags by the target tag on the works, goals and series templates of the user,
+// keeping the position of the first one replaced. The target is kept once when a document already has it.
+func (r *tagRepo) ReplaceTags(ctx context.Context, userID string, sourceTags []string, targetTag string) (int64, int64, error) {
+	works, err := r.replaceTags(ctx, collection.WorksCollection, "tags", userID, sourceTags, targetTag)
+	if err != nil {
+		return 0, 0, err
+	}
+	goals, err := r.replaceTags(ctx, collection.GoalsCollection, "tags", userID, sourceTags, targetTag)
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err := r.replaceTags(ctx, collection.RepeatedSeriesCollection, "template.tags", userID, sourceTags, targetTag); err != nil {
+		return 0, 0, err
+	}
+	return works, goals, nil
+}
+
+func (r *tagRepo) replaceTags(ctx context.Context, collName string, field string, userID string, sourceTags []string, targetTag string) (int64, error) {
+	coll := r.mongoConnector.GetCollection(collName)
+
+	// the tags are user input, a tag starting with "$" would otherwise be read as a field path or a variable
+	renamed := bson.M{"$map": bson.M{
+		"input": "$" + field,
+		"in": bson.M{"$cond": bson.A{
+			bson.M{"$in": bson.A{"$$this", bson.M{"$literal": sourceTags}}},
+			bson.M{"$literal": targetTag},
+			"$$this",
+		}},
+	}}
+	deduplicated := bson.M{"$reduce": bson.M{
+		"input":        renamed,
+		"initialValue": bson.A{},
+		"in": bson.M{"$cond": bson.A{
+			bson.M{"$in": bson.A{"$$this", "$$value"}},
+			"$$value",
+			bson.M{"$concatArrays": bson.A{"$$value", bson.A{"$$this"}}},
+		}},
+	}}
+
+	result, err := coll.UpdateMany(ctx,
+		bson.M{"user_id": userID, field: bson.M{"$in": sourceTags}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			field:              deduplicated,
+			"last_modified_at": time.Now().UTC(),
+		}}}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// tagsFilter matches the documents carrying every included tag and none of the excluded ones.
+func tagsFilter(includeTags []string, excludeTags []string) bson.D {
+	condition := bson.M{}
+	if include := utils.NormalizeTags(includeTags); len(include) > 0 {
+		condition["$all"] = include
+	}
+	if exclude := utils.NormalizeTags(excludeTags); len(exclude) > 0 {
+		condition["$nin"] = exclude
+	}
+	if len(condition) == 0 {
+		return nil
+	}
+	return bson.D{{Key: "tags", Value: condition}}
+}
@@ -2,6 +2,7 @@ package repos
 
 import (
 	"context"
+	"errors"
 	"personal_schedule_service/internal/collection"
 	"personal_schedule_service/internal/grpc/models"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/thanvuc/go-core-lib/log"
 	"github.com/thanvuc/go-core-lib/mongolib"
 	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.uber.org/zap"
 )
@@ -22,14 +24,17 @@ func (r *userRepo) UpsertSyncUser(ctx context.Context, payload models.UserOutbox
 	collection := r.connector.GetCollection(collection.UsersCollection)
 	filter := bson.M{"_id": payload.UserID}
 
-	update := bson.M{
-		"$set": bson.M{
-			"_id":              payload.UserID,
-			"email":            payload.Email,
-			"created_at":       time.Unix(payload.CreatedAt, 0),
-			"last_modified_at": time.Now(),
-		},
+	set := bson.M{
+		"_id":              payload.UserID,
+		"email":            payload.Email,
+		"created_at":       time.Unix(payload.CreatedAt, 0),
+		"last_modified_at": time.Now(),
+	}
+	// a time zone chosen in this service is kept when the auth service does not send one
+	if payload.TimeZone != "" {
+		set["time_zone"] = payload.TimeZone
 	}
+	update := bson.M{"$set": set}
 
 	opts := options.UpdateOne().SetUpsert(true)
 
@@ -40,3 +45,195 @@ func (r *userRepo) UpsertSyncUser(ctx context.Context, payload models.UserOutbox
 
 	return nil
 }
+
+// GetUserTimeZone returns the IANA time zone of the user, empty when the user has not chosen one.
+func (r *userRepo) GetUserTimeZone(ctx context.Context, userID string) (string, error) {
+	coll := r.connector.GetCollection(collection.UsersCollection)
+	var user collection.User
+	opts := options.FindOne().SetProjection(bson.M{"time_zone": 1})
+	if err := coll.FindOne(ctx, bson.M{"_id": userID}, opts).Decode(&user); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", nil
+		}
+		return "", err
+	}
+	return user.TimeZone, nil
+}
+
+// GetUserLocale returns the locale of the user, empty when the user has not chosen one.
+func (r *userRepo) GetUserLocale(ctx context.Context, userID string) (string, error) {
+	coll := r.connector.GetCollection(collection.UsersCollection)
+	var user collection.User
+	opts := options.FindOne().SetProjection(bson.M{"locale": 1})
+	if err := coll.FindOne(ctx, bson.M{"_id": userID}, opts).Decode(&user); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", nil
+		}
+		return "", err
+	}
+	return user.Locale, nil
+}
+
+// GetUserLocales returns the locale of the users which have chosen one, by user ID.
+func (r *userRepo) GetUserLocales(ctx context.Context, userIDs []string) (map[string]string, error) {
+	coll := r.connector.GetCollection(collection.UsersCollection)
+	filter := bson.M{"_id": bson.M{"$in": userIDs}, "locale": bson.M{"$nin": bson.A{nil, ""}}}
+	opts := options.Find().SetProjection(bson.M{"locale": 1})
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []collection.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	locales := make(map[string]string, len(users))
+	for _, user := range users {
+		locales[user.ID] = user.Locale
+	}
+	return locales, nil
+}
+
+// GetUserIDsByTimeZone groups the users which have chosen a time zone by it.
+func (r *userRepo) GetUserIDsByTimeZone(ctx context.Context) (map[string][]string, error) {
+	coll := r.connector.GetCollection(collection.UsersCollection)
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"time_zone": bson.M{"$nin": bson.A{nil, ""}}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":      "$time_zone",
+			"user_ids": bson.M{"$push": "$_id"},
+		}}},
+	}
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var groups []struct {
+		TimeZone string   `bson:"_id"`
+		UserIDs  []string `bson:"user_ids"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+	userIDs := make(map[string][]string, len(groups))
+	for _, group := range groups {
+		userIDs[group.TimeZone] = group.UserIDs
+	}
+	return userIDs, nil
+}
+
+func (r *userRepo) GetUserByID(ctx context.Context, userID string) (*collection.User, error) {
+	coll := r.connector.GetCollection(collection.UsersCollection)
+	var user collection.User
+	if err := coll.FindOne(ctx, bson.M{"_id": userID}).Decode(&user); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+// UpdateUserPreference replaces the preferences of the user, creating the user when it is not synced yet.
+func (r *userRepo) UpdateUserPreference(ctx context.Context, user *collection.User) (*collection.User, error) {
+	coll := r.connector.GetCollection(collection.UsersCollection)
+	now := time.Now().UTC()
+	update := bson.M{
+		"$set": bson.M{
+			"time_zone":                user.TimeZone,
+			"locale":                   user.Locale,
+			"week_start":               user.WeekStart,
+			"working_hours":            user.WorkingHours,
+			"default_labels":           user.DefaultLabels,
+			"reminder_offsets_minutes": user.ReminderOffsetsMinutes,
+			"daily_capacity_hours":     user.DailyCapacityHours,
+			"last_modified_at":         now,
+		},
+		"$setOnInsert": bson.M{
+			"created_at": now,
+		},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var updated collection.User
+	if err := coll.FindOneAndUpdate(ctx, bson.M{"_id": user.ID}, update, opts).Decode(&updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// CreateFeedToken sets the feed token of the user, creating the user when it is not synced yet.
+// It returns false when the user already has a feed token.
+func (r *userRepo) CreateFeedToken(ctx context.Context, userID string, token collection.UserFeedToken) (bool, error) {
+	coll := r.connector.GetCollection(collection.UsersCollection)
+	now := time.Now().UTC()
+	update := bson.M{
+		"$set": bson.M{
+			"feed_token":       token,
+			"last_modified_at": now,
+		},
+		"$setOnInsert": bson.M{
+			"created_at": now,
+		},
+	}
+	opts := options.UpdateOne().SetUpsert(true)
+
+	// a user with a token does not match, so the upsert collides with its _id
+	if _, err := coll.UpdateOne(ctx, bson.M{"_id": userID, "feed_token": nil}, update, opts); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// ReplaceFeedToken replaces the feed token of the user, it returns false when the user has none.
+func (r *userRepo) ReplaceFeedToken(ctx context.Context, userID string, token collection.UserFeedToken) (bool, error) {
+	coll := r.connector.GetCollection(collection.UsersCollection)
+	filter := bson.M{"_id": userID, "feed_token": bson.M{"$ne": nil}}
+	update := bson.M{
+		"$set": bson.M{
+			"feed_token":       token,
+			"last_modified_at": time.Now().UTC(),
+		},
+	}
+
+	result, err := coll.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+// DeleteFeedToken removes the feed token of the user, it returns false when the user has none.
+func (r *userRepo) DeleteFeedToken(ctx context.Context, userID string) (bool, error) {
+	coll := r.connector.GetCollection(collection.UsersCollection)
+	filter := bson.M{"_id": userID, "feed_token": bson.M{"$ne": nil}}
+	update := bson.M{
+		"$unset": bson.M{"feed_token": ""},
+		"$set":   bson.M{"last_modified_at": time.Now().UTC()},
+	}
+
+	result, err := coll.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+func (r *userRepo) GetUserByFeedTokenHash(ctx context.Context, tokenHash string) (*collection.User, error) {
+	coll := r.connector.GetCollection(collection.UsersCollection)
+	var user collection.User
+	if err := coll.FindOne(ctx, bson.M{"feed_token.token_hash": tokenHash}).Decode(&user); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user, nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"personal_schedule_service/internal/collection"
+	labels_constant "personal_schedule_service/internal/constant/labels"
 	"personal_schedule_service/internal/grpc/models"
 	"personal_schedule_service/internal/grpc/utils"
 	"personal_schedule_service/proto/personal_schedule"
+	"regexp"
 	"time"
 
 	"github.com/thanvuc/go-core-lib/log"
@@ -27,6 +29,13 @@ type GoalInfo struct {
 	Name string        `bson:"name"`
 }
 
+// CalendarVersion summarizes the works of a calendar range, it changes whenever one of them is created,
+// modified or deleted.
+type CalendarVersion struct {
+	LastModifiedAt *time.Time `bson:"last_modified_at"`
+	Count          int64      `bson:"count"`
+}
+
 type SeriesBoundaries struct {
 	MinStartDate *time.Time `bson:"min_start_date"`
 	MaxEndDate   *time.Time `bson:"max_end_date"`
@@ -50,6 +59,27 @@ type AggregatedWork struct {
 	Overdue             []collection.Label `bson:"overdue,omitempty"`
 	Draft               []collection.Label `bson:"draftInfo,omitempty"`
 	RepeatedID          *bson.ObjectID     `bson:"repeated_id,omitempty"`
+	Tags                []string           `bson:"tags,omitempty"`
+	LastModifiedAt      time.Time          `bson:"last_modified_at"`
+	PriorityRank        *int32             `bson:"priority_rank,omitempty"`
+}
+
+type AggregatedWorkEvent struct {
+	ID        bson.ObjectID      `bson:"_id"`
+	EventType string             `bson:"event_type"`
+	Field     string             `bson:"field"`
+	From      []collection.Label `bson:"fromInfo"`
+	To        []collection.Label `bson:"toInfo"`
+	ActorID   string             `bson:"actor_id"`
+	Reason    *string            `bson:"reason,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// WorksPage is a page of GetWorks, Total counts the works of every page.
+type WorksPage struct {
+	Works   []AggregatedWork
+	Total   int32
+	HasMore bool
 }
 
 type totalCountWorksResult struct {
@@ -71,7 +101,9 @@ func (wr *workRepo) GetWorkByID(ctx context.Context, workID bson.ObjectID) (*col
 
 func (wr *workRepo) CreateWork(ctx context.Context, work *collection.Work) (bson.ObjectID, error) {
 	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
-	work.ID = bson.NewObjectID()
+	if work.ID.IsZero() {
+		work.ID = bson.NewObjectID()
+	}
 	res, err := coll.InsertOne(ctx, work)
 	if err != nil {
 		return bson.NilObjectID, err
@@ -96,6 +128,7 @@ func (wr *workRepo) UpdateWork(ctx context.Context, workID bson.ObjectID, work *
 		"category_id":          work.CategoryID,
 		"draft_id":             work.DraftID,
 		"goal_id":              work.GoalID,
+		"tags":                 work.Tags,
 		"last_modified_at":     now,
 	}
 	_, err := coll.UpdateOne(ctx, bson.M{"_id": workID}, bson.M{"$set": updates})
@@ -125,7 +158,9 @@ func (wr *workRepo) BulkWriteSubTasks(ctx context.Context, operations []mongo.Wr
 	return coll.BulkWrite(ctx, operations)
 }
 
-func (wr *workRepo) GetWorks(ctx context.Context, req *personal_schedule.GetWorksRequest) ([]AggregatedWork, int32, error) {
+// GetWorks returns a page of the works matching the request, in the sort of the request.
+// A page limit of 0 returns every work, after is the cursor the page starts after.
+func (wr *workRepo) GetWorks(ctx context.Context, req *personal_schedule.GetWorksRequest, page models.Pagination, after *models.WorkCursor) (*WorksPage, error) {
 	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
 
 	var fromDate, toDate time.Time
@@ -149,7 +184,7 @@ func (wr *workRepo) GetWorks(ctx context.Context, req *personal_schedule.GetWork
 		matchFilter = append(matchFilter, bson.E{
 			Key: "name_normalized",
 			Value: bson.M{
-				"$regex":   searchNorm,
+				"$regex":   regexp.QuoteMeta(searchNorm),
 				"$options": "i",
 			},
 		})
@@ -179,6 +214,7 @@ func (wr *workRepo) GetWorks(ctx context.Context, req *personal_schedule.GetWork
 			matchFilter = append(matchFilter, bson.E{Key: "category_id", Value: objID})
 		}
 	}
+	matchFilter = append(matchFilter, tagsFilter(req.IncludeTags, req.ExcludeTags)...)
 
 	lookupStatus := bson.D{{
 		Key: "$lookup",
@@ -247,59 +283,169 @@ func (wr *workRepo) GetWorks(ctx context.Context, req *personal_schedule.GetWork
 		},
 	}}
 
-	sortStage := bson.D{
-		{Key: "$sort", Value: bson.M{"end_date": 1}},
+	sortField := workSortFields[req.SortKey]
+	sortOrder := 1
+	if req.SortDescending {
+		sortOrder = -1
 	}
 
-	matchStage := bson.D{
-		{Key: "$match", Value: matchFilter},
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: matchFilter}},
+	}
+	if req.SortKey == personal_schedule.WorkSortKey_WORK_SORT_KEY_PRIORITY {
+		pipeline = append(pipeline, priorityRankStages()...)
 	}
 
-	pipeline := mongo.Pipeline{
-		matchStage,
+	// the cursor only narrows the page, the total counts every work of the filter
+	pageStages := []bson.D{}
+	if after != nil {
+		pageStages = append(pageStages, bson.D{{Key: "$match", Value: afterCursorFilter(sortField, req.SortDescending, after)}})
+	}
+	pageStages = append(pageStages, bson.D{{Key: "$sort", Value: bson.D{
+		{Key: sortField, Value: sortOrder},
+		{Key: "_id", Value: sortOrder},
+	}}})
+	if page.Offset > 0 {
+		pageStages = append(pageStages, bson.D{{Key: "$skip", Value: page.Offset}})
+	}
+	if page.Limit > 0 {
+		// one more work tells whether there is a next page
+		pageStages = append(pageStages, bson.D{{Key: "$limit", Value: page.Limit + 1}})
+	}
+	pageStages = append(pageStages,
 		lookupStatus,
 		lookupDifficulty,
 		lookupPriority,
 		lookupCategory,
 		lookupType,
 		lookupDraft,
-		sortStage,
 		lookupGoal,
+	)
+
+	// every work in a single $facet document could exceed the document size limit, so an unbounded query is streamed
+	if page.Limit <= 0 {
+		pipeline = append(pipeline, pageStages...)
+		cursor, err := coll.Aggregate(ctx, pipeline)
+		if err != nil {
+			return nil, err
+		}
+		defer cursor.Close(ctx)
+
+		var works []AggregatedWork
+		if err = cursor.All(ctx, &works); err != nil {
+			wr.logger.Error("Failed to decode works", "", zap.Error(err))
+			return nil, err
+		}
+		return &WorksPage{Works: works, Total: int32(len(works))}, nil
 	}
 
-	pipelineCount := mongo.Pipeline{
-		{{Key: "$match", Value: matchFilter}},
-		{{Key: "$count", Value: "total"}},
+	facetStages := make(bson.A, 0, len(pageStages))
+	for _, stage := range pageStages {
+		facetStages = append(facetStages, stage)
 	}
+	pipeline = append(pipeline, bson.D{{Key: "$facet", Value: bson.M{
+		"works": facetStages,
+		"total": bson.A{bson.D{{Key: "$count", Value: "total"}}},
+	}}})
 
 	cursor, err := coll.Aggregate(ctx, pipeline)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer cursor.Close(ctx)
 
-	var works []AggregatedWork
-	if err = cursor.All(ctx, &works); err != nil {
+	var results []struct {
+		Works []AggregatedWork        `bson:"works"`
+		Total []totalCountWorksResult `bson:"total"`
+	}
+	if err = cursor.All(ctx, &results); err != nil {
 		wr.logger.Error("Failed to decode works", "", zap.Error(err))
-		return nil, 0, err
+		return nil, err
 	}
 
-	countCursor, err := coll.Aggregate(ctx, pipelineCount)
-	totalWorks := int32(0)
-	if err != nil {
-		wr.logger.Error("Failed to aggregate works count", "", zap.Error(err))
-		return nil, 0, err
-	} else {
-		defer countCursor.Close(ctx)
-		var countResult []totalCountResult
-		if err = countCursor.All(ctx, &countResult); err == nil && len(countResult) > 0 {
-			totalWorks = countResult[0].Total
-		} else if err != nil {
-			wr.logger.Error("Failed to decode GetWorks count results", "err", zap.Error(err))
+	result := &WorksPage{}
+	if len(results) == 0 {
+		return result, nil
+	}
+	result.Works = results[0].Works
+	if len(results[0].Total) > 0 {
+		result.Total = results[0].Total[0].Total
+	}
+	if int32(len(result.Works)) > page.Limit {
+		result.Works = result.Works[:page.Limit]
+		result.HasMore = true
+	}
+	return result, nil
+}
+
+// afterCursorFilter matches the works sorted after the cursor. Null sort values come first in an ascending sort
+// and last in a descending one, as MongoDB orders them.
+func afterCursorFilter(sortField string, descending bool, after *models.WorkCursor) bson.M {
+	comparison := "$gt"
+	if descending {
+		comparison = "$lt"
+	}
+	if after.Null {
+		branches := bson.A{
+			bson.M{sortField: nil, "_id": bson.M{comparison: after.ID}},
 		}
+		if !descending {
+			branches = append(branches, bson.M{sortField: bson.M{"$ne": nil}})
+		}
+		return bson.M{"$or": branches}
+	}
+
+	var afterValue any = after.Value
+	if sortField != "priority_rank" {
+		afterValue = time.UnixMilli(after.Value).UTC()
 	}
+	branches := bson.A{
+		bson.M{sortField: bson.M{comparison: afterValue}},
+		bson.M{sortField: afterValue, "_id": bson.M{comparison: after.ID}},
+	}
+	if descending {
+		branches = append(branches, bson.M{sortField: nil})
+	}
+	return bson.M{"$or": branches}
+}
 
-	return works, totalWorks, nil
+// workSortFields are the work fields GetWorks sorts by.
+var workSortFields = map[personal_schedule.WorkSortKey]string{
+	personal_schedule.WorkSortKey_WORK_SORT_KEY_END_DATE:         "end_date",
+	personal_schedule.WorkSortKey_WORK_SORT_KEY_START_DATE:       "start_date",
+	personal_schedule.WorkSortKey_WORK_SORT_KEY_PRIORITY:         "priority_rank",
+	personal_schedule.WorkSortKey_WORK_SORT_KEY_LAST_MODIFIED_AT: "last_modified_at",
+}
+
+// priorityRankStages set priority_rank to the position of the work priority in PriorityOrder,
+// the works without a known priority come last.
+func priorityRankStages() []bson.D {
+	branches := make(bson.A, 0, len(labels_constant.PriorityOrder))
+	for rank, key := range labels_constant.PriorityOrder {
+		branches = append(branches, bson.M{
+			"case": bson.M{"$eq": bson.A{bson.M{"$arrayElemAt": bson.A{"$priorityRank.key", 0}}, key}},
+			"then": rank,
+		})
+	}
+
+	return []bson.D{
+		{{Key: "$lookup", Value: bson.M{
+			"from":         collection.LabelsCollection,
+			"localField":   "priority_id",
+			"foreignField": "_id",
+			"as":           "priorityRank",
+			"pipeline": bson.A{
+				bson.D{{Key: "$project", Value: bson.M{"key": 1}}},
+			},
+		}}},
+		{{Key: "$addFields", Value: bson.M{
+			"priority_rank": bson.M{"$switch": bson.M{
+				"branches": branches,
+				"default":  len(labels_constant.PriorityOrder),
+			}},
+		}}},
+		{{Key: "$project", Value: bson.M{"priorityRank": 0}}},
+	}
 }
 
 func (wr *workRepo) CountOverlappingWorks(ctx context.Context, userID string, startDate, endDate int64, excludeWorkID *bson.ObjectID) (int64, error) {
@@ -578,11 +724,12 @@ func (wr *workRepo) BulkInsertSubTasks(ctx context.Context, subTasks []interface
 	return err
 }
 
+// GetLabelsByTypeIDs returns the system labels of the type, the custom labels have no key.
 func (wr *workRepo) GetLabelsByTypeIDs(ctx context.Context, typeID int32) ([]collection.Label, error) {
 	var labels []collection.Label
 	collection := wr.mongoConnector.GetCollection(collection.LabelsCollection)
 	println("typeID:", typeID)
-	filter := bson.M{"label_type": typeID}
+	filter := bson.M{"label_type": typeID, "user_id": nil, "deprecated_at": nil}
 	options := options.Find().SetSort(bson.D{{Key: "color", Value: 1}})
 	cursor, err := collection.Find(ctx, filter, options)
 	if err != nil {
@@ -753,9 +900,9 @@ func (wr *workRepo) DeleteSubTasksByWorkIDs(ctx context.Context, workIDs []bson.
 	return err
 }
 
-func (wr *workRepo) GetExistingTimes(ctx context.Context, userID string, localDate string) ([]*models.TimeRange, error) {
+func (wr *workRepo) GetExistingTimes(ctx context.Context, userID string, localDate string, loc *time.Location) ([]*models.TimeRange, error) {
 	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
-	startOfDay, endOffDay, err := utils.VietNameLocalDateRangeUTC(localDate)
+	startOfDay, endOffDay, err := utils.LocalDateRangeUTC(localDate, loc)
 	if err != nil {
 		return nil, err
 	}
@@ -787,13 +934,14 @@ func (wr *workRepo) GetExistingTimes(ctx context.Context, userID string, localDa
 	return existingTimes, nil
 }
 
-func (wr *workRepo) DeleteDraftBefore(ctx context.Context, before time.Time) error {
+// DeleteDraftBefore deletes the drafts created before the given time, of the given users
+// or, with excludeUserIDs, of every other user.
+func (wr *workRepo) DeleteDraftBefore(ctx context.Context, before time.Time, userIDs []string, excludeUserIDs bool) error {
 	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
 
-	filter := bson.M{
-		"draft_id":   bson.M{"$ne": nil},
-		"created_at": bson.M{"$lt": before},
-	}
+	filter := draftUsersFilter(userIDs, excludeUserIDs)
+	filter["draft_id"] = bson.M{"$ne": nil}
+	filter["created_at"] = bson.M{"$lt": before}
 
 	result, err := coll.DeleteMany(ctx, filter)
 	if err != nil {
@@ -804,6 +952,35 @@ func (wr *workRepo) DeleteDraftBefore(ctx context.Context, before time.Time) err
 	return nil
 }
 
+// DeleteDraftRepeatedSeriesBefore deletes the draft series created before the given time, so their drafts are not materialized again.
+func (wr *workRepo) DeleteDraftRepeatedSeriesBefore(ctx context.Context, before time.Time, userIDs []string, excludeUserIDs bool) error {
+	coll := wr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+
+	filter := draftUsersFilter(userIDs, excludeUserIDs)
+	filter["template.draft_id"] = bson.M{"$exists": true, "$ne": nil}
+	filter["created_at"] = bson.M{"$lt": before}
+
+	result, err := coll.DeleteMany(ctx, filter)
+	if err != nil {
+		return err
+	}
+	wr.logger.Info("DeleteDraftRepeatedSeriesBefore", "", zap.Int64("deleted_count", result.DeletedCount))
+	return nil
+}
+
+// draftUsersFilter matches the given users, or every other user when excludeUserIDs is set.
+func draftUsersFilter(userIDs []string, excludeUserIDs bool) bson.M {
+	filter := bson.M{}
+	if excludeUserIDs {
+		if len(userIDs) > 0 {
+			filter["user_id"] = bson.M{"$nin": userIDs}
+		}
+	} else {
+		filter["user_id"] = bson.M{"$in": userIDs}
+	}
+	return filter
+}
+
 func (wr *workRepo) GetWorksInRange(ctx context.Context, userID string, startMs, endMs int64, excludeWorkID *bson.ObjectID) ([]collection.Work, error) {
 	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
 
@@ -827,9 +1004,12 @@ func (wr *workRepo) GetWorksInRange(ctx context.Context, userID string, startMs,
 	}
 
 	projection := bson.M{
-		"_id":        1,
-		"start_date": 1,
-		"end_date":   1,
+		"_id":               1,
+		"name":              1,
+		"start_date":        1,
+		"end_date":          1,
+		"repeated_id":       1,
+		"scheduled_from_id": 1,
 	}
 
 	opts := options.Find().SetProjection(projection)
@@ -847,3 +1027,527 @@ func (wr *workRepo) GetWorksInRange(ctx context.Context, userID string, startMs,
 
 	return works, nil
 }
+
+// GetUnscheduledWorks returns the real works without start date ending in [from, to), except the given statuses.
+func (wr *workRepo) GetUnscheduledWorks(ctx context.Context, userID string, from, to time.Time, excludedStatusIDs []bson.ObjectID) ([]collection.Work, error) {
+	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
+	filter := bson.M{
+		"user_id":    userID,
+		"start_date": nil,
+		"draft_id":   nil,
+		"end_date": bson.M{
+			"$gte": from,
+			"$lt":  to,
+		},
+	}
+	// a nil slice would be sent as null, which $nin rejects
+	if len(excludedStatusIDs) > 0 {
+		filter["status_id"] = bson.M{"$nin": excludedStatusIDs}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "end_date", Value: 1}})
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var works []collection.Work
+	if err := cursor.All(ctx, &works); err != nil {
+		return nil, err
+	}
+	return works, nil
+}
+
+func (wr *workRepo) GetWorksByIDs(ctx context.Context, userID string, workIDs []bson.ObjectID) ([]collection.Work, error) {
+	if len(workIDs) == 0 {
+		return nil, nil
+	}
+	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
+	cursor, err := coll.Find(ctx, bson.M{
+		"user_id": userID,
+		"_id":     bson.M{"$in": workIDs},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var works []collection.Work
+	if err := cursor.All(ctx, &works); err != nil {
+		return nil, err
+	}
+	return works, nil
+}
+
+// GetDraftsScheduledFrom returns the drafts the auto scheduler created for the given works.
+func (wr *workRepo) GetDraftsScheduledFrom(ctx context.Context, userID string, sourceIDs []bson.ObjectID) ([]collection.Work, error) {
+	if len(sourceIDs) == 0 {
+		return nil, nil
+	}
+	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
+	cursor, err := coll.Find(ctx, bson.M{
+		"user_id":           userID,
+		"scheduled_from_id": bson.M{"$in": sourceIDs},
+	}, options.Find().SetProjection(bson.M{"_id": 1, "scheduled_from_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var works []collection.Work
+	if err := cursor.All(ctx, &works); err != nil {
+		return nil, err
+	}
+	return works, nil
+}
+
+func (wr *workRepo) UpdateWorkSchedule(ctx context.Context, workID bson.ObjectID, startDate, endDate time.Time) error {
+	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
+	_, err := coll.UpdateOne(ctx, bson.M{"_id": workID}, bson.M{
+		"$set": bson.M{
+			"start_date":       startDate,
+			"end_date":         endDate,
+			"last_modified_at": time.Now().UTC(),
+		},
+	})
+	return err
+}
+
+func (wr *workRepo) DeleteWorksByIDs(ctx context.Context, workIDs []bson.ObjectID) error {
+	if len(workIDs) == 0 {
+		return nil
+	}
+	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
+	_, err := coll.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": workIDs}})
+	return err
+}
+
+func (wr *workRepo) CreateRepeatedSeries(ctx context.Context, series *collection.RepeatedSeries) error {
+	coll := wr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	_, err := coll.InsertOne(ctx, series)
+	return err
+}
+
+func (wr *workRepo) GetRepeatedSeriesByID(ctx context.Context, repeatedID bson.ObjectID) (*collection.RepeatedSeries, error) {
+	coll := wr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	var series collection.RepeatedSeries
+	err := coll.FindOne(ctx, bson.M{"_id": repeatedID}).Decode(&series)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &series, nil
+}
+
+func (wr *workRepo) UpdateRepeatedSeriesRule(ctx context.Context, repeatedID bson.ObjectID, rrule string, endsAt *time.Time) error {
+	coll := wr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	_, err := coll.UpdateOne(ctx, bson.M{"_id": repeatedID}, bson.M{"$set": bson.M{
+		"rrule":            rrule,
+		"ends_at":          endsAt,
+		"last_modified_at": time.Now().UTC(),
+	}})
+	return err
+}
+
+func (wr *workRepo) UpdateRepeatedSeriesTemplate(ctx context.Context, repeatedID bson.ObjectID, template collection.RepeatedSeriesTemplate) error {
+	coll := wr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	_, err := coll.UpdateOne(ctx, bson.M{"_id": repeatedID}, bson.M{"$set": bson.M{
+		"template":         template,
+		"last_modified_at": time.Now().UTC(),
+	}})
+	return err
+}
+
+// GetRepeatedSeriesBehind returns the series which still have occurrences to materialize before the given time,
+// for every user when userID is empty.
+func (wr *workRepo) GetRepeatedSeriesBehind(ctx context.Context, userID string, before time.Time) ([]collection.RepeatedSeries, error) {
+	coll := wr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	filter := bson.M{
+		"materialized_until": bson.M{"$lt": before},
+		"dtstart":            bson.M{"$lt": before},
+		"$or": bson.A{
+			bson.M{"ends_at": nil},
+			bson.M{"$expr": bson.M{"$gte": bson.A{"$ends_at", "$materialized_until"}}},
+		},
+	}
+	if userID != "" {
+		filter["user_id"] = userID
+	}
+
+	cursor, err := coll.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var series []collection.RepeatedSeries
+	if err = cursor.All(ctx, &series); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+func (wr *workRepo) AdvanceRepeatedSeriesMaterializedUntil(ctx context.Context, repeatedID bson.ObjectID, until time.Time) error {
+	coll := wr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	_, err := coll.UpdateOne(ctx, bson.M{"_id": repeatedID}, bson.M{
+		"$max": bson.M{"materialized_until": until.UTC()},
+	})
+	return err
+}
+
+// UpsertRepeatedWorks inserts the works whose occurrence is not materialized yet and returns the IDs of the inserted ones.
+// Existing occurrences are matched by (repeated_id, recurrence_id) and left untouched.
+func (wr *workRepo) UpsertRepeatedWorks(ctx context.Context, works []collection.Work) ([]bson.ObjectID, error) {
+	if len(works) == 0 {
+		return nil, nil
+	}
+	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
+
+	models := make([]mongo.WriteModel, 0, len(works))
+	for _, work := range works {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"repeated_id": work.RepeatedID, "recurrence_id": work.RecurrenceID}).
+			SetUpdate(bson.M{"$setOnInsert": work}).
+			SetUpsert(true))
+	}
+
+	// a concurrent materialization of the same occurrence fails the transaction with a write conflict,
+	// its retry then matches the occurrence instead of inserting it
+	result, err := coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return nil, err
+	}
+
+	var insertedIDs []bson.ObjectID
+	for _, id := range result.UpsertedIDs {
+		if objID, ok := id.(bson.ObjectID); ok {
+			insertedIDs = append(insertedIDs, objID)
+		}
+	}
+	wr.logger.Info("UpsertRepeatedWorks", "", zap.Int("count", len(works)), zap.Int("inserted", len(insertedIDs)))
+	return insertedIDs, nil
+}
+
+func (wr *workRepo) AddRepeatedSeriesExDate(ctx context.Context, repeatedID bson.ObjectID, exDate time.Time) error {
+	coll := wr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	_, err := coll.UpdateOne(ctx, bson.M{"_id": repeatedID}, bson.M{
+		"$addToSet": bson.M{"exdates": exDate.UTC()},
+		"$set":      bson.M{"last_modified_at": time.Now().UTC()},
+	})
+	return err
+}
+
+// GetWorksToTransition returns non-draft works in one of the given statuses which ended before endBefore, oldest first.
+// The whole documents are loaded since the lifecycle events of the transition carry the full work.
+func (wr *workRepo) GetWorksToTransition(ctx context.Context, statusIDs []bson.ObjectID, endBefore time.Time, limit int64) ([]collection.Work, error) {
+	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
+	filter := bson.M{
+		"status_id": bson.M{"$in": statusIDs},
+		"end_date":  bson.M{"$lt": endBefore},
+		"draft_id":  nil,
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "end_date", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var works []collection.Work
+	if err = cursor.All(ctx, &works); err != nil {
+		return nil, err
+	}
+	return works, nil
+}
+
+// TransitionWorkStatus moves a work to toStatusID only if it is still in fromStatusID, reporting whether it moved.
+func (wr *workRepo) TransitionWorkStatus(ctx context.Context, workID bson.ObjectID, fromStatusID, toStatusID bson.ObjectID) (bool, error) {
+	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
+	result, err := coll.UpdateOne(ctx,
+		bson.M{"_id": workID, "status_id": fromStatusID},
+		bson.M{"$set": bson.M{
+			"status_id":        toStatusID,
+			"last_modified_at": time.Now().UTC(),
+		}},
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+func (wr *workRepo) InsertWorkEvents(ctx context.Context, events []interface{}) error {
+	if len(events) == 0 {
+		return nil
+	}
+	coll := wr.mongoConnector.GetCollection(collection.WorkEventsCollection)
+	_, err := coll.InsertMany(ctx, events)
+	return err
+}
+
+// GetWorkEvents returns the history of a work, oldest first, with the labels it moved between.
+func (wr *workRepo) GetWorkEvents(ctx context.Context, workID bson.ObjectID) ([]AggregatedWorkEvent, error) {
+	coll := wr.mongoConnector.GetCollection(collection.WorkEventsCollection)
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"work_id": workID}}},
+		{{Key: "$sort", Value: bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         collection.LabelsCollection,
+			"localField":   "from_id",
+			"foreignField": "_id",
+			"as":           "fromInfo",
+		}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         collection.LabelsCollection,
+			"localField":   "to_id",
+			"foreignField": "_id",
+			"as":           "toInfo",
+		}}},
+	}
+
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		wr.logger.Error("Failed to aggregate work events", "", zap.Error(err), zap.String("work_id", workID.Hex()))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var events []AggregatedWorkEvent
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// GetCompletionTimes returns, per work of the user, the latest time it was moved to completedStatusID within [from, to).
+func (wr *workRepo) GetCompletionTimes(ctx context.Context, userID string, completedStatusID bson.ObjectID, from, to time.Time) (map[bson.ObjectID]time.Time, error) {
+	coll := wr.mongoConnector.GetCollection(collection.WorkEventsCollection)
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"user_id":    userID,
+			"field":      "status_id",
+			"to_id":      completedStatusID,
+			"work_id":    bson.M{"$exists": true},
+			"created_at": bson.M{"$gte": from, "$lt": to},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":          "$work_id",
+			"completed_at": bson.M{"$max": "$created_at"},
+		}}},
+	}
+
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		WorkID      bson.ObjectID `bson:"_id"`
+		CompletedAt time.Time     `bson:"completed_at"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	result := make(map[bson.ObjectID]time.Time, len(rows))
+	for _, row := range rows {
+		result[row.WorkID] = row.CompletedAt
+	}
+	return result, nil
+}
+
+// GetWorksForCalendar returns the non draft works of the user overlapping [from, to),
+// and the undated ones ending in it.
+func (wr *workRepo) GetWorksForCalendar(ctx context.Context, userID string, from, to time.Time) ([]collection.Work, error) {
+	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
+	opts := options.Find().SetSort(bson.D{{Key: "start_date", Value: 1}, {Key: "end_date", Value: 1}})
+
+	cursor, err := coll.Find(ctx, calendarWorksFilter(userID, from, to), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var works []collection.Work
+	if err := cursor.All(ctx, &works); err != nil {
+		return nil, err
+	}
+	return works, nil
+}
+
+// GetCalendarVersion summarizes the works GetWorksForCalendar returns for the same range.
+func (wr *workRepo) GetCalendarVersion(ctx context.Context, userID string, from, to time.Time) (*CalendarVersion, error) {
+	coll := wr.mongoConnector.GetCollection(collection.WorksCollection)
+	return aggregateVersion(ctx, coll, calendarWorksFilter(userID, from, to))
+}
+
+// GetRepeatedSeriesVersion summarizes the series of the user, their rule and template edits move it.
+func (wr *workRepo) GetRepeatedSeriesVersion(ctx context.Context, userID string) (*CalendarVersion, error) {
+	coll := wr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	return aggregateVersion(ctx, coll, bson.M{"user_id": userID})
+}
+
+// aggregateVersion returns the last modification and the count of the documents matching filter.
+func aggregateVersion(ctx context.Context, coll *mongo.Collection, filter bson.M) (*CalendarVersion, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.M{
+			"_id":              nil,
+			"last_modified_at": bson.M{"$max": "$last_modified_at"},
+			"count":            bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []CalendarVersion
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return &CalendarVersion{}, nil
+	}
+	return &results[0], nil
+}
+
+func calendarWorksFilter(userID string, from, to time.Time) bson.M {
+	return bson.M{
+		"user_id":  userID,
+		"draft_id": nil,
+		"$or": bson.A{
+			bson.M{
+				"start_date": bson.M{"$lt": to},
+				"end_date":   bson.M{"$gt": from},
+			},
+			bson.M{
+				"start_date": nil,
+				"end_date":   bson.M{"$gte": from, "$lt": to},
+			},
+		},
+	}
+}
+
+func (wr *workRepo) GetSubTasksByWorkIDs(ctx context.Context, workIDs []bson.ObjectID) ([]collection.SubTask, error) {
+	if len(workIDs) == 0 {
+		return nil, nil
+	}
+	coll := wr.mongoConnector.GetCollection(collection.SubTasksCollection)
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := coll.Find(ctx, bson.M{"work_id": bson.M{"$in": workIDs}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var subTasks []collection.SubTask
+	if err := cursor.All(ctx, &subTasks); err != nil {
+		return nil, err
+	}
+	return subTasks, nil
+}
+
+func (wr *workRepo) GetRepeatedSeriesByIDs(ctx context.Context, repeatedIDs []bson.ObjectID) ([]collection.RepeatedSeries, error) {
+	if len(repeatedIDs) == 0 {
+		return nil, nil
+	}
+	coll := wr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	cursor, err := coll.Find(ctx, bson.M{"_id": bson.M{"$in": repeatedIDs}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var series []collection.RepeatedSeries
+	if err := cursor.All(ctx, &series); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// GetRepeatedSeriesForCalendar returns the series of the user with an occurrence overlapping the range, drafts excluded.
+func (wr *workRepo) GetRepeatedSeriesForCalendar(ctx context.Context, userID string, from, to time.Time) ([]collection.RepeatedSeries, error) {
+	coll := wr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	filter := bson.M{
+		"user_id":           userID,
+		"template.draft_id": nil,
+		"dtstart":           bson.M{"$lt": to},
+		"$or": bson.A{
+			bson.M{"ends_at": nil},
+			// the last occurrence still ends inside the range
+			bson.M{"$expr": bson.M{"$gt": bson.A{bson.M{"$add": bson.A{"$ends_at", "$duration_ms"}}, from}}},
+		},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "dtstart", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var series []collection.RepeatedSeries
+	if err := cursor.All(ctx, &series); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// GetImportedUIDs returns which of the iCalendar UIDs were already imported by the user, as a work or a series.
+func (wr *workRepo) GetImportedUIDs(ctx context.Context, userID string, uids []string) (map[string]bool, error) {
+	imported := make(map[string]bool)
+	if len(uids) == 0 {
+		return imported, nil
+	}
+	filter := bson.M{
+		"user_id":      userID,
+		"external_uid": bson.M{"$in": uids},
+	}
+	for _, name := range []string{collection.WorksCollection, collection.RepeatedSeriesCollection} {
+		var values []string
+		err := wr.mongoConnector.GetCollection(name).Distinct(ctx, "external_uid", filter).Decode(&values)
+		if err != nil && err != mongo.ErrNoDocuments {
+			return nil, err
+		}
+		for _, uid := range values {
+			imported[uid] = true
+		}
+	}
+	return imported, nil
+}
+
+// SaveDraftRepeatedSeries accepts the draft series of the user, their next occurrences are materialized as real works.
+func (wr *workRepo) SaveDraftRepeatedSeries(ctx context.Context, userID string, draftID bson.ObjectID) error {
+	coll := wr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	_, err := coll.UpdateMany(ctx, bson.M{
+		"user_id":           userID,
+		"template.draft_id": draftID,
+	}, bson.M{
+		"$unset": bson.M{"template.draft_id": ""},
+		"$set":   bson.M{"last_modified_at": time.Now().UTC()},
+	})
+	return err
+}
+
+// DeleteDraftRepeatedSeries deletes the draft series of the user, their works are deleted with the other drafts.
+func (wr *workRepo) DeleteDraftRepeatedSeries(ctx context.Context, userID string) error {
+	coll := wr.mongoConnector.GetCollection(collection.RepeatedSeriesCollection)
+	result, err := coll.DeleteMany(ctx, bson.M{
+		"user_id":           userID,
+		"template.draft_id": bson.M{"$exists": true, "$ne": nil},
+	})
+	if err != nil {
+		return err
+	}
+	wr.logger.Info("DeleteDraftRepeatedSeries", "", zap.String("user_id", userID), zap.Int64("deleted_count", result.DeletedCount))
+	return nil
+}
@@ -15,6 +15,9 @@ import (
 func InjectLabelController() *controller.LabelController {
 	wire.Build(
 		repos.NewLabelRepo,
+		repos.NewUserRepo,
+		repos.NewWorkRepo,
+		repos.NewOutboxRepo,
 		mapper.NewLabelMapper,
 		services.NewLabelService,
 		controller.NewLabelController,
@@ -23,10 +26,24 @@ func InjectLabelController() *controller.LabelController {
 	return nil
 }
 
+func InjectLabelAdminController() *controller.LabelAdminController {
+	wire.Build(
+		repos.NewLabelRepo,
+		repos.NewWorkRepo,
+		repos.NewOutboxRepo,
+		mapper.NewLabelMapper,
+		services.NewLabelAdminService,
+		controller.NewLabelAdminController,
+	)
+
+	return nil
+}
+
 func InjectGoalController() *controller.GoalController {
 	wire.Build(
 		repos.NewGoalRepo,
 		repos.NewLabelRepo,
+		repos.NewOutboxRepo,
 		mapper.NewGoalMapper,
 		services.NewGoalService,
 		controller.NewGoalController,
@@ -40,6 +57,9 @@ func InjectWorkController() *controller.WorkController {
 	wire.Build(
 		repos.NewWorkRepo,
 		repos.NewLabelRepo,
+		repos.NewOutboxRepo,
+		repos.NewUserRepo,
+		repos.NewGoalRepo,
 		mapper.NewWorkMapper,
 		services.NewWorkService,
 		controller.NewWorkController,
@@ -47,3 +67,62 @@ func InjectWorkController() *controller.WorkController {
 	)
 	return nil
 }
+
+func InjectAnalyticsController() *controller.AnalyticsController {
+	wire.Build(
+		repos.NewAnalyticsRepo,
+		repos.NewWorkRepo,
+		repos.NewLabelRepo,
+		repos.NewUserRepo,
+		mapper.NewLabelMapper,
+		services.NewAnalyticsService,
+		controller.NewAnalyticsController,
+	)
+	return nil
+}
+
+func InjectUserPreferenceController() *controller.UserPreferenceController {
+	wire.Build(
+		repos.NewUserRepo,
+		repos.NewLabelRepo,
+		mapper.NewUserPreferenceMapper,
+		validation.NewUserPreferenceValidator,
+		services.NewUserPreferenceService,
+		controller.NewUserPreferenceController,
+	)
+	return nil
+}
+
+func InjectCalendarController() *controller.CalendarController {
+	wire.Build(
+		repos.NewWorkRepo,
+		repos.NewLabelRepo,
+		repos.NewUserRepo,
+		repos.NewOutboxRepo,
+		repos.NewGoalRepo,
+		mapper.NewWorkMapper,
+		validation.NewWorkValidator,
+		services.NewCalendarService,
+		services.NewWorkService,
+		controller.NewCalendarController,
+	)
+	return nil
+}
+
+func InjectSearchController() *controller.SearchController {
+	wire.Build(
+		repos.NewSearchRepo,
+		services.NewSearchService,
+		controller.NewSearchController,
+	)
+	return nil
+}
+
+func InjectTagController() *controller.TagController {
+	wire.Build(
+		repos.NewTagRepo,
+		services.NewTagService,
+		controller.NewTagController,
+	)
+	return nil
+}
@@ -16,6 +16,9 @@ func InjectWorkCronJob() *cronjob.WorkCronJob {
 	wire.Build(
 		repos.NewWorkRepo,
 		repos.NewLabelRepo,
+		repos.NewOutboxRepo,
+		repos.NewUserRepo,
+		repos.NewGoalRepo,
 		mapper.NewWorkMapper,
 		validation.NewWorkValidator,
 		services.NewWorkService,
@@ -0,0 +1,23 @@
+//go:build wireinject
+
+package wire
+
+import (
+	"personal_schedule_service/internal/feed"
+	"personal_schedule_service/internal/grpc/services"
+	"personal_schedule_service/internal/repos"
+
+	"github.com/google/wire"
+)
+
+func InjectCalendarFeedHandler() *feed.CalendarFeedHandler {
+	wire.Build(
+		repos.NewWorkRepo,
+		repos.NewLabelRepo,
+		repos.NewUserRepo,
+		services.NewCalendarService,
+		feed.NewCalendarFeedHandler,
+	)
+
+	return nil
+}
@@ -23,6 +23,7 @@ func InjectGenerateWorkHandler() *handler.WorkGenerationHandler {
 	wire.Build(
 		repos.NewWorkRepo,
 		repos.NewLabelRepo,
+		repos.NewUserRepo,
 		validation.NewWorkValidator,
 		handler.NewWorkGenerationHandler,
 	)
@@ -0,0 +1,19 @@
+//go:build wireinject
+
+package wire
+
+import (
+	"personal_schedule_service/internal/outbox"
+	"personal_schedule_service/internal/repos"
+
+	"github.com/google/wire"
+)
+
+func InjectOutboxRelay() *outbox.Relay {
+	wire.Build(
+		repos.NewOutboxRepo,
+		outbox.NewRelay,
+	)
+
+	return nil
+}
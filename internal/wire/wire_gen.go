@@ -9,10 +9,12 @@ package wire
 import (
 	"personal_schedule_service/internal/cronjob/cronjob"
 	"personal_schedule_service/internal/eventbus/handler"
+	"personal_schedule_service/internal/feed"
 	"personal_schedule_service/internal/grpc/controller"
 	"personal_schedule_service/internal/grpc/mapper"
 	"personal_schedule_service/internal/grpc/services"
 	"personal_schedule_service/internal/grpc/validation"
+	"personal_schedule_service/internal/outbox"
 	"personal_schedule_service/internal/repos"
 )
 
@@ -21,17 +23,31 @@ import (
 func InjectLabelController() *controller.LabelController {
 	labelRepo := repos.NewLabelRepo()
 	labelMapper := mapper.NewLabelMapper()
-	labelService := services.NewLabelService(labelRepo, labelMapper)
+	userRepo := repos.NewUserRepo()
+	workRepo := repos.NewWorkRepo()
+	outboxRepo := repos.NewOutboxRepo()
+	labelService := services.NewLabelService(labelRepo, labelMapper, userRepo, workRepo, outboxRepo)
 	labelController := controller.NewLabelController(labelService)
 	return labelController
 }
 
+func InjectLabelAdminController() *controller.LabelAdminController {
+	labelRepo := repos.NewLabelRepo()
+	labelMapper := mapper.NewLabelMapper()
+	workRepo := repos.NewWorkRepo()
+	outboxRepo := repos.NewOutboxRepo()
+	labelAdminService := services.NewLabelAdminService(labelRepo, labelMapper, workRepo, outboxRepo)
+	labelAdminController := controller.NewLabelAdminController(labelAdminService)
+	return labelAdminController
+}
+
 func InjectGoalController() *controller.GoalController {
 	goalRepo := repos.NewGoalRepo()
 	goalMapper := mapper.NewGoalMapper()
 	labelRepo := repos.NewLabelRepo()
 	goalValidator := validation.NewGoalValidator(goalRepo, labelRepo)
-	goalService := services.NewGoalService(goalRepo, goalMapper, goalValidator)
+	outboxRepo := repos.NewOutboxRepo()
+	goalService := services.NewGoalService(goalRepo, goalMapper, goalValidator, outboxRepo)
 	goalController := controller.NewGoalController(goalService)
 	return goalController
 }
@@ -41,11 +57,35 @@ func InjectWorkController() *controller.WorkController {
 	workMapper := mapper.NewWorkMapper()
 	labelRepo := repos.NewLabelRepo()
 	workValidator := validation.NewWorkValidator(workRepo, labelRepo)
-	workService := services.NewWorkService(workRepo, workMapper, workValidator)
+	outboxRepo := repos.NewOutboxRepo()
+	userRepo := repos.NewUserRepo()
+	goalRepo := repos.NewGoalRepo()
+	workService := services.NewWorkService(workRepo, workMapper, workValidator, outboxRepo, userRepo, goalRepo, labelRepo)
 	workController := controller.NewWorkController(workService)
 	return workController
 }
 
+func InjectAnalyticsController() *controller.AnalyticsController {
+	analyticsRepo := repos.NewAnalyticsRepo()
+	workRepo := repos.NewWorkRepo()
+	labelRepo := repos.NewLabelRepo()
+	labelMapper := mapper.NewLabelMapper()
+	userRepo := repos.NewUserRepo()
+	analyticsService := services.NewAnalyticsService(analyticsRepo, workRepo, labelRepo, labelMapper, userRepo)
+	analyticsController := controller.NewAnalyticsController(analyticsService)
+	return analyticsController
+}
+
+func InjectUserPreferenceController() *controller.UserPreferenceController {
+	userRepo := repos.NewUserRepo()
+	userPreferenceMapper := mapper.NewUserPreferenceMapper()
+	labelRepo := repos.NewLabelRepo()
+	userPreferenceValidator := validation.NewUserPreferenceValidator(labelRepo)
+	userPreferenceService := services.NewUserPreferenceService(userRepo, userPreferenceMapper, userPreferenceValidator)
+	userPreferenceController := controller.NewUserPreferenceController(userPreferenceService)
+	return userPreferenceController
+}
+
 // Injectors from cronjob.wire.go:
 
 func InjectWorkCronJob() *cronjob.WorkCronJob {
@@ -53,11 +93,25 @@ func InjectWorkCronJob() *cronjob.WorkCronJob {
 	workMapper := mapper.NewWorkMapper()
 	labelRepo := repos.NewLabelRepo()
 	workValidator := validation.NewWorkValidator(workRepo, labelRepo)
-	workService := services.NewWorkService(workRepo, workMapper, workValidator)
+	outboxRepo := repos.NewOutboxRepo()
+	userRepo := repos.NewUserRepo()
+	goalRepo := repos.NewGoalRepo()
+	workService := services.NewWorkService(workRepo, workMapper, workValidator, outboxRepo, userRepo, goalRepo, labelRepo)
 	workCronJob := cronjob.NewWorkCronJob(workService)
 	return workCronJob
 }
 
+// Injectors from feed.wire.go:
+
+func InjectCalendarFeedHandler() *feed.CalendarFeedHandler {
+	workRepo := repos.NewWorkRepo()
+	labelRepo := repos.NewLabelRepo()
+	userRepo := repos.NewUserRepo()
+	calendarService := services.NewCalendarService(workRepo, labelRepo, userRepo)
+	calendarFeedHandler := feed.NewCalendarFeedHandler(calendarService)
+	return calendarFeedHandler
+}
+
 // Injectors from handler.wire.go:
 
 func InjectSyncAuthHandler() *handler.SyncAuthHandler {
@@ -70,6 +124,43 @@ func InjectGenerateWorkHandler() *handler.WorkGenerationHandler {
 	workRepo := repos.NewWorkRepo()
 	labelRepo := repos.NewLabelRepo()
 	workValidator := validation.NewWorkValidator(workRepo, labelRepo)
-	workGenerationHandler := handler.NewWorkGenerationHandler(workRepo, workValidator, labelRepo)
+	userRepo := repos.NewUserRepo()
+	workGenerationHandler := handler.NewWorkGenerationHandler(workRepo, workValidator, labelRepo, userRepo)
 	return workGenerationHandler
 }
+
+// Injectors from outbox.wire.go:
+
+func InjectOutboxRelay() *outbox.Relay {
+	outboxRepo := repos.NewOutboxRepo()
+	relay := outbox.NewRelay(outboxRepo)
+	return relay
+}
+
+func InjectCalendarController() *controller.CalendarController {
+	workRepo := repos.NewWorkRepo()
+	labelRepo := repos.NewLabelRepo()
+	userRepo := repos.NewUserRepo()
+	calendarService := services.NewCalendarService(workRepo, labelRepo, userRepo)
+	workMapper := mapper.NewWorkMapper()
+	workValidator := validation.NewWorkValidator(workRepo, labelRepo)
+	outboxRepo := repos.NewOutboxRepo()
+	goalRepo := repos.NewGoalRepo()
+	workService := services.NewWorkService(workRepo, workMapper, workValidator, outboxRepo, userRepo, goalRepo, labelRepo)
+	calendarController := controller.NewCalendarController(calendarService, workService)
+	return calendarController
+}
+
+func InjectSearchController() *controller.SearchController {
+	searchRepo := repos.NewSearchRepo()
+	searchService := services.NewSearchService(searchRepo)
+	searchController := controller.NewSearchController(searchService)
+	return searchController
+}
+
+func InjectTagController() *controller.TagController {
+	tagRepo := repos.NewTagRepo()
+	tagService := services.NewTagService(tagRepo)
+	tagController := controller.NewTagController(tagService)
+	return tagController
+}
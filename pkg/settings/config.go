@@ -15,6 +15,10 @@ type Config struct {
 	Log      Log      `mapstructure:"log" json:"log" yaml:"log"`
 	RabbitMQ RabbitMQ `mapstructure:"rabbitmq" json:"rabbitmq" yaml:"rabbitmq"`
 	Mongo    Mongo    `mapstructure:"mongo" json:"mongo" yaml:"mongo"`
+	Work     Work     `mapstructure:"work" json:"work" yaml:"work"`
+	Outbox   Outbox   `mapstructure:"outbox" json:"outbox" yaml:"outbox"`
+	Feed     Feed     `mapstructure:"feed" json:"feed" yaml:"feed"`
+	Admin    Admin    `mapstructure:"admin" json:"admin" yaml:"admin"`
 }
 
 type Redis struct {
@@ -52,3 +56,24 @@ type Mongo struct {
 	Username string `mapstructure:"username" json:"username" yaml:"username"`
 	Password string `mapstructure:"password" json:"password" yaml:"password"`
 }
+
+type Work struct {
+	GiveUpGraceHours int `mapstructure:"give_up_grace_hours" json:"give_up_grace_hours" yaml:"give_up_grace_hours"` // OVER_DUE works become GIVE_UP after this
+}
+
+type Outbox struct {
+	MaxRetries         int `mapstructure:"max_retries" json:"max_retries" yaml:"max_retries"`                            // attempts before an event is dead-lettered
+	BaseBackoffSeconds int `mapstructure:"base_backoff_seconds" json:"base_backoff_seconds" yaml:"base_backoff_seconds"` // delay after the first failure, doubled on each retry
+	MaxBackoffSeconds  int `mapstructure:"max_backoff_seconds" json:"max_backoff_seconds" yaml:"max_backoff_seconds"`
+}
+
+type Feed struct {
+	Port       int    `mapstructure:"port" json:"port" yaml:"port"`                   // the HTTP feed server is not started when 0
+	PublicURL  string `mapstructure:"public_url" json:"public_url" yaml:"public_url"` // base of the subscription URLs, eg. https://schedule.example.com
+	PastDays   int    `mapstructure:"past_days" json:"past_days" yaml:"past_days"`    // works ending after today minus this are in the feed
+	FutureDays int    `mapstructure:"future_days" json:"future_days" yaml:"future_days"`
+}
+
+type Admin struct {
+	Token string `mapstructure:"token" json:"token" yaml:"token"` // shared secret of the operator tools, the label admin service is not served when empty
+}
@@ -19,4 +19,24 @@ const (
 	RepeatedWorkInvalidDates = 10015
 	InvalidGoalName          = 10016
 	InvalidWorkName          = 10017
+	InvalidRecurrenceRule    = 10018
+	GoalHasLinkedWorks       = 10019
+	InvalidTimeZone          = 10020
+	InvalidUserPreference    = 10021
+	InvalidCalendar          = 10022
+	FeedTokenExists          = 10023
+	FeedTokenNotFound        = 10024
+	InvalidCursor            = 10025
+	InvalidSearchQuery       = 10026
+	InvalidLabel             = 10027
+	LabelNameExists          = 10028
+	LabelInUse               = 10029
+	CustomLabelLimitExceeded = 10030
+	InvalidTag               = 10031
+	LabelKeyExists           = 10032
+	ProtectedLabel           = 10033
+	EmptyWorkPatch           = 10034
+	InvalidWorkingHours      = 10035
+	RangeTooLong             = 10036
+	TooManyWorks             = 10037
 )
@@ -22,14 +22,20 @@ const (
 )
 
 type Label struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key"`
-	Meaning       string                 `protobuf:"bytes,4,opt,name=meaning,proto3" json:"meaning"`
-	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note"`
-	Color         string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color"`
-	LabelType     int32                  `protobuf:"varint,7,opt,name=label_type,json=labelType,proto3" json:"label_type"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Key       string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key"`
+	Meaning   string                 `protobuf:"bytes,4,opt,name=meaning,proto3" json:"meaning"`
+	Note      string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note"`
+	Color     string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color"`
+	LabelType int32                  `protobuf:"varint,7,opt,name=label_type,json=labelType,proto3" json:"label_type"`
+	// created by the user, the system labels are shared by every user
+	IsCustom bool `protobuf:"varint,8,opt,name=is_custom,json=isCustom,proto3" json:"is_custom"`
+	// position among the labels of the same type, system labels first
+	Order int32 `protobuf:"varint,9,opt,name=order,proto3" json:"order"`
+	// system label hidden from the users, its works and goals were moved to a replacement
+	IsDeprecated  bool `protobuf:"varint,10,opt,name=is_deprecated,json=isDeprecated,proto3" json:"is_deprecated"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -113,6 +119,27 @@ func (x *Label) GetLabelType() int32 {
 	return 0
 }
 
+func (x *Label) GetIsCustom() bool {
+	if x != nil {
+		return x.IsCustom
+	}
+	return false
+}
+
+func (x *Label) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *Label) GetIsDeprecated() bool {
+	if x != nil {
+		return x.IsDeprecated
+	}
+	return false
+}
+
 type LabelPerType struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type"`
@@ -312,6 +339,8 @@ type Goal struct {
 	GoalLabels          *GoalLabels            `protobuf:"bytes,7,opt,name=goalLabels,proto3" json:"goalLabels"`
 	Category            *LabelInfo             `protobuf:"bytes,8,opt,name=category,proto3" json:"category"`
 	Overdue             *LabelInfo             `protobuf:"bytes,9,opt,name=overdue,proto3,oneof" json:"overdue"`
+	Progress            *GoalProgress          `protobuf:"bytes,10,opt,name=progress,proto3" json:"progress"`
+	Tags                []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -409,6 +438,123 @@ func (x *Goal) GetOverdue() *LabelInfo {
 	return nil
 }
 
+func (x *Goal) GetProgress() *GoalProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *Goal) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// GoalProgress is computed from the goal tasks and the works linked to the goal, drafts excluded.
+type GoalProgress struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TotalTasks          int32                  `protobuf:"varint,1,opt,name=total_tasks,json=totalTasks,proto3" json:"total_tasks"`
+	CompletedTasks      int32                  `protobuf:"varint,2,opt,name=completed_tasks,json=completedTasks,proto3" json:"completed_tasks"`
+	TotalWorks          int32                  `protobuf:"varint,3,opt,name=total_works,json=totalWorks,proto3" json:"total_works"`
+	CompletedWorks      int32                  `protobuf:"varint,4,opt,name=completed_works,json=completedWorks,proto3" json:"completed_works"`
+	TotalWorkTimeMs     int64                  `protobuf:"varint,5,opt,name=total_work_time_ms,json=totalWorkTimeMs,proto3" json:"total_work_time_ms"`
+	CompletedWorkTimeMs int64                  `protobuf:"varint,6,opt,name=completed_work_time_ms,json=completedWorkTimeMs,proto3" json:"completed_work_time_ms"`
+	// completed tasks and works over all tasks and works, from 0 to 100
+	CompletionPercentage float64 `protobuf:"fixed64,7,opt,name=completion_percentage,json=completionPercentage,proto3" json:"completion_percentage"`
+	// time left until end_date, 0 once it is passed, unset without end_date
+	TimeRemainingMs *int64 `protobuf:"varint,8,opt,name=time_remaining_ms,json=timeRemainingMs,proto3,oneof" json:"time_remaining_ms"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GoalProgress) Reset() {
+	*x = GoalProgress{}
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoalProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalProgress) ProtoMessage() {}
+
+func (x *GoalProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalProgress.ProtoReflect.Descriptor instead.
+func (*GoalProgress) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GoalProgress) GetTotalTasks() int32 {
+	if x != nil {
+		return x.TotalTasks
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetCompletedTasks() int32 {
+	if x != nil {
+		return x.CompletedTasks
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetTotalWorks() int32 {
+	if x != nil {
+		return x.TotalWorks
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetCompletedWorks() int32 {
+	if x != nil {
+		return x.CompletedWorks
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetTotalWorkTimeMs() int64 {
+	if x != nil {
+		return x.TotalWorkTimeMs
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetCompletedWorkTimeMs() int64 {
+	if x != nil {
+		return x.CompletedWorkTimeMs
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetCompletionPercentage() float64 {
+	if x != nil {
+		return x.CompletionPercentage
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetTimeRemainingMs() int64 {
+	if x != nil && x.TimeRemainingMs != nil {
+		return *x.TimeRemainingMs
+	}
+	return 0
+}
+
 type GoalTaskPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id"`
@@ -420,7 +566,7 @@ type GoalTaskPayload struct {
 
 func (x *GoalTaskPayload) Reset() {
 	*x = GoalTaskPayload{}
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[5]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -432,7 +578,7 @@ func (x *GoalTaskPayload) String() string {
 func (*GoalTaskPayload) ProtoMessage() {}
 
 func (x *GoalTaskPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[5]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +591,7 @@ func (x *GoalTaskPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoalTaskPayload.ProtoReflect.Descriptor instead.
 func (*GoalTaskPayload) Descriptor() ([]byte, []int) {
-	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{5}
+	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GoalTaskPayload) GetId() string {
@@ -481,7 +627,7 @@ type GoalLabel struct {
 
 func (x *GoalLabel) Reset() {
 	*x = GoalLabel{}
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[6]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -493,7 +639,7 @@ func (x *GoalLabel) String() string {
 func (*GoalLabel) ProtoMessage() {}
 
 func (x *GoalLabel) ProtoReflect() protoreflect.Message {
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[6]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,7 +652,7 @@ func (x *GoalLabel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoalLabel.ProtoReflect.Descriptor instead.
 func (*GoalLabel) Descriptor() ([]byte, []int) {
-	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{6}
+	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GoalLabel) GetStatus() *LabelInfo {
@@ -547,13 +693,15 @@ type GoalDetail struct {
 	EndDate             int64                  `protobuf:"varint,6,opt,name=end_date,json=endDate,proto3" json:"end_date"`
 	GoalLabels          *GoalLabel             `protobuf:"bytes,7,opt,name=goalLabels,proto3" json:"goalLabels"`
 	Tasks               []*GoalTaskPayload     `protobuf:"bytes,8,rep,name=tasks,proto3" json:"tasks"`
+	Progress            *GoalProgress          `protobuf:"bytes,9,opt,name=progress,proto3" json:"progress"`
+	Tags                []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GoalDetail) Reset() {
 	*x = GoalDetail{}
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[7]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -565,7 +713,7 @@ func (x *GoalDetail) String() string {
 func (*GoalDetail) ProtoMessage() {}
 
 func (x *GoalDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[7]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,7 +726,7 @@ func (x *GoalDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoalDetail.ProtoReflect.Descriptor instead.
 func (*GoalDetail) Descriptor() ([]byte, []int) {
-	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{7}
+	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GoalDetail) GetId() string {
@@ -637,6 +785,20 @@ func (x *GoalDetail) GetTasks() []*GoalTaskPayload {
 	return nil
 }
 
+func (x *GoalDetail) GetProgress() *GoalProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *GoalDetail) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type SubTaskPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id"`
@@ -648,7 +810,7 @@ type SubTaskPayload struct {
 
 func (x *SubTaskPayload) Reset() {
 	*x = SubTaskPayload{}
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[8]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -660,7 +822,7 @@ func (x *SubTaskPayload) String() string {
 func (*SubTaskPayload) ProtoMessage() {}
 
 func (x *SubTaskPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[8]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,7 +835,7 @@ func (x *SubTaskPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubTaskPayload.ProtoReflect.Descriptor instead.
 func (*SubTaskPayload) Descriptor() ([]byte, []int) {
-	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{8}
+	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SubTaskPayload) GetId() string {
@@ -710,7 +872,7 @@ type WorkLabelGroup struct {
 
 func (x *WorkLabelGroup) Reset() {
 	*x = WorkLabelGroup{}
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[9]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -722,7 +884,7 @@ func (x *WorkLabelGroup) String() string {
 func (*WorkLabelGroup) ProtoMessage() {}
 
 func (x *WorkLabelGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[9]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,7 +897,7 @@ func (x *WorkLabelGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkLabelGroup.ProtoReflect.Descriptor instead.
 func (*WorkLabelGroup) Descriptor() ([]byte, []int) {
-	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{9}
+	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *WorkLabelGroup) GetStatus() *LabelInfo {
@@ -783,7 +945,7 @@ type GoalOfWork struct {
 
 func (x *GoalOfWork) Reset() {
 	*x = GoalOfWork{}
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[10]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -795,7 +957,7 @@ func (x *GoalOfWork) String() string {
 func (*GoalOfWork) ProtoMessage() {}
 
 func (x *GoalOfWork) ProtoReflect() protoreflect.Message {
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[10]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +970,7 @@ func (x *GoalOfWork) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoalOfWork.ProtoReflect.Descriptor instead.
 func (*GoalOfWork) Descriptor() ([]byte, []int) {
-	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{10}
+	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GoalOfWork) GetId() string {
@@ -837,13 +999,14 @@ type Work struct {
 	Labels              *WorkLabelGroup        `protobuf:"bytes,8,opt,name=labels,proto3" json:"labels"`
 	Category            *LabelInfo             `protobuf:"bytes,9,opt,name=category,proto3" json:"category"`
 	Overdue             *LabelInfo             `protobuf:"bytes,10,opt,name=overdue,proto3,oneof" json:"overdue"`
+	Tags                []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Work) Reset() {
 	*x = Work{}
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[11]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -855,7 +1018,7 @@ func (x *Work) String() string {
 func (*Work) ProtoMessage() {}
 
 func (x *Work) ProtoReflect() protoreflect.Message {
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[11]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -868,7 +1031,7 @@ func (x *Work) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Work.ProtoReflect.Descriptor instead.
 func (*Work) Descriptor() ([]byte, []int) {
-	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{11}
+	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Work) GetId() string {
@@ -941,6 +1104,13 @@ func (x *Work) GetOverdue() *LabelInfo {
 	return nil
 }
 
+func (x *Work) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type WorkLabelGroupDetail struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *LabelInfo             `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
@@ -954,7 +1124,7 @@ type WorkLabelGroupDetail struct {
 
 func (x *WorkLabelGroupDetail) Reset() {
 	*x = WorkLabelGroupDetail{}
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[12]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -966,7 +1136,7 @@ func (x *WorkLabelGroupDetail) String() string {
 func (*WorkLabelGroupDetail) ProtoMessage() {}
 
 func (x *WorkLabelGroupDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[12]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -979,7 +1149,7 @@ func (x *WorkLabelGroupDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkLabelGroupDetail.ProtoReflect.Descriptor instead.
 func (*WorkLabelGroupDetail) Descriptor() ([]byte, []int) {
-	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{12}
+	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *WorkLabelGroupDetail) GetStatus() *LabelInfo {
@@ -1031,13 +1201,15 @@ type WorkDetail struct {
 	Draft                 *LabelInfo             `protobuf:"bytes,10,opt,name=draft,proto3,oneof" json:"draft"`
 	RepeatSeriesStartDate *int64                 `protobuf:"varint,11,opt,name=repeat_series_startDate,json=repeatSeriesStartDate,proto3,oneof" json:"repeat_series_startDate"`
 	RepeatSeriesEndDate   *int64                 `protobuf:"varint,12,opt,name=repeat_series_endDate,json=repeatSeriesEndDate,proto3,oneof" json:"repeat_series_endDate"`
+	Recurrence            *RecurrenceRule        `protobuf:"bytes,13,opt,name=recurrence,proto3,oneof" json:"recurrence"`
+	Tags                  []string               `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *WorkDetail) Reset() {
 	*x = WorkDetail{}
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[13]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1049,7 +1221,7 @@ func (x *WorkDetail) String() string {
 func (*WorkDetail) ProtoMessage() {}
 
 func (x *WorkDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[13]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,7 +1234,7 @@ func (x *WorkDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkDetail.ProtoReflect.Descriptor instead.
 func (*WorkDetail) Descriptor() ([]byte, []int) {
-	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{13}
+	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *WorkDetail) GetId() string {
@@ -1149,6 +1321,80 @@ func (x *WorkDetail) GetRepeatSeriesEndDate() int64 {
 	return 0
 }
 
+func (x *WorkDetail) GetRecurrence() *RecurrenceRule {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *WorkDetail) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type RecurrenceRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rrule         string                 `protobuf:"bytes,1,opt,name=rrule,proto3" json:"rrule"`
+	Exdates       []int64                `protobuf:"varint,2,rep,packed,name=exdates,proto3" json:"exdates"`
+	TimeZone      *string                `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurrenceRule) Reset() {
+	*x = RecurrenceRule{}
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurrenceRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurrenceRule) ProtoMessage() {}
+
+func (x *RecurrenceRule) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurrenceRule.ProtoReflect.Descriptor instead.
+func (*RecurrenceRule) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RecurrenceRule) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *RecurrenceRule) GetExdates() []int64 {
+	if x != nil {
+		return x.Exdates
+	}
+	return nil
+}
+
+func (x *RecurrenceRule) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
 type WorkNotification struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id"`
@@ -1163,7 +1409,7 @@ type WorkNotification struct {
 
 func (x *WorkNotification) Reset() {
 	*x = WorkNotification{}
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[14]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1175,7 +1421,7 @@ func (x *WorkNotification) String() string {
 func (*WorkNotification) ProtoMessage() {}
 
 func (x *WorkNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[14]
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,7 +1434,7 @@ func (x *WorkNotification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkNotification.ProtoReflect.Descriptor instead.
 func (*WorkNotification) Descriptor() ([]byte, []int) {
-	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{14}
+	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *WorkNotification) GetId() string {
@@ -1233,11 +1479,72 @@ func (x *WorkNotification) GetImgUrl() string {
 	return ""
 }
 
+type WorkingHours struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 is sunday, 6 is saturday
+	Weekday       int32 `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday"`
+	StartMinute   int32 `protobuf:"varint,2,opt,name=start_minute,json=startMinute,proto3" json:"start_minute"`
+	EndMinute     int32 `protobuf:"varint,3,opt,name=end_minute,json=endMinute,proto3" json:"end_minute"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkingHours) Reset() {
+	*x = WorkingHours{}
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkingHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingHours) ProtoMessage() {}
+
+func (x *WorkingHours) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_common_schedule_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingHours.ProtoReflect.Descriptor instead.
+func (*WorkingHours) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_common_schedule_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WorkingHours) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *WorkingHours) GetStartMinute() int32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *WorkingHours) GetEndMinute() int32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
 var File_personal_schedule_service_common_schedule_proto protoreflect.FileDescriptor
 
 const file_personal_schedule_service_common_schedule_proto_rawDesc = "" +
 	"\n" +
-	"/personal_schedule_service/common.schedule.proto\x12\x11personal_schedule\"\xa0\x01\n" +
+	"/personal_schedule_service/common.schedule.proto\x12\x11personal_schedule\"\xf8\x01\n" +
 	"\x05Label\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
@@ -1246,7 +1553,11 @@ const file_personal_schedule_service_common_schedule_proto_rawDesc = "" +
 	"\x04note\x18\x05 \x01(\tR\x04note\x12\x14\n" +
 	"\x05color\x18\x06 \x01(\tR\x05color\x12\x1d\n" +
 	"\n" +
-	"label_type\x18\a \x01(\x05R\tlabelType\"T\n" +
+	"label_type\x18\a \x01(\x05R\tlabelType\x12\x1b\n" +
+	"\tis_custom\x18\b \x01(\bR\bisCustom\x12\x14\n" +
+	"\x05order\x18\t \x01(\x05R\x05order\x12#\n" +
+	"\ris_deprecated\x18\n" +
+	" \x01(\bR\fisDeprecated\"T\n" +
 	"\fLabelPerType\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\x05R\x04type\x120\n" +
 	"\x06labels\x18\x02 \x03(\v2\x18.personal_schedule.LabelR\x06labels\"v\n" +
@@ -1263,7 +1574,7 @@ const file_personal_schedule_service_common_schedule_proto_rawDesc = "" +
 	"\n" +
 	"difficulty\x18\x02 \x01(\v2\x1c.personal_schedule.LabelInfoR\n" +
 	"difficulty\x128\n" +
-	"\bpriority\x18\x03 \x01(\v2\x1c.personal_schedule.LabelInfoR\bpriority\"\xc2\x03\n" +
+	"\bpriority\x18\x03 \x01(\v2\x1c.personal_schedule.LabelInfoR\bpriority\"\x93\x04\n" +
 	"\x04Goal\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x122\n" +
@@ -1276,11 +1587,26 @@ const file_personal_schedule_service_common_schedule_proto_rawDesc = "" +
 	"goalLabels\x18\a \x01(\v2\x1d.personal_schedule.GoalLabelsR\n" +
 	"goalLabels\x128\n" +
 	"\bcategory\x18\b \x01(\v2\x1c.personal_schedule.LabelInfoR\bcategory\x12;\n" +
-	"\aoverdue\x18\t \x01(\v2\x1c.personal_schedule.LabelInfoH\x02R\aoverdue\x88\x01\x01B\x15\n" +
+	"\aoverdue\x18\t \x01(\v2\x1c.personal_schedule.LabelInfoH\x02R\aoverdue\x88\x01\x01\x12;\n" +
+	"\bprogress\x18\n" +
+	" \x01(\v2\x1f.personal_schedule.GoalProgressR\bprogress\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tagsB\x15\n" +
 	"\x13_short_descriptionsB\x17\n" +
 	"\x15_detailed_descriptionB\n" +
 	"\n" +
-	"\b_overdue\"d\n" +
+	"\b_overdue\"\x80\x03\n" +
+	"\fGoalProgress\x12\x1f\n" +
+	"\vtotal_tasks\x18\x01 \x01(\x05R\n" +
+	"totalTasks\x12'\n" +
+	"\x0fcompleted_tasks\x18\x02 \x01(\x05R\x0ecompletedTasks\x12\x1f\n" +
+	"\vtotal_works\x18\x03 \x01(\x05R\n" +
+	"totalWorks\x12'\n" +
+	"\x0fcompleted_works\x18\x04 \x01(\x05R\x0ecompletedWorks\x12+\n" +
+	"\x12total_work_time_ms\x18\x05 \x01(\x03R\x0ftotalWorkTimeMs\x123\n" +
+	"\x16completed_work_time_ms\x18\x06 \x01(\x03R\x13completedWorkTimeMs\x123\n" +
+	"\x15completion_percentage\x18\a \x01(\x01R\x14completionPercentage\x12/\n" +
+	"\x11time_remaining_ms\x18\b \x01(\x03H\x00R\x0ftimeRemainingMs\x88\x01\x01B\x14\n" +
+	"\x12_time_remaining_ms\"d\n" +
 	"\x0fGoalTaskPayload\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
@@ -1292,7 +1618,7 @@ const file_personal_schedule_service_common_schedule_proto_rawDesc = "" +
 	"difficulty\x18\x02 \x01(\v2\x1c.personal_schedule.LabelInfoR\n" +
 	"difficulty\x128\n" +
 	"\bpriority\x18\x03 \x01(\v2\x1c.personal_schedule.LabelInfoR\bpriority\x128\n" +
-	"\bcategory\x18\x04 \x01(\v2\x1c.personal_schedule.LabelInfoR\bcategory\"\xc4\x02\n" +
+	"\bcategory\x18\x04 \x01(\v2\x1c.personal_schedule.LabelInfoR\bcategory\"\x95\x03\n" +
 	"\n" +
 	"GoalDetail\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -1305,7 +1631,10 @@ const file_personal_schedule_service_common_schedule_proto_rawDesc = "" +
 	"\n" +
 	"goalLabels\x18\a \x01(\v2\x1c.personal_schedule.GoalLabelR\n" +
 	"goalLabels\x128\n" +
-	"\x05tasks\x18\b \x03(\v2\".personal_schedule.GoalTaskPayloadR\x05tasks\"c\n" +
+	"\x05tasks\x18\b \x03(\v2\".personal_schedule.GoalTaskPayloadR\x05tasks\x12;\n" +
+	"\bprogress\x18\t \x01(\v2\x1f.personal_schedule.GoalProgressR\bprogress\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\"c\n" +
 	"\x0eSubTaskPayload\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
@@ -1322,7 +1651,7 @@ const file_personal_schedule_service_common_schedule_proto_rawDesc = "" +
 	"\n" +
 	"GoalOfWork\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\xf1\x03\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x85\x04\n" +
 	"\x04Work\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x122\n" +
@@ -1335,7 +1664,8 @@ const file_personal_schedule_service_common_schedule_proto_rawDesc = "" +
 	"\x06labels\x18\b \x01(\v2!.personal_schedule.WorkLabelGroupR\x06labels\x128\n" +
 	"\bcategory\x18\t \x01(\v2\x1c.personal_schedule.LabelInfoR\bcategory\x12;\n" +
 	"\aoverdue\x18\n" +
-	" \x01(\v2\x1c.personal_schedule.LabelInfoH\x02R\aoverdue\x88\x01\x01B\x15\n" +
+	" \x01(\v2\x1c.personal_schedule.LabelInfoH\x02R\aoverdue\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tagsB\x15\n" +
 	"\x13_short_descriptionsB\x17\n" +
 	"\x15_detailed_descriptionB\n" +
 	"\n" +
@@ -1347,7 +1677,7 @@ const file_personal_schedule_service_common_schedule_proto_rawDesc = "" +
 	"difficulty\x128\n" +
 	"\bpriority\x18\x03 \x01(\v2\x1c.personal_schedule.LabelInfoR\bpriority\x120\n" +
 	"\x04type\x18\x04 \x01(\v2\x1c.personal_schedule.LabelInfoR\x04type\x128\n" +
-	"\bcategory\x18\x05 \x01(\v2\x1c.personal_schedule.LabelInfoR\bcategory\"\xa9\x05\n" +
+	"\bcategory\x18\x05 \x01(\v2\x1c.personal_schedule.LabelInfoR\bcategory\"\x94\x06\n" +
 	"\n" +
 	"WorkDetail\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -1363,12 +1693,23 @@ const file_personal_schedule_service_common_schedule_proto_rawDesc = "" +
 	"\x05draft\x18\n" +
 	" \x01(\v2\x1c.personal_schedule.LabelInfoH\x02R\x05draft\x88\x01\x01\x12;\n" +
 	"\x17repeat_series_startDate\x18\v \x01(\x03H\x03R\x15repeatSeriesStartDate\x88\x01\x01\x127\n" +
-	"\x15repeat_series_endDate\x18\f \x01(\x03H\x04R\x13repeatSeriesEndDate\x88\x01\x01B\x15\n" +
+	"\x15repeat_series_endDate\x18\f \x01(\x03H\x04R\x13repeatSeriesEndDate\x88\x01\x01\x12F\n" +
+	"\n" +
+	"recurrence\x18\r \x01(\v2!.personal_schedule.RecurrenceRuleH\x05R\n" +
+	"recurrence\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x0e \x03(\tR\x04tagsB\x15\n" +
 	"\x13_short_descriptionsB\x17\n" +
 	"\x15_detailed_descriptionB\b\n" +
 	"\x06_draftB\x1a\n" +
 	"\x18_repeat_series_startDateB\x18\n" +
-	"\x16_repeat_series_endDate\"\xd8\x01\n" +
+	"\x16_repeat_series_endDateB\r\n" +
+	"\v_recurrence\"p\n" +
+	"\x0eRecurrenceRule\x12\x14\n" +
+	"\x05rrule\x18\x01 \x01(\tR\x05rrule\x12\x18\n" +
+	"\aexdates\x18\x02 \x03(\x03R\aexdates\x12 \n" +
+	"\ttime_zone\x18\x03 \x01(\tH\x00R\btimeZone\x88\x01\x01B\f\n" +
+	"\n" +
+	"_time_zone\"\xd8\x01\n" +
 	"\x10WorkNotification\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x1d\n" +
 	"\n" +
@@ -1381,7 +1722,12 @@ const file_personal_schedule_service_common_schedule_proto_rawDesc = "" +
 	"\x03_idB\a\n" +
 	"\x05_linkB\n" +
 	"\n" +
-	"\b_img_urlB\x19Z\x17proto/personal_scheduleb\x06proto3"
+	"\b_img_url\"j\n" +
+	"\fWorkingHours\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12!\n" +
+	"\fstart_minute\x18\x02 \x01(\x05R\vstartMinute\x12\x1d\n" +
+	"\n" +
+	"end_minute\x18\x03 \x01(\x05R\tendMinuteB\x19Z\x17proto/personal_scheduleb\x06proto3"
 
 var (
 	file_personal_schedule_service_common_schedule_proto_rawDescOnce sync.Once
@@ -1395,23 +1741,26 @@ func file_personal_schedule_service_common_schedule_proto_rawDescGZIP() []byte {
 	return file_personal_schedule_service_common_schedule_proto_rawDescData
 }
 
-var file_personal_schedule_service_common_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_personal_schedule_service_common_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_personal_schedule_service_common_schedule_proto_goTypes = []any{
 	(*Label)(nil),                // 0: personal_schedule.Label
 	(*LabelPerType)(nil),         // 1: personal_schedule.LabelPerType
 	(*LabelInfo)(nil),            // 2: personal_schedule.LabelInfo
 	(*GoalLabels)(nil),           // 3: personal_schedule.GoalLabels
 	(*Goal)(nil),                 // 4: personal_schedule.Goal
-	(*GoalTaskPayload)(nil),      // 5: personal_schedule.GoalTaskPayload
-	(*GoalLabel)(nil),            // 6: personal_schedule.GoalLabel
-	(*GoalDetail)(nil),           // 7: personal_schedule.GoalDetail
-	(*SubTaskPayload)(nil),       // 8: personal_schedule.SubTaskPayload
-	(*WorkLabelGroup)(nil),       // 9: personal_schedule.WorkLabelGroup
-	(*GoalOfWork)(nil),           // 10: personal_schedule.GoalOfWork
-	(*Work)(nil),                 // 11: personal_schedule.Work
-	(*WorkLabelGroupDetail)(nil), // 12: personal_schedule.WorkLabelGroupDetail
-	(*WorkDetail)(nil),           // 13: personal_schedule.WorkDetail
-	(*WorkNotification)(nil),     // 14: personal_schedule.WorkNotification
+	(*GoalProgress)(nil),         // 5: personal_schedule.GoalProgress
+	(*GoalTaskPayload)(nil),      // 6: personal_schedule.GoalTaskPayload
+	(*GoalLabel)(nil),            // 7: personal_schedule.GoalLabel
+	(*GoalDetail)(nil),           // 8: personal_schedule.GoalDetail
+	(*SubTaskPayload)(nil),       // 9: personal_schedule.SubTaskPayload
+	(*WorkLabelGroup)(nil),       // 10: personal_schedule.WorkLabelGroup
+	(*GoalOfWork)(nil),           // 11: personal_schedule.GoalOfWork
+	(*Work)(nil),                 // 12: personal_schedule.Work
+	(*WorkLabelGroupDetail)(nil), // 13: personal_schedule.WorkLabelGroupDetail
+	(*WorkDetail)(nil),           // 14: personal_schedule.WorkDetail
+	(*RecurrenceRule)(nil),       // 15: personal_schedule.RecurrenceRule
+	(*WorkNotification)(nil),     // 16: personal_schedule.WorkNotification
+	(*WorkingHours)(nil),         // 17: personal_schedule.WorkingHours
 }
 var file_personal_schedule_service_common_schedule_proto_depIdxs = []int32{
 	0,  // 0: personal_schedule.LabelPerType.labels:type_name -> personal_schedule.Label
@@ -1421,35 +1770,38 @@ var file_personal_schedule_service_common_schedule_proto_depIdxs = []int32{
 	3,  // 4: personal_schedule.Goal.goalLabels:type_name -> personal_schedule.GoalLabels
 	2,  // 5: personal_schedule.Goal.category:type_name -> personal_schedule.LabelInfo
 	2,  // 6: personal_schedule.Goal.overdue:type_name -> personal_schedule.LabelInfo
-	2,  // 7: personal_schedule.GoalLabel.status:type_name -> personal_schedule.LabelInfo
-	2,  // 8: personal_schedule.GoalLabel.difficulty:type_name -> personal_schedule.LabelInfo
-	2,  // 9: personal_schedule.GoalLabel.priority:type_name -> personal_schedule.LabelInfo
-	2,  // 10: personal_schedule.GoalLabel.category:type_name -> personal_schedule.LabelInfo
-	6,  // 11: personal_schedule.GoalDetail.goalLabels:type_name -> personal_schedule.GoalLabel
-	5,  // 12: personal_schedule.GoalDetail.tasks:type_name -> personal_schedule.GoalTaskPayload
-	2,  // 13: personal_schedule.WorkLabelGroup.status:type_name -> personal_schedule.LabelInfo
-	2,  // 14: personal_schedule.WorkLabelGroup.difficulty:type_name -> personal_schedule.LabelInfo
-	2,  // 15: personal_schedule.WorkLabelGroup.priority:type_name -> personal_schedule.LabelInfo
-	2,  // 16: personal_schedule.WorkLabelGroup.type:type_name -> personal_schedule.LabelInfo
-	2,  // 17: personal_schedule.WorkLabelGroup.draft:type_name -> personal_schedule.LabelInfo
-	10, // 18: personal_schedule.Work.goal:type_name -> personal_schedule.GoalOfWork
-	9,  // 19: personal_schedule.Work.labels:type_name -> personal_schedule.WorkLabelGroup
-	2,  // 20: personal_schedule.Work.category:type_name -> personal_schedule.LabelInfo
-	2,  // 21: personal_schedule.Work.overdue:type_name -> personal_schedule.LabelInfo
-	2,  // 22: personal_schedule.WorkLabelGroupDetail.status:type_name -> personal_schedule.LabelInfo
-	2,  // 23: personal_schedule.WorkLabelGroupDetail.difficulty:type_name -> personal_schedule.LabelInfo
-	2,  // 24: personal_schedule.WorkLabelGroupDetail.priority:type_name -> personal_schedule.LabelInfo
-	2,  // 25: personal_schedule.WorkLabelGroupDetail.type:type_name -> personal_schedule.LabelInfo
-	2,  // 26: personal_schedule.WorkLabelGroupDetail.category:type_name -> personal_schedule.LabelInfo
-	10, // 27: personal_schedule.WorkDetail.goal:type_name -> personal_schedule.GoalOfWork
-	12, // 28: personal_schedule.WorkDetail.labels:type_name -> personal_schedule.WorkLabelGroupDetail
-	8,  // 29: personal_schedule.WorkDetail.sub_tasks:type_name -> personal_schedule.SubTaskPayload
-	2,  // 30: personal_schedule.WorkDetail.draft:type_name -> personal_schedule.LabelInfo
-	31, // [31:31] is the sub-list for method output_type
-	31, // [31:31] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	5,  // 7: personal_schedule.Goal.progress:type_name -> personal_schedule.GoalProgress
+	2,  // 8: personal_schedule.GoalLabel.status:type_name -> personal_schedule.LabelInfo
+	2,  // 9: personal_schedule.GoalLabel.difficulty:type_name -> personal_schedule.LabelInfo
+	2,  // 10: personal_schedule.GoalLabel.priority:type_name -> personal_schedule.LabelInfo
+	2,  // 11: personal_schedule.GoalLabel.category:type_name -> personal_schedule.LabelInfo
+	7,  // 12: personal_schedule.GoalDetail.goalLabels:type_name -> personal_schedule.GoalLabel
+	6,  // 13: personal_schedule.GoalDetail.tasks:type_name -> personal_schedule.GoalTaskPayload
+	5,  // 14: personal_schedule.GoalDetail.progress:type_name -> personal_schedule.GoalProgress
+	2,  // 15: personal_schedule.WorkLabelGroup.status:type_name -> personal_schedule.LabelInfo
+	2,  // 16: personal_schedule.WorkLabelGroup.difficulty:type_name -> personal_schedule.LabelInfo
+	2,  // 17: personal_schedule.WorkLabelGroup.priority:type_name -> personal_schedule.LabelInfo
+	2,  // 18: personal_schedule.WorkLabelGroup.type:type_name -> personal_schedule.LabelInfo
+	2,  // 19: personal_schedule.WorkLabelGroup.draft:type_name -> personal_schedule.LabelInfo
+	11, // 20: personal_schedule.Work.goal:type_name -> personal_schedule.GoalOfWork
+	10, // 21: personal_schedule.Work.labels:type_name -> personal_schedule.WorkLabelGroup
+	2,  // 22: personal_schedule.Work.category:type_name -> personal_schedule.LabelInfo
+	2,  // 23: personal_schedule.Work.overdue:type_name -> personal_schedule.LabelInfo
+	2,  // 24: personal_schedule.WorkLabelGroupDetail.status:type_name -> personal_schedule.LabelInfo
+	2,  // 25: personal_schedule.WorkLabelGroupDetail.difficulty:type_name -> personal_schedule.LabelInfo
+	2,  // 26: personal_schedule.WorkLabelGroupDetail.priority:type_name -> personal_schedule.LabelInfo
+	2,  // 27: personal_schedule.WorkLabelGroupDetail.type:type_name -> personal_schedule.LabelInfo
+	2,  // 28: personal_schedule.WorkLabelGroupDetail.category:type_name -> personal_schedule.LabelInfo
+	11, // 29: personal_schedule.WorkDetail.goal:type_name -> personal_schedule.GoalOfWork
+	13, // 30: personal_schedule.WorkDetail.labels:type_name -> personal_schedule.WorkLabelGroupDetail
+	9,  // 31: personal_schedule.WorkDetail.sub_tasks:type_name -> personal_schedule.SubTaskPayload
+	2,  // 32: personal_schedule.WorkDetail.draft:type_name -> personal_schedule.LabelInfo
+	15, // 33: personal_schedule.WorkDetail.recurrence:type_name -> personal_schedule.RecurrenceRule
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_personal_schedule_service_common_schedule_proto_init() }
@@ -1459,17 +1811,19 @@ func file_personal_schedule_service_common_schedule_proto_init() {
 	}
 	file_personal_schedule_service_common_schedule_proto_msgTypes[4].OneofWrappers = []any{}
 	file_personal_schedule_service_common_schedule_proto_msgTypes[5].OneofWrappers = []any{}
-	file_personal_schedule_service_common_schedule_proto_msgTypes[8].OneofWrappers = []any{}
-	file_personal_schedule_service_common_schedule_proto_msgTypes[11].OneofWrappers = []any{}
-	file_personal_schedule_service_common_schedule_proto_msgTypes[13].OneofWrappers = []any{}
+	file_personal_schedule_service_common_schedule_proto_msgTypes[6].OneofWrappers = []any{}
+	file_personal_schedule_service_common_schedule_proto_msgTypes[9].OneofWrappers = []any{}
+	file_personal_schedule_service_common_schedule_proto_msgTypes[12].OneofWrappers = []any{}
 	file_personal_schedule_service_common_schedule_proto_msgTypes[14].OneofWrappers = []any{}
+	file_personal_schedule_service_common_schedule_proto_msgTypes[15].OneofWrappers = []any{}
+	file_personal_schedule_service_common_schedule_proto_msgTypes[16].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_personal_schedule_service_common_schedule_proto_rawDesc), len(file_personal_schedule_service_common_schedule_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
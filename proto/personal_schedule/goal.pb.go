@@ -22,12 +22,69 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// GoalDeleteMode tells what happens to the works linked to the deleted goal.
+type GoalDeleteMode int32
+
+const (
+	// the works are kept and unlinked from the goal
+	GoalDeleteMode_GOAL_DELETE_MODE_DETACH GoalDeleteMode = 0
+	// the works are deleted with their subtasks
+	GoalDeleteMode_GOAL_DELETE_MODE_CASCADE GoalDeleteMode = 1
+	// the goal is not deleted while works are linked to it
+	GoalDeleteMode_GOAL_DELETE_MODE_REFUSE GoalDeleteMode = 2
+)
+
+// Enum value maps for GoalDeleteMode.
+var (
+	GoalDeleteMode_name = map[int32]string{
+		0: "GOAL_DELETE_MODE_DETACH",
+		1: "GOAL_DELETE_MODE_CASCADE",
+		2: "GOAL_DELETE_MODE_REFUSE",
+	}
+	GoalDeleteMode_value = map[string]int32{
+		"GOAL_DELETE_MODE_DETACH":  0,
+		"GOAL_DELETE_MODE_CASCADE": 1,
+		"GOAL_DELETE_MODE_REFUSE":  2,
+	}
+)
+
+func (x GoalDeleteMode) Enum() *GoalDeleteMode {
+	p := new(GoalDeleteMode)
+	*p = x
+	return p
+}
+
+func (x GoalDeleteMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GoalDeleteMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_personal_schedule_service_goal_proto_enumTypes[0].Descriptor()
+}
+
+func (GoalDeleteMode) Type() protoreflect.EnumType {
+	return &file_personal_schedule_service_goal_proto_enumTypes[0]
+}
+
+func (x GoalDeleteMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GoalDeleteMode.Descriptor instead.
+func (GoalDeleteMode) EnumDescriptor() ([]byte, []int) {
+	return file_personal_schedule_service_goal_proto_rawDescGZIP(), []int{0}
+}
+
 type GetGoalsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
-	Search        *string                `protobuf:"bytes,2,opt,name=search,proto3,oneof" json:"search"`
-	StatusId      *string                `protobuf:"bytes,3,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id"`
-	PageQuery     *common.PageQuery      `protobuf:"bytes,4,opt,name=page_query,json=pageQuery,proto3" json:"page_query"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Search    *string                `protobuf:"bytes,2,opt,name=search,proto3,oneof" json:"search"`
+	StatusId  *string                `protobuf:"bytes,3,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id"`
+	PageQuery *common.PageQuery      `protobuf:"bytes,4,opt,name=page_query,json=pageQuery,proto3" json:"page_query"`
+	// goals having every one of the tags
+	IncludeTags []string `protobuf:"bytes,5,rep,name=include_tags,json=includeTags,proto3" json:"include_tags"`
+	// goals having none of the tags
+	ExcludeTags   []string `protobuf:"bytes,6,rep,name=exclude_tags,json=excludeTags,proto3" json:"exclude_tags"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,6 +147,20 @@ func (x *GetGoalsRequest) GetPageQuery() *common.PageQuery {
 	return nil
 }
 
+func (x *GetGoalsRequest) GetIncludeTags() []string {
+	if x != nil {
+		return x.IncludeTags
+	}
+	return nil
+}
+
+func (x *GetGoalsRequest) GetExcludeTags() []string {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
 type GetGoalsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Goals         []*Goal                `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals"`
@@ -172,8 +243,10 @@ type UpsertGoalRequest struct {
 	PriorityId          string                 `protobuf:"bytes,10,opt,name=priority_id,json=priorityId,proto3" json:"priority_id"`
 	CategoryId          string                 `protobuf:"bytes,11,opt,name=category_id,json=categoryId,proto3" json:"category_id"`
 	Tasks               []*GoalTaskPayload     `protobuf:"bytes,12,rep,name=tasks,proto3" json:"tasks"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// replaces the tags of the goal, they are trimmed and lowercased
+	Tags          []string `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpsertGoalRequest) Reset() {
@@ -290,6 +363,13 @@ func (x *UpsertGoalRequest) GetTasks() []*GoalTaskPayload {
 	return nil
 }
 
+func (x *UpsertGoalRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type UpsertGoalResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IsSuccess     bool                   `protobuf:"varint,1,opt,name=is_success,json=isSuccess,proto3" json:"is_success"`
@@ -458,6 +538,7 @@ type DeleteGoalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
 	GoalId        string                 `protobuf:"bytes,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id"`
+	Mode          GoalDeleteMode         `protobuf:"varint,3,opt,name=mode,proto3,enum=personal_schedule.GoalDeleteMode" json:"mode"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -506,13 +587,25 @@ func (x *DeleteGoalRequest) GetGoalId() string {
 	return ""
 }
 
+func (x *DeleteGoalRequest) GetMode() GoalDeleteMode {
+	if x != nil {
+		return x.Mode
+	}
+	return GoalDeleteMode_GOAL_DELETE_MODE_DETACH
+}
+
 type DeleteGoalResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
-	Error         *common.Error          `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error"`
-	Message       *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	Error            *common.Error          `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error"`
+	Message          *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message"`
+	LinkedWorks      int32                  `protobuf:"varint,4,opt,name=linked_works,json=linkedWorks,proto3" json:"linked_works"`
+	DetachedWorks    int32                  `protobuf:"varint,5,opt,name=detached_works,json=detachedWorks,proto3" json:"detached_works"`
+	DeletedWorks     int32                  `protobuf:"varint,6,opt,name=deleted_works,json=deletedWorks,proto3" json:"deleted_works"`
+	DeletedSubTasks  int32                  `protobuf:"varint,7,opt,name=deleted_sub_tasks,json=deletedSubTasks,proto3" json:"deleted_sub_tasks"`
+	DeletedGoalTasks int32                  `protobuf:"varint,8,opt,name=deleted_goal_tasks,json=deletedGoalTasks,proto3" json:"deleted_goal_tasks"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *DeleteGoalResponse) Reset() {
@@ -566,6 +659,41 @@ func (x *DeleteGoalResponse) GetMessage() string {
 	return ""
 }
 
+func (x *DeleteGoalResponse) GetLinkedWorks() int32 {
+	if x != nil {
+		return x.LinkedWorks
+	}
+	return 0
+}
+
+func (x *DeleteGoalResponse) GetDetachedWorks() int32 {
+	if x != nil {
+		return x.DetachedWorks
+	}
+	return 0
+}
+
+func (x *DeleteGoalResponse) GetDeletedWorks() int32 {
+	if x != nil {
+		return x.DeletedWorks
+	}
+	return 0
+}
+
+func (x *DeleteGoalResponse) GetDeletedSubTasks() int32 {
+	if x != nil {
+		return x.DeletedSubTasks
+	}
+	return 0
+}
+
+func (x *DeleteGoalResponse) GetDeletedGoalTasks() int32 {
+	if x != nil {
+		return x.DeletedGoalTasks
+	}
+	return 0
+}
+
 type GetGoalsForDialogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
@@ -786,13 +914,15 @@ var File_personal_schedule_service_goal_proto protoreflect.FileDescriptor
 
 const file_personal_schedule_service_goal_proto_rawDesc = "" +
 	"\n" +
-	"$personal_schedule_service/goal.proto\x12\x11personal_schedule\x1a/personal_schedule_service/common.schedule.proto\x1a\x12common/error.proto\x1a\x17common/pagination.proto\x1a\x13common/common.proto\"\xb4\x01\n" +
+	"$personal_schedule_service/goal.proto\x12\x11personal_schedule\x1a/personal_schedule_service/common.schedule.proto\x1a\x12common/error.proto\x1a\x17common/pagination.proto\x1a\x13common/common.proto\"\xfa\x01\n" +
 	"\x0fGetGoalsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\x06search\x18\x02 \x01(\tH\x00R\x06search\x88\x01\x01\x12 \n" +
 	"\tstatus_id\x18\x03 \x01(\tH\x01R\bstatusId\x88\x01\x01\x120\n" +
 	"\n" +
-	"page_query\x18\x04 \x01(\v2\x11.common.PageQueryR\tpageQueryB\t\n" +
+	"page_query\x18\x04 \x01(\v2\x11.common.PageQueryR\tpageQuery\x12!\n" +
+	"\finclude_tags\x18\x05 \x03(\tR\vincludeTags\x12!\n" +
+	"\fexclude_tags\x18\x06 \x03(\tR\vexcludeTagsB\t\n" +
 	"\a_searchB\f\n" +
 	"\n" +
 	"_status_id\"\xc5\x01\n" +
@@ -802,7 +932,7 @@ const file_personal_schedule_service_goal_proto_rawDesc = "" +
 	"\vtotal_goals\x18\x03 \x01(\x05R\n" +
 	"totalGoals\x12(\n" +
 	"\x05error\x18\x04 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"\x96\x04\n" +
+	"\x06_error\"\xaa\x04\n" +
 	"\x11UpsertGoalRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x13\n" +
 	"\x02id\x18\x02 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x12\n" +
@@ -819,7 +949,8 @@ const file_personal_schedule_service_goal_proto_rawDesc = "" +
 	"priorityId\x12\x1f\n" +
 	"\vcategory_id\x18\v \x01(\tR\n" +
 	"categoryId\x128\n" +
-	"\x05tasks\x18\f \x03(\v2\".personal_schedule.GoalTaskPayloadR\x05tasksB\x05\n" +
+	"\x05tasks\x18\f \x03(\v2\".personal_schedule.GoalTaskPayloadR\x05tasks\x12\x12\n" +
+	"\x04tags\x18\r \x03(\tR\x04tagsB\x05\n" +
 	"\x03_idB\x15\n" +
 	"\x13_short_descriptionsB\x17\n" +
 	"\x15_detailed_descriptionB\r\n" +
@@ -837,14 +968,20 @@ const file_personal_schedule_service_goal_proto_rawDesc = "" +
 	"\x0fGetGoalResponse\x121\n" +
 	"\x04goal\x18\x01 \x01(\v2\x1d.personal_schedule.GoalDetailR\x04goal\x12(\n" +
 	"\x05error\x18\x02 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"E\n" +
+	"\x06_error\"|\n" +
 	"\x11DeleteGoalRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\agoal_id\x18\x02 \x01(\tR\x06goalId\"\x8d\x01\n" +
+	"\agoal_id\x18\x02 \x01(\tR\x06goalId\x125\n" +
+	"\x04mode\x18\x03 \x01(\x0e2!.personal_schedule.GoalDeleteModeR\x04mode\"\xd6\x02\n" +
 	"\x12DeleteGoalResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12(\n" +
 	"\x05error\x18\x02 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01\x12\x1d\n" +
-	"\amessage\x18\x03 \x01(\tH\x01R\amessage\x88\x01\x01B\b\n" +
+	"\amessage\x18\x03 \x01(\tH\x01R\amessage\x88\x01\x01\x12!\n" +
+	"\flinked_works\x18\x04 \x01(\x05R\vlinkedWorks\x12%\n" +
+	"\x0edetached_works\x18\x05 \x01(\x05R\rdetachedWorks\x12#\n" +
+	"\rdeleted_works\x18\x06 \x01(\x05R\fdeletedWorks\x12*\n" +
+	"\x11deleted_sub_tasks\x18\a \x01(\x05R\x0fdeletedSubTasks\x12,\n" +
+	"\x12deleted_goal_tasks\x18\b \x01(\x05R\x10deletedGoalTasksB\b\n" +
 	"\x06_errorB\n" +
 	"\n" +
 	"\b_message\"3\n" +
@@ -863,7 +1000,11 @@ const file_personal_schedule_service_goal_proto_rawDesc = "" +
 	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
 	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error2\xc3\x04\n" +
+	"\x06_error*h\n" +
+	"\x0eGoalDeleteMode\x12\x1b\n" +
+	"\x17GOAL_DELETE_MODE_DETACH\x10\x00\x12\x1c\n" +
+	"\x18GOAL_DELETE_MODE_CASCADE\x10\x01\x12\x1b\n" +
+	"\x17GOAL_DELETE_MODE_REFUSE\x10\x022\xc3\x04\n" +
 	"\vGoalService\x12S\n" +
 	"\bGetGoals\x12\".personal_schedule.GetGoalsRequest\x1a#.personal_schedule.GetGoalsResponse\x12Y\n" +
 	"\n" +
@@ -886,57 +1027,60 @@ func file_personal_schedule_service_goal_proto_rawDescGZIP() []byte {
 	return file_personal_schedule_service_goal_proto_rawDescData
 }
 
+var file_personal_schedule_service_goal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_personal_schedule_service_goal_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_personal_schedule_service_goal_proto_goTypes = []any{
-	(*GetGoalsRequest)(nil),          // 0: personal_schedule.GetGoalsRequest
-	(*GetGoalsResponse)(nil),         // 1: personal_schedule.GetGoalsResponse
-	(*UpsertGoalRequest)(nil),        // 2: personal_schedule.UpsertGoalRequest
-	(*UpsertGoalResponse)(nil),       // 3: personal_schedule.UpsertGoalResponse
-	(*GetGoalRequest)(nil),           // 4: personal_schedule.GetGoalRequest
-	(*GetGoalResponse)(nil),          // 5: personal_schedule.GetGoalResponse
-	(*DeleteGoalRequest)(nil),        // 6: personal_schedule.DeleteGoalRequest
-	(*DeleteGoalResponse)(nil),       // 7: personal_schedule.DeleteGoalResponse
-	(*GetGoalsForDialogRequest)(nil), // 8: personal_schedule.GetGoalsForDialogRequest
-	(*GetGoalForDialogResponse)(nil), // 9: personal_schedule.GetGoalForDialogResponse
-	(*UpdateGoalLabelRequest)(nil),   // 10: personal_schedule.UpdateGoalLabelRequest
-	(*UpdateGoalLabelResponse)(nil),  // 11: personal_schedule.UpdateGoalLabelResponse
-	(*common.PageQuery)(nil),         // 12: common.PageQuery
-	(*Goal)(nil),                     // 13: personal_schedule.Goal
-	(*common.PageInfo)(nil),          // 14: common.PageInfo
-	(*common.Error)(nil),             // 15: common.Error
-	(*GoalTaskPayload)(nil),          // 16: personal_schedule.GoalTaskPayload
-	(*GoalDetail)(nil),               // 17: personal_schedule.GoalDetail
-	(*GoalOfWork)(nil),               // 18: personal_schedule.GoalOfWork
+	(GoalDeleteMode)(0),              // 0: personal_schedule.GoalDeleteMode
+	(*GetGoalsRequest)(nil),          // 1: personal_schedule.GetGoalsRequest
+	(*GetGoalsResponse)(nil),         // 2: personal_schedule.GetGoalsResponse
+	(*UpsertGoalRequest)(nil),        // 3: personal_schedule.UpsertGoalRequest
+	(*UpsertGoalResponse)(nil),       // 4: personal_schedule.UpsertGoalResponse
+	(*GetGoalRequest)(nil),           // 5: personal_schedule.GetGoalRequest
+	(*GetGoalResponse)(nil),          // 6: personal_schedule.GetGoalResponse
+	(*DeleteGoalRequest)(nil),        // 7: personal_schedule.DeleteGoalRequest
+	(*DeleteGoalResponse)(nil),       // 8: personal_schedule.DeleteGoalResponse
+	(*GetGoalsForDialogRequest)(nil), // 9: personal_schedule.GetGoalsForDialogRequest
+	(*GetGoalForDialogResponse)(nil), // 10: personal_schedule.GetGoalForDialogResponse
+	(*UpdateGoalLabelRequest)(nil),   // 11: personal_schedule.UpdateGoalLabelRequest
+	(*UpdateGoalLabelResponse)(nil),  // 12: personal_schedule.UpdateGoalLabelResponse
+	(*common.PageQuery)(nil),         // 13: common.PageQuery
+	(*Goal)(nil),                     // 14: personal_schedule.Goal
+	(*common.PageInfo)(nil),          // 15: common.PageInfo
+	(*common.Error)(nil),             // 16: common.Error
+	(*GoalTaskPayload)(nil),          // 17: personal_schedule.GoalTaskPayload
+	(*GoalDetail)(nil),               // 18: personal_schedule.GoalDetail
+	(*GoalOfWork)(nil),               // 19: personal_schedule.GoalOfWork
 }
 var file_personal_schedule_service_goal_proto_depIdxs = []int32{
-	12, // 0: personal_schedule.GetGoalsRequest.page_query:type_name -> common.PageQuery
-	13, // 1: personal_schedule.GetGoalsResponse.goals:type_name -> personal_schedule.Goal
-	14, // 2: personal_schedule.GetGoalsResponse.page_info:type_name -> common.PageInfo
-	15, // 3: personal_schedule.GetGoalsResponse.error:type_name -> common.Error
-	16, // 4: personal_schedule.UpsertGoalRequest.tasks:type_name -> personal_schedule.GoalTaskPayload
-	15, // 5: personal_schedule.UpsertGoalResponse.error:type_name -> common.Error
-	17, // 6: personal_schedule.GetGoalResponse.goal:type_name -> personal_schedule.GoalDetail
-	15, // 7: personal_schedule.GetGoalResponse.error:type_name -> common.Error
-	15, // 8: personal_schedule.DeleteGoalResponse.error:type_name -> common.Error
-	18, // 9: personal_schedule.GetGoalForDialogResponse.goals:type_name -> personal_schedule.GoalOfWork
-	15, // 10: personal_schedule.UpdateGoalLabelResponse.error:type_name -> common.Error
-	0,  // 11: personal_schedule.GoalService.GetGoals:input_type -> personal_schedule.GetGoalsRequest
-	2,  // 12: personal_schedule.GoalService.UpsertGoal:input_type -> personal_schedule.UpsertGoalRequest
-	4,  // 13: personal_schedule.GoalService.GetGoal:input_type -> personal_schedule.GetGoalRequest
-	6,  // 14: personal_schedule.GoalService.DeleteGoal:input_type -> personal_schedule.DeleteGoalRequest
-	8,  // 15: personal_schedule.GoalService.GetGoalForDiaglog:input_type -> personal_schedule.GetGoalsForDialogRequest
-	10, // 16: personal_schedule.GoalService.UpdateGoalLabel:input_type -> personal_schedule.UpdateGoalLabelRequest
-	1,  // 17: personal_schedule.GoalService.GetGoals:output_type -> personal_schedule.GetGoalsResponse
-	3,  // 18: personal_schedule.GoalService.UpsertGoal:output_type -> personal_schedule.UpsertGoalResponse
-	5,  // 19: personal_schedule.GoalService.GetGoal:output_type -> personal_schedule.GetGoalResponse
-	7,  // 20: personal_schedule.GoalService.DeleteGoal:output_type -> personal_schedule.DeleteGoalResponse
-	9,  // 21: personal_schedule.GoalService.GetGoalForDiaglog:output_type -> personal_schedule.GetGoalForDialogResponse
-	11, // 22: personal_schedule.GoalService.UpdateGoalLabel:output_type -> personal_schedule.UpdateGoalLabelResponse
-	17, // [17:23] is the sub-list for method output_type
-	11, // [11:17] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	13, // 0: personal_schedule.GetGoalsRequest.page_query:type_name -> common.PageQuery
+	14, // 1: personal_schedule.GetGoalsResponse.goals:type_name -> personal_schedule.Goal
+	15, // 2: personal_schedule.GetGoalsResponse.page_info:type_name -> common.PageInfo
+	16, // 3: personal_schedule.GetGoalsResponse.error:type_name -> common.Error
+	17, // 4: personal_schedule.UpsertGoalRequest.tasks:type_name -> personal_schedule.GoalTaskPayload
+	16, // 5: personal_schedule.UpsertGoalResponse.error:type_name -> common.Error
+	18, // 6: personal_schedule.GetGoalResponse.goal:type_name -> personal_schedule.GoalDetail
+	16, // 7: personal_schedule.GetGoalResponse.error:type_name -> common.Error
+	0,  // 8: personal_schedule.DeleteGoalRequest.mode:type_name -> personal_schedule.GoalDeleteMode
+	16, // 9: personal_schedule.DeleteGoalResponse.error:type_name -> common.Error
+	19, // 10: personal_schedule.GetGoalForDialogResponse.goals:type_name -> personal_schedule.GoalOfWork
+	16, // 11: personal_schedule.UpdateGoalLabelResponse.error:type_name -> common.Error
+	1,  // 12: personal_schedule.GoalService.GetGoals:input_type -> personal_schedule.GetGoalsRequest
+	3,  // 13: personal_schedule.GoalService.UpsertGoal:input_type -> personal_schedule.UpsertGoalRequest
+	5,  // 14: personal_schedule.GoalService.GetGoal:input_type -> personal_schedule.GetGoalRequest
+	7,  // 15: personal_schedule.GoalService.DeleteGoal:input_type -> personal_schedule.DeleteGoalRequest
+	9,  // 16: personal_schedule.GoalService.GetGoalForDiaglog:input_type -> personal_schedule.GetGoalsForDialogRequest
+	11, // 17: personal_schedule.GoalService.UpdateGoalLabel:input_type -> personal_schedule.UpdateGoalLabelRequest
+	2,  // 18: personal_schedule.GoalService.GetGoals:output_type -> personal_schedule.GetGoalsResponse
+	4,  // 19: personal_schedule.GoalService.UpsertGoal:output_type -> personal_schedule.UpsertGoalResponse
+	6,  // 20: personal_schedule.GoalService.GetGoal:output_type -> personal_schedule.GetGoalResponse
+	8,  // 21: personal_schedule.GoalService.DeleteGoal:output_type -> personal_schedule.DeleteGoalResponse
+	10, // 22: personal_schedule.GoalService.GetGoalForDiaglog:output_type -> personal_schedule.GetGoalForDialogResponse
+	12, // 23: personal_schedule.GoalService.UpdateGoalLabel:output_type -> personal_schedule.UpdateGoalLabelResponse
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_personal_schedule_service_goal_proto_init() }
@@ -957,13 +1101,14 @@ func file_personal_schedule_service_goal_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_personal_schedule_service_goal_proto_rawDesc), len(file_personal_schedule_service_goal_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_personal_schedule_service_goal_proto_goTypes,
 		DependencyIndexes: file_personal_schedule_service_goal_proto_depIdxs,
+		EnumInfos:         file_personal_schedule_service_goal_proto_enumTypes,
 		MessageInfos:      file_personal_schedule_service_goal_proto_msgTypes,
 	}.Build()
 	File_personal_schedule_service_goal_proto = out.File
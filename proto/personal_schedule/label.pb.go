@@ -126,6 +126,51 @@ func (x *GetLabelsByTypeIDsResponse) GetError() *common.Error {
 	return nil
 }
 
+type GetDefaultLabelRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the default labels chosen in the preferences of the user replace the built-in ones
+	UserId        *string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDefaultLabelRequest) Reset() {
+	*x = GetDefaultLabelRequest{}
+	mi := &file_personal_schedule_service_label_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDefaultLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDefaultLabelRequest) ProtoMessage() {}
+
+func (x *GetDefaultLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_label_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDefaultLabelRequest.ProtoReflect.Descriptor instead.
+func (*GetDefaultLabelRequest) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_label_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDefaultLabelRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
 type GetDefaultLabelResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *LabelInfo             `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
@@ -140,7 +185,7 @@ type GetDefaultLabelResponse struct {
 
 func (x *GetDefaultLabelResponse) Reset() {
 	*x = GetDefaultLabelResponse{}
-	mi := &file_personal_schedule_service_label_proto_msgTypes[2]
+	mi := &file_personal_schedule_service_label_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -152,7 +197,7 @@ func (x *GetDefaultLabelResponse) String() string {
 func (*GetDefaultLabelResponse) ProtoMessage() {}
 
 func (x *GetDefaultLabelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_personal_schedule_service_label_proto_msgTypes[2]
+	mi := &file_personal_schedule_service_label_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,7 +210,7 @@ func (x *GetDefaultLabelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDefaultLabelResponse.ProtoReflect.Descriptor instead.
 func (*GetDefaultLabelResponse) Descriptor() ([]byte, []int) {
-	return file_personal_schedule_service_label_proto_rawDescGZIP(), []int{2}
+	return file_personal_schedule_service_label_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetDefaultLabelResponse) GetStatus() *LabelInfo {
@@ -210,6 +255,629 @@ func (x *GetDefaultLabelResponse) GetError() *common.Error {
 	return nil
 }
 
+type GetLabelPerTypesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the custom labels of the user are merged after the system labels
+	UserId        *string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLabelPerTypesRequest) Reset() {
+	*x = GetLabelPerTypesRequest{}
+	mi := &file_personal_schedule_service_label_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLabelPerTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLabelPerTypesRequest) ProtoMessage() {}
+
+func (x *GetLabelPerTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_label_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLabelPerTypesRequest.ProtoReflect.Descriptor instead.
+func (*GetLabelPerTypesRequest) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_label_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetLabelPerTypesRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+type GetLabelsByTypeIDsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// label type
+	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	UserId        *string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLabelsByTypeIDsRequest) Reset() {
+	*x = GetLabelsByTypeIDsRequest{}
+	mi := &file_personal_schedule_service_label_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLabelsByTypeIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLabelsByTypeIDsRequest) ProtoMessage() {}
+
+func (x *GetLabelsByTypeIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_label_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLabelsByTypeIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetLabelsByTypeIDsRequest) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_label_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetLabelsByTypeIDsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetLabelsByTypeIDsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+type CreateLabelRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	// the type the label is used as, the draft type can not have custom labels
+	LabelType int32 `protobuf:"varint,3,opt,name=label_type,json=labelType,proto3" json:"label_type"`
+	// #RRGGBB
+	Color         *string `protobuf:"bytes,4,opt,name=color,proto3,oneof" json:"color"`
+	Meaning       *string `protobuf:"bytes,5,opt,name=meaning,proto3,oneof" json:"meaning"`
+	Note          *string `protobuf:"bytes,6,opt,name=note,proto3,oneof" json:"note"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLabelRequest) Reset() {
+	*x = CreateLabelRequest{}
+	mi := &file_personal_schedule_service_label_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLabelRequest) ProtoMessage() {}
+
+func (x *CreateLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_label_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLabelRequest.ProtoReflect.Descriptor instead.
+func (*CreateLabelRequest) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_label_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateLabelRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateLabelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateLabelRequest) GetLabelType() int32 {
+	if x != nil {
+		return x.LabelType
+	}
+	return 0
+}
+
+func (x *CreateLabelRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *CreateLabelRequest) GetMeaning() string {
+	if x != nil && x.Meaning != nil {
+		return *x.Meaning
+	}
+	return ""
+}
+
+func (x *CreateLabelRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type CreateLabelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         *Label                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label"`
+	Error         *common.Error          `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLabelResponse) Reset() {
+	*x = CreateLabelResponse{}
+	mi := &file_personal_schedule_service_label_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLabelResponse) ProtoMessage() {}
+
+func (x *CreateLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_label_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLabelResponse.ProtoReflect.Descriptor instead.
+func (*CreateLabelResponse) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_label_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateLabelResponse) GetLabel() *Label {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+func (x *CreateLabelResponse) GetError() *common.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type UpdateLabelRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Id     string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id"`
+	// unset fields are kept
+	Name          *string `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	Color         *string `protobuf:"bytes,4,opt,name=color,proto3,oneof" json:"color"`
+	Meaning       *string `protobuf:"bytes,5,opt,name=meaning,proto3,oneof" json:"meaning"`
+	Note          *string `protobuf:"bytes,6,opt,name=note,proto3,oneof" json:"note"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLabelRequest) Reset() {
+	*x = UpdateLabelRequest{}
+	mi := &file_personal_schedule_service_label_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLabelRequest) ProtoMessage() {}
+
+func (x *UpdateLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_label_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLabelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLabelRequest) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_label_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateLabelRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateLabelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateLabelRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateLabelRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *UpdateLabelRequest) GetMeaning() string {
+	if x != nil && x.Meaning != nil {
+		return *x.Meaning
+	}
+	return ""
+}
+
+func (x *UpdateLabelRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type UpdateLabelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         *Label                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label"`
+	Error         *common.Error          `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLabelResponse) Reset() {
+	*x = UpdateLabelResponse{}
+	mi := &file_personal_schedule_service_label_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLabelResponse) ProtoMessage() {}
+
+func (x *UpdateLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_label_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLabelResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLabelResponse) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_label_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateLabelResponse) GetLabel() *Label {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+func (x *UpdateLabelResponse) GetError() *common.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type DeleteLabelRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Id     string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id"`
+	// required when works or goals use the label, they are moved to it
+	ReplacementLabelId *string `protobuf:"bytes,3,opt,name=replacement_label_id,json=replacementLabelId,proto3,oneof" json:"replacement_label_id"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeleteLabelRequest) Reset() {
+	*x = DeleteLabelRequest{}
+	mi := &file_personal_schedule_service_label_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLabelRequest) ProtoMessage() {}
+
+func (x *DeleteLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_label_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLabelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLabelRequest) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_label_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteLabelRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteLabelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteLabelRequest) GetReplacementLabelId() string {
+	if x != nil && x.ReplacementLabelId != nil {
+		return *x.ReplacementLabelId
+	}
+	return ""
+}
+
+type DeleteLabelResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess       bool                   `protobuf:"varint,1,opt,name=is_success,json=isSuccess,proto3" json:"is_success"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	ReassignedWorks int64                  `protobuf:"varint,3,opt,name=reassigned_works,json=reassignedWorks,proto3" json:"reassigned_works"`
+	ReassignedGoals int64                  `protobuf:"varint,4,opt,name=reassigned_goals,json=reassignedGoals,proto3" json:"reassigned_goals"`
+	Error           *common.Error          `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteLabelResponse) Reset() {
+	*x = DeleteLabelResponse{}
+	mi := &file_personal_schedule_service_label_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLabelResponse) ProtoMessage() {}
+
+func (x *DeleteLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_label_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLabelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLabelResponse) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_label_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteLabelResponse) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
+func (x *DeleteLabelResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteLabelResponse) GetReassignedWorks() int64 {
+	if x != nil {
+		return x.ReassignedWorks
+	}
+	return 0
+}
+
+func (x *DeleteLabelResponse) GetReassignedGoals() int64 {
+	if x != nil {
+		return x.ReassignedGoals
+	}
+	return 0
+}
+
+func (x *DeleteLabelResponse) GetError() *common.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ReorderLabelsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	LabelType int32                  `protobuf:"varint,2,opt,name=label_type,json=labelType,proto3" json:"label_type"`
+	// every custom label of the type, in their new order
+	LabelIds      []string `protobuf:"bytes,3,rep,name=label_ids,json=labelIds,proto3" json:"label_ids"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderLabelsRequest) Reset() {
+	*x = ReorderLabelsRequest{}
+	mi := &file_personal_schedule_service_label_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderLabelsRequest) ProtoMessage() {}
+
+func (x *ReorderLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_label_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderLabelsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_label_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReorderLabelsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderLabelsRequest) GetLabelType() int32 {
+	if x != nil {
+		return x.LabelType
+	}
+	return 0
+}
+
+func (x *ReorderLabelsRequest) GetLabelIds() []string {
+	if x != nil {
+		return x.LabelIds
+	}
+	return nil
+}
+
+type ReorderLabelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Labels        []*Label               `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels"`
+	Error         *common.Error          `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderLabelsResponse) Reset() {
+	*x = ReorderLabelsResponse{}
+	mi := &file_personal_schedule_service_label_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderLabelsResponse) ProtoMessage() {}
+
+func (x *ReorderLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_label_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderLabelsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_label_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReorderLabelsResponse) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ReorderLabelsResponse) GetError() *common.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
 var File_personal_schedule_service_label_proto protoreflect.FileDescriptor
 
 const file_personal_schedule_service_label_proto_rawDesc = "" +
@@ -222,7 +890,11 @@ const file_personal_schedule_service_label_proto_rawDesc = "" +
 	"\x1aGetLabelsByTypeIDsResponse\x120\n" +
 	"\x06labels\x18\x01 \x03(\v2\x18.personal_schedule.LabelR\x06labels\x12(\n" +
 	"\x05error\x18\x02 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"\xe7\x02\n" +
+	"\x06_error\"B\n" +
+	"\x16GetDefaultLabelRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"\xe7\x02\n" +
 	"\x17GetDefaultLabelResponse\x124\n" +
 	"\x06status\x18\x01 \x01(\v2\x1c.personal_schedule.LabelInfoR\x06status\x12<\n" +
 	"\n" +
@@ -232,11 +904,79 @@ const file_personal_schedule_service_label_proto_rawDesc = "" +
 	"\x04type\x18\x04 \x01(\v2\x1c.personal_schedule.LabelInfoR\x04type\x128\n" +
 	"\bcategory\x18\x05 \x01(\v2\x1c.personal_schedule.LabelInfoR\bcategory\x12(\n" +
 	"\x05error\x18\x06 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error2\x92\x02\n" +
-	"\fLabelService\x12U\n" +
-	"\x10GetLabelPerTypes\x12\x14.common.EmptyRequest\x1a+.personal_schedule.GetLabelPerTypesResponse\x12V\n" +
-	"\x12GetLabelsByTypeIDs\x12\x11.common.IDRequest\x1a-.personal_schedule.GetLabelsByTypeIDsResponse\x12S\n" +
-	"\x0fGetDefaultLabel\x12\x14.common.EmptyRequest\x1a*.personal_schedule.GetDefaultLabelResponseB\x19Z\x17proto/personal_scheduleb\x06proto3"
+	"\x06_error\"C\n" +
+	"\x17GetLabelPerTypesRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"U\n" +
+	"\x19GetLabelsByTypeIDsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\tH\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"\xd2\x01\n" +
+	"\x12CreateLabelRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"label_type\x18\x03 \x01(\x05R\tlabelType\x12\x19\n" +
+	"\x05color\x18\x04 \x01(\tH\x00R\x05color\x88\x01\x01\x12\x1d\n" +
+	"\ameaning\x18\x05 \x01(\tH\x01R\ameaning\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x06 \x01(\tH\x02R\x04note\x88\x01\x01B\b\n" +
+	"\x06_colorB\n" +
+	"\n" +
+	"\b_meaningB\a\n" +
+	"\x05_note\"y\n" +
+	"\x13CreateLabelResponse\x12.\n" +
+	"\x05label\x18\x01 \x01(\v2\x18.personal_schedule.LabelR\x05label\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"\xd1\x01\n" +
+	"\x12UpdateLabelRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x04 \x01(\tH\x01R\x05color\x88\x01\x01\x12\x1d\n" +
+	"\ameaning\x18\x05 \x01(\tH\x02R\ameaning\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x06 \x01(\tH\x03R\x04note\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_colorB\n" +
+	"\n" +
+	"\b_meaningB\a\n" +
+	"\x05_note\"y\n" +
+	"\x13UpdateLabelResponse\x12.\n" +
+	"\x05label\x18\x01 \x01(\v2\x18.personal_schedule.LabelR\x05label\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"\x8d\x01\n" +
+	"\x12DeleteLabelRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x125\n" +
+	"\x14replacement_label_id\x18\x03 \x01(\tH\x00R\x12replacementLabelId\x88\x01\x01B\x17\n" +
+	"\x15_replacement_label_id\"\xd8\x01\n" +
+	"\x13DeleteLabelResponse\x12\x1d\n" +
+	"\n" +
+	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\x10reassigned_works\x18\x03 \x01(\x03R\x0freassignedWorks\x12)\n" +
+	"\x10reassigned_goals\x18\x04 \x01(\x03R\x0freassignedGoals\x12(\n" +
+	"\x05error\x18\x05 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"k\n" +
+	"\x14ReorderLabelsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"label_type\x18\x02 \x01(\x05R\tlabelType\x12\x1b\n" +
+	"\tlabel_ids\x18\x03 \x03(\tR\blabelIds\"}\n" +
+	"\x15ReorderLabelsResponse\x120\n" +
+	"\x06labels\x18\x01 \x03(\v2\x18.personal_schedule.LabelR\x06labels\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error2\xaf\x06\n" +
+	"\fLabelService\x12k\n" +
+	"\x10GetLabelPerTypes\x12*.personal_schedule.GetLabelPerTypesRequest\x1a+.personal_schedule.GetLabelPerTypesResponse\x12q\n" +
+	"\x12GetLabelsByTypeIDs\x12,.personal_schedule.GetLabelsByTypeIDsRequest\x1a-.personal_schedule.GetLabelsByTypeIDsResponse\x12S\n" +
+	"\x0fGetDefaultLabel\x12\x14.common.EmptyRequest\x1a*.personal_schedule.GetDefaultLabelResponse\x12l\n" +
+	"\x13GetUserDefaultLabel\x12).personal_schedule.GetDefaultLabelRequest\x1a*.personal_schedule.GetDefaultLabelResponse\x12\\\n" +
+	"\vCreateLabel\x12%.personal_schedule.CreateLabelRequest\x1a&.personal_schedule.CreateLabelResponse\x12\\\n" +
+	"\vUpdateLabel\x12%.personal_schedule.UpdateLabelRequest\x1a&.personal_schedule.UpdateLabelResponse\x12\\\n" +
+	"\vDeleteLabel\x12%.personal_schedule.DeleteLabelRequest\x1a&.personal_schedule.DeleteLabelResponse\x12b\n" +
+	"\rReorderLabels\x12'.personal_schedule.ReorderLabelsRequest\x1a(.personal_schedule.ReorderLabelsResponseB\x19Z\x17proto/personal_scheduleb\x06proto3"
 
 var (
 	file_personal_schedule_service_label_proto_rawDescOnce sync.Once
@@ -250,40 +990,67 @@ func file_personal_schedule_service_label_proto_rawDescGZIP() []byte {
 	return file_personal_schedule_service_label_proto_rawDescData
 }
 
-var file_personal_schedule_service_label_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_personal_schedule_service_label_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_personal_schedule_service_label_proto_goTypes = []any{
 	(*GetLabelPerTypesResponse)(nil),   // 0: personal_schedule.GetLabelPerTypesResponse
 	(*GetLabelsByTypeIDsResponse)(nil), // 1: personal_schedule.GetLabelsByTypeIDsResponse
-	(*GetDefaultLabelResponse)(nil),    // 2: personal_schedule.GetDefaultLabelResponse
-	(*LabelPerType)(nil),               // 3: personal_schedule.LabelPerType
-	(*common.Error)(nil),               // 4: common.Error
-	(*Label)(nil),                      // 5: personal_schedule.Label
-	(*LabelInfo)(nil),                  // 6: personal_schedule.LabelInfo
-	(*common.EmptyRequest)(nil),        // 7: common.EmptyRequest
-	(*common.IDRequest)(nil),           // 8: common.IDRequest
+	(*GetDefaultLabelRequest)(nil),     // 2: personal_schedule.GetDefaultLabelRequest
+	(*GetDefaultLabelResponse)(nil),    // 3: personal_schedule.GetDefaultLabelResponse
+	(*GetLabelPerTypesRequest)(nil),    // 4: personal_schedule.GetLabelPerTypesRequest
+	(*GetLabelsByTypeIDsRequest)(nil),  // 5: personal_schedule.GetLabelsByTypeIDsRequest
+	(*CreateLabelRequest)(nil),         // 6: personal_schedule.CreateLabelRequest
+	(*CreateLabelResponse)(nil),        // 7: personal_schedule.CreateLabelResponse
+	(*UpdateLabelRequest)(nil),         // 8: personal_schedule.UpdateLabelRequest
+	(*UpdateLabelResponse)(nil),        // 9: personal_schedule.UpdateLabelResponse
+	(*DeleteLabelRequest)(nil),         // 10: personal_schedule.DeleteLabelRequest
+	(*DeleteLabelResponse)(nil),        // 11: personal_schedule.DeleteLabelResponse
+	(*ReorderLabelsRequest)(nil),       // 12: personal_schedule.ReorderLabelsRequest
+	(*ReorderLabelsResponse)(nil),      // 13: personal_schedule.ReorderLabelsResponse
+	(*LabelPerType)(nil),               // 14: personal_schedule.LabelPerType
+	(*common.Error)(nil),               // 15: common.Error
+	(*Label)(nil),                      // 16: personal_schedule.Label
+	(*LabelInfo)(nil),                  // 17: personal_schedule.LabelInfo
+	(*common.EmptyRequest)(nil),        // 18: common.EmptyRequest
 }
 var file_personal_schedule_service_label_proto_depIdxs = []int32{
-	3,  // 0: personal_schedule.GetLabelPerTypesResponse.label_per_types:type_name -> personal_schedule.LabelPerType
-	4,  // 1: personal_schedule.GetLabelPerTypesResponse.error:type_name -> common.Error
-	5,  // 2: personal_schedule.GetLabelsByTypeIDsResponse.labels:type_name -> personal_schedule.Label
-	4,  // 3: personal_schedule.GetLabelsByTypeIDsResponse.error:type_name -> common.Error
-	6,  // 4: personal_schedule.GetDefaultLabelResponse.status:type_name -> personal_schedule.LabelInfo
-	6,  // 5: personal_schedule.GetDefaultLabelResponse.difficulty:type_name -> personal_schedule.LabelInfo
-	6,  // 6: personal_schedule.GetDefaultLabelResponse.priority:type_name -> personal_schedule.LabelInfo
-	6,  // 7: personal_schedule.GetDefaultLabelResponse.type:type_name -> personal_schedule.LabelInfo
-	6,  // 8: personal_schedule.GetDefaultLabelResponse.category:type_name -> personal_schedule.LabelInfo
-	4,  // 9: personal_schedule.GetDefaultLabelResponse.error:type_name -> common.Error
-	7,  // 10: personal_schedule.LabelService.GetLabelPerTypes:input_type -> common.EmptyRequest
-	8,  // 11: personal_schedule.LabelService.GetLabelsByTypeIDs:input_type -> common.IDRequest
-	7,  // 12: personal_schedule.LabelService.GetDefaultLabel:input_type -> common.EmptyRequest
-	0,  // 13: personal_schedule.LabelService.GetLabelPerTypes:output_type -> personal_schedule.GetLabelPerTypesResponse
-	1,  // 14: personal_schedule.LabelService.GetLabelsByTypeIDs:output_type -> personal_schedule.GetLabelsByTypeIDsResponse
-	2,  // 15: personal_schedule.LabelService.GetDefaultLabel:output_type -> personal_schedule.GetDefaultLabelResponse
-	13, // [13:16] is the sub-list for method output_type
-	10, // [10:13] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	14, // 0: personal_schedule.GetLabelPerTypesResponse.label_per_types:type_name -> personal_schedule.LabelPerType
+	15, // 1: personal_schedule.GetLabelPerTypesResponse.error:type_name -> common.Error
+	16, // 2: personal_schedule.GetLabelsByTypeIDsResponse.labels:type_name -> personal_schedule.Label
+	15, // 3: personal_schedule.GetLabelsByTypeIDsResponse.error:type_name -> common.Error
+	17, // 4: personal_schedule.GetDefaultLabelResponse.status:type_name -> personal_schedule.LabelInfo
+	17, // 5: personal_schedule.GetDefaultLabelResponse.difficulty:type_name -> personal_schedule.LabelInfo
+	17, // 6: personal_schedule.GetDefaultLabelResponse.priority:type_name -> personal_schedule.LabelInfo
+	17, // 7: personal_schedule.GetDefaultLabelResponse.type:type_name -> personal_schedule.LabelInfo
+	17, // 8: personal_schedule.GetDefaultLabelResponse.category:type_name -> personal_schedule.LabelInfo
+	15, // 9: personal_schedule.GetDefaultLabelResponse.error:type_name -> common.Error
+	16, // 10: personal_schedule.CreateLabelResponse.label:type_name -> personal_schedule.Label
+	15, // 11: personal_schedule.CreateLabelResponse.error:type_name -> common.Error
+	16, // 12: personal_schedule.UpdateLabelResponse.label:type_name -> personal_schedule.Label
+	15, // 13: personal_schedule.UpdateLabelResponse.error:type_name -> common.Error
+	15, // 14: personal_schedule.DeleteLabelResponse.error:type_name -> common.Error
+	16, // 15: personal_schedule.ReorderLabelsResponse.labels:type_name -> personal_schedule.Label
+	15, // 16: personal_schedule.ReorderLabelsResponse.error:type_name -> common.Error
+	4,  // 17: personal_schedule.LabelService.GetLabelPerTypes:input_type -> personal_schedule.GetLabelPerTypesRequest
+	5,  // 18: personal_schedule.LabelService.GetLabelsByTypeIDs:input_type -> personal_schedule.GetLabelsByTypeIDsRequest
+	18, // 19: personal_schedule.LabelService.GetDefaultLabel:input_type -> common.EmptyRequest
+	2,  // 20: personal_schedule.LabelService.GetUserDefaultLabel:input_type -> personal_schedule.GetDefaultLabelRequest
+	6,  // 21: personal_schedule.LabelService.CreateLabel:input_type -> personal_schedule.CreateLabelRequest
+	8,  // 22: personal_schedule.LabelService.UpdateLabel:input_type -> personal_schedule.UpdateLabelRequest
+	10, // 23: personal_schedule.LabelService.DeleteLabel:input_type -> personal_schedule.DeleteLabelRequest
+	12, // 24: personal_schedule.LabelService.ReorderLabels:input_type -> personal_schedule.ReorderLabelsRequest
+	0,  // 25: personal_schedule.LabelService.GetLabelPerTypes:output_type -> personal_schedule.GetLabelPerTypesResponse
+	1,  // 26: personal_schedule.LabelService.GetLabelsByTypeIDs:output_type -> personal_schedule.GetLabelsByTypeIDsResponse
+	3,  // 27: personal_schedule.LabelService.GetDefaultLabel:output_type -> personal_schedule.GetDefaultLabelResponse
+	3,  // 28: personal_schedule.LabelService.GetUserDefaultLabel:output_type -> personal_schedule.GetDefaultLabelResponse
+	7,  // 29: personal_schedule.LabelService.CreateLabel:output_type -> personal_schedule.CreateLabelResponse
+	9,  // 30: personal_schedule.LabelService.UpdateLabel:output_type -> personal_schedule.UpdateLabelResponse
+	11, // 31: personal_schedule.LabelService.DeleteLabel:output_type -> personal_schedule.DeleteLabelResponse
+	13, // 32: personal_schedule.LabelService.ReorderLabels:output_type -> personal_schedule.ReorderLabelsResponse
+	25, // [25:33] is the sub-list for method output_type
+	17, // [17:25] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_personal_schedule_service_label_proto_init() }
@@ -295,13 +1062,23 @@ func file_personal_schedule_service_label_proto_init() {
 	file_personal_schedule_service_label_proto_msgTypes[0].OneofWrappers = []any{}
 	file_personal_schedule_service_label_proto_msgTypes[1].OneofWrappers = []any{}
 	file_personal_schedule_service_label_proto_msgTypes[2].OneofWrappers = []any{}
+	file_personal_schedule_service_label_proto_msgTypes[3].OneofWrappers = []any{}
+	file_personal_schedule_service_label_proto_msgTypes[4].OneofWrappers = []any{}
+	file_personal_schedule_service_label_proto_msgTypes[5].OneofWrappers = []any{}
+	file_personal_schedule_service_label_proto_msgTypes[6].OneofWrappers = []any{}
+	file_personal_schedule_service_label_proto_msgTypes[7].OneofWrappers = []any{}
+	file_personal_schedule_service_label_proto_msgTypes[8].OneofWrappers = []any{}
+	file_personal_schedule_service_label_proto_msgTypes[9].OneofWrappers = []any{}
+	file_personal_schedule_service_label_proto_msgTypes[10].OneofWrappers = []any{}
+	file_personal_schedule_service_label_proto_msgTypes[11].OneofWrappers = []any{}
+	file_personal_schedule_service_label_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_personal_schedule_service_label_proto_rawDesc), len(file_personal_schedule_service_label_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
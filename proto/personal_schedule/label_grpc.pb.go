@@ -20,18 +20,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LabelService_GetLabelPerTypes_FullMethodName   = "/personal_schedule.LabelService/GetLabelPerTypes"
-	LabelService_GetLabelsByTypeIDs_FullMethodName = "/personal_schedule.LabelService/GetLabelsByTypeIDs"
-	LabelService_GetDefaultLabel_FullMethodName    = "/personal_schedule.LabelService/GetDefaultLabel"
+	LabelService_GetLabelPerTypes_FullMethodName    = "/personal_schedule.LabelService/GetLabelPerTypes"
+	LabelService_GetLabelsByTypeIDs_FullMethodName  = "/personal_schedule.LabelService/GetLabelsByTypeIDs"
+	LabelService_GetDefaultLabel_FullMethodName     = "/personal_schedule.LabelService/GetDefaultLabel"
+	LabelService_GetUserDefaultLabel_FullMethodName = "/personal_schedule.LabelService/GetUserDefaultLabel"
+	LabelService_CreateLabel_FullMethodName         = "/personal_schedule.LabelService/CreateLabel"
+	LabelService_UpdateLabel_FullMethodName         = "/personal_schedule.LabelService/UpdateLabel"
+	LabelService_DeleteLabel_FullMethodName         = "/personal_schedule.LabelService/DeleteLabel"
+	LabelService_ReorderLabels_FullMethodName       = "/personal_schedule.LabelService/ReorderLabels"
 )
 
 // LabelServiceClient is the client API for LabelService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LabelServiceClient interface {
-	GetLabelPerTypes(ctx context.Context, in *common.EmptyRequest, opts ...grpc.CallOption) (*GetLabelPerTypesResponse, error)
-	GetLabelsByTypeIDs(ctx context.Context, in *common.IDRequest, opts ...grpc.CallOption) (*GetLabelsByTypeIDsResponse, error)
+	GetLabelPerTypes(ctx context.Context, in *GetLabelPerTypesRequest, opts ...grpc.CallOption) (*GetLabelPerTypesResponse, error)
+	GetLabelsByTypeIDs(ctx context.Context, in *GetLabelsByTypeIDsRequest, opts ...grpc.CallOption) (*GetLabelsByTypeIDsResponse, error)
+	// the system defaults, kept for the clients built before the user defaults
 	GetDefaultLabel(ctx context.Context, in *common.EmptyRequest, opts ...grpc.CallOption) (*GetDefaultLabelResponse, error)
+	// the defaults of the user, else the system defaults
+	GetUserDefaultLabel(ctx context.Context, in *GetDefaultLabelRequest, opts ...grpc.CallOption) (*GetDefaultLabelResponse, error)
+	// custom labels are owned by the user and only usable by them
+	CreateLabel(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*CreateLabelResponse, error)
+	UpdateLabel(ctx context.Context, in *UpdateLabelRequest, opts ...grpc.CallOption) (*UpdateLabelResponse, error)
+	DeleteLabel(ctx context.Context, in *DeleteLabelRequest, opts ...grpc.CallOption) (*DeleteLabelResponse, error)
+	ReorderLabels(ctx context.Context, in *ReorderLabelsRequest, opts ...grpc.CallOption) (*ReorderLabelsResponse, error)
 }
 
 type labelServiceClient struct {
@@ -42,7 +55,7 @@ func NewLabelServiceClient(cc grpc.ClientConnInterface) LabelServiceClient {
 	return &labelServiceClient{cc}
 }
 
-func (c *labelServiceClient) GetLabelPerTypes(ctx context.Context, in *common.EmptyRequest, opts ...grpc.CallOption) (*GetLabelPerTypesResponse, error) {
+func (c *labelServiceClient) GetLabelPerTypes(ctx context.Context, in *GetLabelPerTypesRequest, opts ...grpc.CallOption) (*GetLabelPerTypesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetLabelPerTypesResponse)
 	err := c.cc.Invoke(ctx, LabelService_GetLabelPerTypes_FullMethodName, in, out, cOpts...)
@@ -52,7 +65,7 @@ func (c *labelServiceClient) GetLabelPerTypes(ctx context.Context, in *common.Em
 	return out, nil
 }
 
-func (c *labelServiceClient) GetLabelsByTypeIDs(ctx context.Context, in *common.IDRequest, opts ...grpc.CallOption) (*GetLabelsByTypeIDsResponse, error) {
+func (c *labelServiceClient) GetLabelsByTypeIDs(ctx context.Context, in *GetLabelsByTypeIDsRequest, opts ...grpc.CallOption) (*GetLabelsByTypeIDsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetLabelsByTypeIDsResponse)
 	err := c.cc.Invoke(ctx, LabelService_GetLabelsByTypeIDs_FullMethodName, in, out, cOpts...)
@@ -72,13 +85,71 @@ func (c *labelServiceClient) GetDefaultLabel(ctx context.Context, in *common.Emp
 	return out, nil
 }
 
+func (c *labelServiceClient) GetUserDefaultLabel(ctx context.Context, in *GetDefaultLabelRequest, opts ...grpc.CallOption) (*GetDefaultLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDefaultLabelResponse)
+	err := c.cc.Invoke(ctx, LabelService_GetUserDefaultLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelServiceClient) CreateLabel(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*CreateLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLabelResponse)
+	err := c.cc.Invoke(ctx, LabelService_CreateLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelServiceClient) UpdateLabel(ctx context.Context, in *UpdateLabelRequest, opts ...grpc.CallOption) (*UpdateLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLabelResponse)
+	err := c.cc.Invoke(ctx, LabelService_UpdateLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelServiceClient) DeleteLabel(ctx context.Context, in *DeleteLabelRequest, opts ...grpc.CallOption) (*DeleteLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLabelResponse)
+	err := c.cc.Invoke(ctx, LabelService_DeleteLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelServiceClient) ReorderLabels(ctx context.Context, in *ReorderLabelsRequest, opts ...grpc.CallOption) (*ReorderLabelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderLabelsResponse)
+	err := c.cc.Invoke(ctx, LabelService_ReorderLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LabelServiceServer is the server API for LabelService service.
 // All implementations must embed UnimplementedLabelServiceServer
 // for forward compatibility.
 type LabelServiceServer interface {
-	GetLabelPerTypes(context.Context, *common.EmptyRequest) (*GetLabelPerTypesResponse, error)
-	GetLabelsByTypeIDs(context.Context, *common.IDRequest) (*GetLabelsByTypeIDsResponse, error)
+	GetLabelPerTypes(context.Context, *GetLabelPerTypesRequest) (*GetLabelPerTypesResponse, error)
+	GetLabelsByTypeIDs(context.Context, *GetLabelsByTypeIDsRequest) (*GetLabelsByTypeIDsResponse, error)
+	// the system defaults, kept for the clients built before the user defaults
 	GetDefaultLabel(context.Context, *common.EmptyRequest) (*GetDefaultLabelResponse, error)
+	// the defaults of the user, else the system defaults
+	GetUserDefaultLabel(context.Context, *GetDefaultLabelRequest) (*GetDefaultLabelResponse, error)
+	// custom labels are owned by the user and only usable by them
+	CreateLabel(context.Context, *CreateLabelRequest) (*CreateLabelResponse, error)
+	UpdateLabel(context.Context, *UpdateLabelRequest) (*UpdateLabelResponse, error)
+	DeleteLabel(context.Context, *DeleteLabelRequest) (*DeleteLabelResponse, error)
+	ReorderLabels(context.Context, *ReorderLabelsRequest) (*ReorderLabelsResponse, error)
 	mustEmbedUnimplementedLabelServiceServer()
 }
 
@@ -89,15 +160,30 @@ type LabelServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedLabelServiceServer struct{}
 
-func (UnimplementedLabelServiceServer) GetLabelPerTypes(context.Context, *common.EmptyRequest) (*GetLabelPerTypesResponse, error) {
+func (UnimplementedLabelServiceServer) GetLabelPerTypes(context.Context, *GetLabelPerTypesRequest) (*GetLabelPerTypesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLabelPerTypes not implemented")
 }
-func (UnimplementedLabelServiceServer) GetLabelsByTypeIDs(context.Context, *common.IDRequest) (*GetLabelsByTypeIDsResponse, error) {
+func (UnimplementedLabelServiceServer) GetLabelsByTypeIDs(context.Context, *GetLabelsByTypeIDsRequest) (*GetLabelsByTypeIDsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLabelsByTypeIDs not implemented")
 }
 func (UnimplementedLabelServiceServer) GetDefaultLabel(context.Context, *common.EmptyRequest) (*GetDefaultLabelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDefaultLabel not implemented")
 }
+func (UnimplementedLabelServiceServer) GetUserDefaultLabel(context.Context, *GetDefaultLabelRequest) (*GetDefaultLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserDefaultLabel not implemented")
+}
+func (UnimplementedLabelServiceServer) CreateLabel(context.Context, *CreateLabelRequest) (*CreateLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLabel not implemented")
+}
+func (UnimplementedLabelServiceServer) UpdateLabel(context.Context, *UpdateLabelRequest) (*UpdateLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLabel not implemented")
+}
+func (UnimplementedLabelServiceServer) DeleteLabel(context.Context, *DeleteLabelRequest) (*DeleteLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLabel not implemented")
+}
+func (UnimplementedLabelServiceServer) ReorderLabels(context.Context, *ReorderLabelsRequest) (*ReorderLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderLabels not implemented")
+}
 func (UnimplementedLabelServiceServer) mustEmbedUnimplementedLabelServiceServer() {}
 func (UnimplementedLabelServiceServer) testEmbeddedByValue()                      {}
 
@@ -120,7 +206,7 @@ func RegisterLabelServiceServer(s grpc.ServiceRegistrar, srv LabelServiceServer)
 }
 
 func _LabelService_GetLabelPerTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(common.EmptyRequest)
+	in := new(GetLabelPerTypesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -132,13 +218,13 @@ func _LabelService_GetLabelPerTypes_Handler(srv interface{}, ctx context.Context
 		FullMethod: LabelService_GetLabelPerTypes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LabelServiceServer).GetLabelPerTypes(ctx, req.(*common.EmptyRequest))
+		return srv.(LabelServiceServer).GetLabelPerTypes(ctx, req.(*GetLabelPerTypesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _LabelService_GetLabelsByTypeIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(common.IDRequest)
+	in := new(GetLabelsByTypeIDsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -150,7 +236,7 @@ func _LabelService_GetLabelsByTypeIDs_Handler(srv interface{}, ctx context.Conte
 		FullMethod: LabelService_GetLabelsByTypeIDs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LabelServiceServer).GetLabelsByTypeIDs(ctx, req.(*common.IDRequest))
+		return srv.(LabelServiceServer).GetLabelsByTypeIDs(ctx, req.(*GetLabelsByTypeIDsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -173,6 +259,96 @@ func _LabelService_GetDefaultLabel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LabelService_GetUserDefaultLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDefaultLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).GetUserDefaultLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabelService_GetUserDefaultLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).GetUserDefaultLabel(ctx, req.(*GetDefaultLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LabelService_CreateLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).CreateLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabelService_CreateLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).CreateLabel(ctx, req.(*CreateLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LabelService_UpdateLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).UpdateLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabelService_UpdateLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).UpdateLabel(ctx, req.(*UpdateLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LabelService_DeleteLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).DeleteLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabelService_DeleteLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).DeleteLabel(ctx, req.(*DeleteLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LabelService_ReorderLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).ReorderLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabelService_ReorderLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).ReorderLabels(ctx, req.(*ReorderLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LabelService_ServiceDesc is the grpc.ServiceDesc for LabelService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,6 +368,26 @@ var LabelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDefaultLabel",
 			Handler:    _LabelService_GetDefaultLabel_Handler,
 		},
+		{
+			MethodName: "GetUserDefaultLabel",
+			Handler:    _LabelService_GetUserDefaultLabel_Handler,
+		},
+		{
+			MethodName: "CreateLabel",
+			Handler:    _LabelService_CreateLabel_Handler,
+		},
+		{
+			MethodName: "UpdateLabel",
+			Handler:    _LabelService_UpdateLabel_Handler,
+		},
+		{
+			MethodName: "DeleteLabel",
+			Handler:    _LabelService_DeleteLabel_Handler,
+		},
+		{
+			MethodName: "ReorderLabels",
+			Handler:    _LabelService_ReorderLabels_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "personal_schedule_service/label.proto",
@@ -22,6 +22,106 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// WorkingHours is a local window of a weekday, in minutes from midnight.
+type FreeTimeOrder int32
+
+const (
+	FreeTimeOrder_FREE_TIME_ORDER_EARLIEST FreeTimeOrder = 0
+	FreeTimeOrder_FREE_TIME_ORDER_LONGEST  FreeTimeOrder = 1
+)
+
+// Enum value maps for FreeTimeOrder.
+var (
+	FreeTimeOrder_name = map[int32]string{
+		0: "FREE_TIME_ORDER_EARLIEST",
+		1: "FREE_TIME_ORDER_LONGEST",
+	}
+	FreeTimeOrder_value = map[string]int32{
+		"FREE_TIME_ORDER_EARLIEST": 0,
+		"FREE_TIME_ORDER_LONGEST":  1,
+	}
+)
+
+func (x FreeTimeOrder) Enum() *FreeTimeOrder {
+	p := new(FreeTimeOrder)
+	*p = x
+	return p
+}
+
+func (x FreeTimeOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FreeTimeOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_personal_schedule_service_work_proto_enumTypes[0].Descriptor()
+}
+
+func (FreeTimeOrder) Type() protoreflect.EnumType {
+	return &file_personal_schedule_service_work_proto_enumTypes[0]
+}
+
+func (x FreeTimeOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FreeTimeOrder.Descriptor instead.
+func (FreeTimeOrder) EnumDescriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{0}
+}
+
+type WorkSortKey int32
+
+const (
+	WorkSortKey_WORK_SORT_KEY_END_DATE   WorkSortKey = 0
+	WorkSortKey_WORK_SORT_KEY_START_DATE WorkSortKey = 1
+	// most important first when ascending, works without a priority last
+	WorkSortKey_WORK_SORT_KEY_PRIORITY         WorkSortKey = 2
+	WorkSortKey_WORK_SORT_KEY_LAST_MODIFIED_AT WorkSortKey = 3
+)
+
+// Enum value maps for WorkSortKey.
+var (
+	WorkSortKey_name = map[int32]string{
+		0: "WORK_SORT_KEY_END_DATE",
+		1: "WORK_SORT_KEY_START_DATE",
+		2: "WORK_SORT_KEY_PRIORITY",
+		3: "WORK_SORT_KEY_LAST_MODIFIED_AT",
+	}
+	WorkSortKey_value = map[string]int32{
+		"WORK_SORT_KEY_END_DATE":         0,
+		"WORK_SORT_KEY_START_DATE":       1,
+		"WORK_SORT_KEY_PRIORITY":         2,
+		"WORK_SORT_KEY_LAST_MODIFIED_AT": 3,
+	}
+)
+
+func (x WorkSortKey) Enum() *WorkSortKey {
+	p := new(WorkSortKey)
+	*p = x
+	return p
+}
+
+func (x WorkSortKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkSortKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_personal_schedule_service_work_proto_enumTypes[1].Descriptor()
+}
+
+func (WorkSortKey) Type() protoreflect.EnumType {
+	return &file_personal_schedule_service_work_proto_enumTypes[1]
+}
+
+func (x WorkSortKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkSortKey.Descriptor instead.
+func (WorkSortKey) EnumDescriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{1}
+}
+
 type UpsertWorkRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
@@ -43,8 +143,11 @@ type UpsertWorkRequest struct {
 	UpdateType          *int32                 `protobuf:"varint,17,opt,name=update_type,json=updateType,proto3,oneof" json:"update_type"`
 	RepeatStartDate     *int64                 `protobuf:"varint,18,opt,name=repeat_start_date,json=repeatStartDate,proto3,oneof" json:"repeat_start_date"`
 	RepeatEndDate       *int64                 `protobuf:"varint,19,opt,name=repeat_end_date,json=repeatEndDate,proto3,oneof" json:"repeat_end_date"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	Recurrence          *RecurrenceRule        `protobuf:"bytes,20,opt,name=recurrence,proto3,oneof" json:"recurrence"`
+	// replaces the tags of the work, they are trimmed and lowercased
+	Tags          []string `protobuf:"bytes,21,rep,name=tags,proto3" json:"tags"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpsertWorkRequest) Reset() {
@@ -210,11 +313,27 @@ func (x *UpsertWorkRequest) GetRepeatEndDate() int64 {
 	return 0
 }
 
+func (x *UpsertWorkRequest) GetRecurrence() *RecurrenceRule {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *UpsertWorkRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type UpsertWorkResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsSuccess     bool                   `protobuf:"varint,1,opt,name=is_success,json=isSuccess,proto3" json:"is_success"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
-	Error         *common.Error          `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess bool                   `protobuf:"varint,1,opt,name=is_success,json=isSuccess,proto3" json:"is_success"`
+	Message   string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	Error     *common.Error          `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error"`
+	// set when the work overlaps existing works
+	Conflicts     []*ScheduleConflict `protobuf:"bytes,5,rep,name=conflicts,proto3" json:"conflicts"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -270,17 +389,35 @@ func (x *UpsertWorkResponse) GetError() *common.Error {
 	return nil
 }
 
+func (x *UpsertWorkResponse) GetConflicts() []*ScheduleConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
 type GetWorksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
-	FromDate      *int64                 `protobuf:"varint,2,opt,name=from_date,json=fromDate,proto3,oneof" json:"from_date"`
-	ToDate        *int64                 `protobuf:"varint,3,opt,name=to_date,json=toDate,proto3,oneof" json:"to_date"`
-	Search        *string                `protobuf:"bytes,4,opt,name=search,proto3,oneof" json:"search"`
-	StatusId      *string                `protobuf:"bytes,6,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id"`
-	DifficultyId  *string                `protobuf:"bytes,7,opt,name=difficulty_id,json=difficultyId,proto3,oneof" json:"difficulty_id"`
-	PriorityId    *string                `protobuf:"bytes,8,opt,name=priority_id,json=priorityId,proto3,oneof" json:"priority_id"`
-	TypeId        *string                `protobuf:"bytes,9,opt,name=type_id,json=typeId,proto3,oneof" json:"type_id"`
-	CategoryId    *string                `protobuf:"bytes,10,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	FromDate     *int64                 `protobuf:"varint,2,opt,name=from_date,json=fromDate,proto3,oneof" json:"from_date"`
+	ToDate       *int64                 `protobuf:"varint,3,opt,name=to_date,json=toDate,proto3,oneof" json:"to_date"`
+	Search       *string                `protobuf:"bytes,4,opt,name=search,proto3,oneof" json:"search"`
+	StatusId     *string                `protobuf:"bytes,6,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id"`
+	DifficultyId *string                `protobuf:"bytes,7,opt,name=difficulty_id,json=difficultyId,proto3,oneof" json:"difficulty_id"`
+	PriorityId   *string                `protobuf:"bytes,8,opt,name=priority_id,json=priorityId,proto3,oneof" json:"priority_id"`
+	TypeId       *string                `protobuf:"bytes,9,opt,name=type_id,json=typeId,proto3,oneof" json:"type_id"`
+	CategoryId   *string                `protobuf:"bytes,10,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id"`
+	// page_ignore unset or true returns every work, sort_by is not used
+	PageQuery *common.PageQuery `protobuf:"bytes,11,opt,name=page_query,json=pageQuery,proto3" json:"page_query"`
+	// ties are broken by the work ID
+	SortKey        WorkSortKey `protobuf:"varint,12,opt,name=sort_key,json=sortKey,proto3,enum=personal_schedule.WorkSortKey" json:"sort_key"`
+	SortDescending bool        `protobuf:"varint,13,opt,name=sort_descending,json=sortDescending,proto3" json:"sort_descending"`
+	// next_cursor of the previous page, page is then ignored; only valid with the same sort
+	Cursor *string `protobuf:"bytes,14,opt,name=cursor,proto3,oneof" json:"cursor"`
+	// works having every one of the tags
+	IncludeTags []string `protobuf:"bytes,15,rep,name=include_tags,json=includeTags,proto3" json:"include_tags"`
+	// works having none of the tags
+	ExcludeTags   []string `protobuf:"bytes,16,rep,name=exclude_tags,json=excludeTags,proto3" json:"exclude_tags"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -378,11 +515,57 @@ func (x *GetWorksRequest) GetCategoryId() string {
 	return ""
 }
 
+func (x *GetWorksRequest) GetPageQuery() *common.PageQuery {
+	if x != nil {
+		return x.PageQuery
+	}
+	return nil
+}
+
+func (x *GetWorksRequest) GetSortKey() WorkSortKey {
+	if x != nil {
+		return x.SortKey
+	}
+	return WorkSortKey_WORK_SORT_KEY_END_DATE
+}
+
+func (x *GetWorksRequest) GetSortDescending() bool {
+	if x != nil {
+		return x.SortDescending
+	}
+	return false
+}
+
+func (x *GetWorksRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *GetWorksRequest) GetIncludeTags() []string {
+	if x != nil {
+		return x.IncludeTags
+	}
+	return nil
+}
+
+func (x *GetWorksRequest) GetExcludeTags() []string {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
 type GetWorksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Works         []*Work                `protobuf:"bytes,1,rep,name=works,proto3" json:"works"`
-	TotalWorks    int32                  `protobuf:"varint,2,opt,name=total_works,json=totalWorks,proto3" json:"total_works"`
-	Error         *common.Error          `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Works      []*Work                `protobuf:"bytes,1,rep,name=works,proto3" json:"works"`
+	TotalWorks int32                  `protobuf:"varint,2,opt,name=total_works,json=totalWorks,proto3" json:"total_works"`
+	Error      *common.Error          `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error"`
+	// page is 0 when the page was read with a cursor
+	PageInfo *common.PageInfo `protobuf:"bytes,4,opt,name=page_info,json=pageInfo,proto3" json:"page_info"`
+	// set when there are works after this page
+	NextCursor    *string `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -438,6 +621,20 @@ func (x *GetWorksResponse) GetError() *common.Error {
 	return nil
 }
 
+func (x *GetWorksResponse) GetPageInfo() *common.PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+func (x *GetWorksResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
 type GetWorkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
@@ -715,10 +912,12 @@ func (x *GetRecoveryWorksRequest) GetSourceDate() int64 {
 }
 
 type GetRecoveryWorksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsSuccess     bool                   `protobuf:"varint,1,opt,name=is_success,json=isSuccess,proto3" json:"is_success"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
-	Error         *common.Error          `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess bool                   `protobuf:"varint,1,opt,name=is_success,json=isSuccess,proto3" json:"is_success"`
+	Message   string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	Error     *common.Error          `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error"`
+	// the recovered works skipped because they overlap existing works
+	Conflicts     []*ScheduleConflict `protobuf:"bytes,4,rep,name=conflicts,proto3" json:"conflicts"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -774,6 +973,13 @@ func (x *GetRecoveryWorksResponse) GetError() *common.Error {
 	return nil
 }
 
+func (x *GetRecoveryWorksResponse) GetConflicts() []*ScheduleConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
 type UpdateWorkLabelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
@@ -947,10 +1153,12 @@ func (x *SaveDraftAsRealWorkRequest) GetUserId() string {
 }
 
 type SaveDraftAsRealWorkResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsSuccess     bool                   `protobuf:"varint,1,opt,name=is_success,json=isSuccess,proto3" json:"is_success"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
-	Error         *common.Error          `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess bool                   `protobuf:"varint,1,opt,name=is_success,json=isSuccess,proto3" json:"is_success"`
+	Message   string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	Error     *common.Error          `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error"`
+	// the drafts overlapping existing works, nothing is accepted while there are some
+	Conflicts     []*ScheduleConflict `protobuf:"bytes,4,rep,name=conflicts,proto3" json:"conflicts"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1006,6 +1214,13 @@ func (x *SaveDraftAsRealWorkResponse) GetError() *common.Error {
 	return nil
 }
 
+func (x *SaveDraftAsRealWorkResponse) GetConflicts() []*ScheduleConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
 type DeleteAllDraftWorksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
@@ -1178,146 +1393,1864 @@ func (x *GenerateWorksByAIRequest) GetAdditionalContext() string {
 	return ""
 }
 
-var File_personal_schedule_service_work_proto protoreflect.FileDescriptor
+type TimeInterval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start"`
+	End           int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_personal_schedule_service_work_proto_rawDesc = "" +
-	"\n" +
-	"$personal_schedule_service/work.proto\x12\x11personal_schedule\x1a/personal_schedule_service/common.schedule.proto\x1a\x12common/error.proto\x1a\x13common/common.proto\"\x83\a\n" +
-	"\x11UpsertWorkRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x13\n" +
-	"\x02id\x18\x02 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x122\n" +
-	"\x12short_descriptions\x18\x04 \x01(\tH\x01R\x11shortDescriptions\x88\x01\x01\x126\n" +
-	"\x14detailed_description\x18\x05 \x01(\tH\x02R\x13detailedDescription\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"start_date\x18\x06 \x01(\x03H\x03R\tstartDate\x88\x01\x01\x12\x19\n" +
-	"\bend_date\x18\a \x01(\x03R\aendDate\x12\x1b\n" +
-	"\tstatus_id\x18\b \x01(\tR\bstatusId\x12#\n" +
-	"\rdifficulty_id\x18\t \x01(\tR\fdifficultyId\x12\x1f\n" +
-	"\vpriority_id\x18\n" +
-	" \x01(\tR\n" +
-	"priorityId\x12\x17\n" +
-	"\atype_id\x18\v \x01(\tR\x06typeId\x12\x1f\n" +
-	"\vcategory_id\x18\f \x01(\tR\n" +
-	"categoryId\x12\x1e\n" +
-	"\bdraft_id\x18\r \x01(\tH\x04R\adraftId\x88\x01\x01\x12\x1c\n" +
-	"\agoal_id\x18\x0e \x01(\tH\x05R\x06goalId\x88\x01\x01\x12>\n" +
-	"\tsub_tasks\x18\x0f \x03(\v2!.personal_schedule.SubTaskPayloadR\bsubTasks\x12I\n" +
-	"\rnotifications\x18\x10 \x03(\v2#.personal_schedule.WorkNotificationR\rnotifications\x12$\n" +
-	"\vupdate_type\x18\x11 \x01(\x05H\x06R\n" +
-	"updateType\x88\x01\x01\x12/\n" +
-	"\x11repeat_start_date\x18\x12 \x01(\x03H\aR\x0frepeatStartDate\x88\x01\x01\x12+\n" +
-	"\x0frepeat_end_date\x18\x13 \x01(\x03H\bR\rrepeatEndDate\x88\x01\x01B\x05\n" +
-	"\x03_idB\x15\n" +
-	"\x13_short_descriptionsB\x17\n" +
-	"\x15_detailed_descriptionB\r\n" +
-	"\v_start_dateB\v\n" +
-	"\t_draft_idB\n" +
-	"\n" +
-	"\b_goal_idB\x0e\n" +
-	"\f_update_typeB\x14\n" +
-	"\x12_repeat_start_dateB\x12\n" +
-	"\x10_repeat_end_date\"\x81\x01\n" +
-	"\x12UpsertWorkResponse\x12\x1d\n" +
-	"\n" +
-	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
-	"\x05error\x18\x04 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"\xae\x03\n" +
-	"\x0fGetWorksRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
-	"\tfrom_date\x18\x02 \x01(\x03H\x00R\bfromDate\x88\x01\x01\x12\x1c\n" +
-	"\ato_date\x18\x03 \x01(\x03H\x01R\x06toDate\x88\x01\x01\x12\x1b\n" +
-	"\x06search\x18\x04 \x01(\tH\x02R\x06search\x88\x01\x01\x12 \n" +
-	"\tstatus_id\x18\x06 \x01(\tH\x03R\bstatusId\x88\x01\x01\x12(\n" +
-	"\rdifficulty_id\x18\a \x01(\tH\x04R\fdifficultyId\x88\x01\x01\x12$\n" +
-	"\vpriority_id\x18\b \x01(\tH\x05R\n" +
-	"priorityId\x88\x01\x01\x12\x1c\n" +
-	"\atype_id\x18\t \x01(\tH\x06R\x06typeId\x88\x01\x01\x12$\n" +
-	"\vcategory_id\x18\n" +
-	" \x01(\tH\aR\n" +
-	"categoryId\x88\x01\x01B\f\n" +
-	"\n" +
-	"_from_dateB\n" +
-	"\n" +
-	"\b_to_dateB\t\n" +
-	"\a_searchB\f\n" +
-	"\n" +
-	"_status_idB\x10\n" +
-	"\x0e_difficulty_idB\x0e\n" +
-	"\f_priority_idB\n" +
-	"\n" +
-	"\b_type_idB\x0e\n" +
-	"\f_category_id\"\x96\x01\n" +
-	"\x10GetWorksResponse\x12-\n" +
-	"\x05works\x18\x01 \x03(\v2\x17.personal_schedule.WorkR\x05works\x12\x1f\n" +
-	"\vtotal_works\x18\x02 \x01(\x05R\n" +
-	"totalWorks\x12(\n" +
-	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"B\n" +
-	"\x0eGetWorkRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\awork_id\x18\x02 \x01(\tR\x06workId\"x\n" +
-	"\x0fGetWorkResponse\x121\n" +
-	"\x04work\x18\x01 \x01(\v2\x1d.personal_schedule.WorkDetailR\x04work\x12(\n" +
-	"\x05error\x18\x02 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"E\n" +
-	"\x11DeleteWorkRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\awork_id\x18\x02 \x01(\tR\x06workId\"\x8d\x01\n" +
-	"\x12DeleteWorkResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12(\n" +
-	"\x05error\x18\x02 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01\x12\x1d\n" +
-	"\amessage\x18\x03 \x01(\tH\x01R\amessage\x88\x01\x01B\b\n" +
-	"\x06_errorB\n" +
-	"\n" +
-	"\b_message\"t\n" +
-	"\x17GetRecoveryWorksRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
-	"\vtarget_date\x18\x02 \x01(\x03R\n" +
-	"targetDate\x12\x1f\n" +
-	"\vsource_date\x18\x03 \x01(\x03R\n" +
-	"sourceDate\"\x87\x01\n" +
-	"\x18GetRecoveryWorksResponse\x12\x1d\n" +
-	"\n" +
-	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
-	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"\x84\x01\n" +
-	"\x16UpdateWorkLabelRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\awork_id\x18\x02 \x01(\tR\x06workId\x12\x1d\n" +
-	"\n" +
-	"label_type\x18\x03 \x01(\x05R\tlabelType\x12\x19\n" +
-	"\blabel_id\x18\x04 \x01(\tR\alabelId\"\x86\x01\n" +
-	"\x17UpdateWorkLabelResponse\x12\x1d\n" +
-	"\n" +
-	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
-	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"5\n" +
-	"\x1aSaveDraftAsRealWorkRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x8a\x01\n" +
-	"\x1bSaveDraftAsRealWorkResponse\x12\x1d\n" +
-	"\n" +
-	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
-	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"5\n" +
-	"\x1aDeleteAllDraftWorksRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x8a\x01\n" +
-	"\x1bDeleteAllDraftWorksResponse\x12\x1d\n" +
-	"\n" +
-	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
-	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"\x9b\x01\n" +
-	"\x18GenerateWorksByAIRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
-	"\aprompts\x18\x02 \x03(\tR\aprompts\x12\x1d\n" +
-	"\n" +
-	"local_date\x18\x03 \x01(\tR\tlocalDate\x12-\n" +
-	"\x12additional_context\x18\x04 \x01(\tR\x11additionalContext2\x86\a\n" +
+func (x *TimeInterval) Reset() {
+	*x = TimeInterval{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeInterval) ProtoMessage() {}
+
+func (x *TimeInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeInterval.ProtoReflect.Descriptor instead.
+func (*TimeInterval) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TimeInterval) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TimeInterval) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type CandidateInterval struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// echoed back in the result, eg. the draft or work id
+	RefId *string `protobuf:"bytes,1,opt,name=ref_id,json=refId,proto3,oneof" json:"ref_id"`
+	Start int64   `protobuf:"varint,2,opt,name=start,proto3" json:"start"`
+	End   int64   `protobuf:"varint,3,opt,name=end,proto3" json:"end"`
+	// the work being moved, it does not conflict with itself
+	ExcludeWorkId *string `protobuf:"bytes,4,opt,name=exclude_work_id,json=excludeWorkId,proto3,oneof" json:"exclude_work_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CandidateInterval) Reset() {
+	*x = CandidateInterval{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CandidateInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandidateInterval) ProtoMessage() {}
+
+func (x *CandidateInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandidateInterval.ProtoReflect.Descriptor instead.
+func (*CandidateInterval) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CandidateInterval) GetRefId() string {
+	if x != nil && x.RefId != nil {
+		return *x.RefId
+	}
+	return ""
+}
+
+func (x *CandidateInterval) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *CandidateInterval) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *CandidateInterval) GetExcludeWorkId() string {
+	if x != nil && x.ExcludeWorkId != nil {
+		return *x.ExcludeWorkId
+	}
+	return ""
+}
+
+type ConflictingWork struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	StartDate     int64                  `protobuf:"varint,3,opt,name=start_date,json=startDate,proto3" json:"start_date"`
+	EndDate       int64                  `protobuf:"varint,4,opt,name=end_date,json=endDate,proto3" json:"end_date"`
+	RepeatedId    *string                `protobuf:"bytes,5,opt,name=repeated_id,json=repeatedId,proto3,oneof" json:"repeated_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConflictingWork) Reset() {
+	*x = ConflictingWork{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConflictingWork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConflictingWork) ProtoMessage() {}
+
+func (x *ConflictingWork) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConflictingWork.ProtoReflect.Descriptor instead.
+func (*ConflictingWork) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConflictingWork) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConflictingWork) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConflictingWork) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *ConflictingWork) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *ConflictingWork) GetRepeatedId() string {
+	if x != nil && x.RepeatedId != nil {
+		return *x.RepeatedId
+	}
+	return ""
+}
+
+type ScheduleConflict struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Candidate *CandidateInterval     `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate"`
+	Conflicts []*ConflictingWork     `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts"`
+	// nearest free slots with the duration of the candidate
+	Suggestions   []*TimeInterval `protobuf:"bytes,3,rep,name=suggestions,proto3" json:"suggestions"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleConflict) Reset() {
+	*x = ScheduleConflict{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleConflict) ProtoMessage() {}
+
+func (x *ScheduleConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleConflict.ProtoReflect.Descriptor instead.
+func (*ScheduleConflict) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ScheduleConflict) GetCandidate() *CandidateInterval {
+	if x != nil {
+		return x.Candidate
+	}
+	return nil
+}
+
+func (x *ScheduleConflict) GetConflicts() []*ConflictingWork {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *ScheduleConflict) GetSuggestions() []*TimeInterval {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type CheckScheduleConflictsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Candidates []*CandidateInterval   `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates"`
+	// suggestions per conflicting candidate, defaults to 3
+	MaxSuggestions *int32 `protobuf:"varint,3,opt,name=max_suggestions,json=maxSuggestions,proto3,oneof" json:"max_suggestions"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckScheduleConflictsRequest) Reset() {
+	*x = CheckScheduleConflictsRequest{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckScheduleConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckScheduleConflictsRequest) ProtoMessage() {}
+
+func (x *CheckScheduleConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckScheduleConflictsRequest.ProtoReflect.Descriptor instead.
+func (*CheckScheduleConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckScheduleConflictsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckScheduleConflictsRequest) GetCandidates() []*CandidateInterval {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *CheckScheduleConflictsRequest) GetMaxSuggestions() int32 {
+	if x != nil && x.MaxSuggestions != nil {
+		return *x.MaxSuggestions
+	}
+	return 0
+}
+
+type CheckScheduleConflictsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// one result per candidate, in the request order
+	Results       []*ScheduleConflict `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	HasConflicts  bool                `protobuf:"varint,2,opt,name=has_conflicts,json=hasConflicts,proto3" json:"has_conflicts"`
+	Error         *common.Error       `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckScheduleConflictsResponse) Reset() {
+	*x = CheckScheduleConflictsResponse{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckScheduleConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckScheduleConflictsResponse) ProtoMessage() {}
+
+func (x *CheckScheduleConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckScheduleConflictsResponse.ProtoReflect.Descriptor instead.
+func (*CheckScheduleConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckScheduleConflictsResponse) GetResults() []*ScheduleConflict {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *CheckScheduleConflictsResponse) GetHasConflicts() bool {
+	if x != nil {
+		return x.HasConflicts
+	}
+	return false
+}
+
+func (x *CheckScheduleConflictsResponse) GetError() *common.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type FindFreeTimeRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	From       int64                  `protobuf:"varint,2,opt,name=from,proto3" json:"from"`
+	To         int64                  `protobuf:"varint,3,opt,name=to,proto3" json:"to"`
+	DurationMs int64                  `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms"`
+	// defaults to the working hours of the user, without any the whole days are searched
+	WorkingHours []*WorkingHours `protobuf:"bytes,5,rep,name=working_hours,json=workingHours,proto3" json:"working_hours"`
+	// minimum free time kept before and after every work
+	BufferMs int64 `protobuf:"varint,6,opt,name=buffer_ms,json=bufferMs,proto3" json:"buffer_ms"`
+	// IANA time zone of the working hours, defaults to the time zone of the user
+	TimeZone *string       `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone"`
+	Order    FreeTimeOrder `protobuf:"varint,8,opt,name=order,proto3,enum=personal_schedule.FreeTimeOrder" json:"order"`
+	// defaults to 20
+	Limit         *int32 `protobuf:"varint,9,opt,name=limit,proto3,oneof" json:"limit"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindFreeTimeRequest) Reset() {
+	*x = FindFreeTimeRequest{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindFreeTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindFreeTimeRequest) ProtoMessage() {}
+
+func (x *FindFreeTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindFreeTimeRequest.ProtoReflect.Descriptor instead.
+func (*FindFreeTimeRequest) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FindFreeTimeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FindFreeTimeRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *FindFreeTimeRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *FindFreeTimeRequest) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *FindFreeTimeRequest) GetWorkingHours() []*WorkingHours {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
+func (x *FindFreeTimeRequest) GetBufferMs() int64 {
+	if x != nil {
+		return x.BufferMs
+	}
+	return 0
+}
+
+func (x *FindFreeTimeRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *FindFreeTimeRequest) GetOrder() FreeTimeOrder {
+	if x != nil {
+		return x.Order
+	}
+	return FreeTimeOrder_FREE_TIME_ORDER_EARLIEST
+}
+
+func (x *FindFreeTimeRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type FreeInterval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start"`
+	End           int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end"`
+	DurationMs    int64                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreeInterval) Reset() {
+	*x = FreeInterval{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreeInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeInterval) ProtoMessage() {}
+
+func (x *FreeInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeInterval.ProtoReflect.Descriptor instead.
+func (*FreeInterval) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FreeInterval) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *FreeInterval) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *FreeInterval) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type FindFreeTimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Intervals     []*FreeInterval        `protobuf:"bytes,1,rep,name=intervals,proto3" json:"intervals"`
+	Error         *common.Error          `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindFreeTimeResponse) Reset() {
+	*x = FindFreeTimeResponse{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindFreeTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindFreeTimeResponse) ProtoMessage() {}
+
+func (x *FindFreeTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindFreeTimeResponse.ProtoReflect.Descriptor instead.
+func (*FindFreeTimeResponse) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FindFreeTimeResponse) GetIntervals() []*FreeInterval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+func (x *FindFreeTimeResponse) GetError() *common.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type AutoScheduleRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	// works to place, when empty every unscheduled work ending in [from, to) is placed
+	WorkIds []string `protobuf:"bytes,2,rep,name=work_ids,json=workIds,proto3" json:"work_ids"`
+	From    int64    `protobuf:"varint,3,opt,name=from,proto3" json:"from"`
+	To      int64    `protobuf:"varint,4,opt,name=to,proto3" json:"to"`
+	// used for the works without an entry in durations_ms
+	DefaultDurationMs int64            `protobuf:"varint,5,opt,name=default_duration_ms,json=defaultDurationMs,proto3" json:"default_duration_ms"`
+	DurationsMs       map[string]int64 `protobuf:"bytes,6,rep,name=durations_ms,json=durationsMs,proto3" json:"durations_ms,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// defaults to the working hours of the user, the daily capacity of the user is kept as well
+	WorkingHours []*WorkingHours `protobuf:"bytes,7,rep,name=working_hours,json=workingHours,proto3" json:"working_hours"`
+	BufferMs     int64           `protobuf:"varint,8,opt,name=buffer_ms,json=bufferMs,proto3" json:"buffer_ms"`
+	// IANA time zone of the working hours, defaults to the time zone of the user
+	TimeZone      *string `protobuf:"bytes,9,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutoScheduleRequest) Reset() {
+	*x = AutoScheduleRequest{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoScheduleRequest) ProtoMessage() {}
+
+func (x *AutoScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoScheduleRequest.ProtoReflect.Descriptor instead.
+func (*AutoScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AutoScheduleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AutoScheduleRequest) GetWorkIds() []string {
+	if x != nil {
+		return x.WorkIds
+	}
+	return nil
+}
+
+func (x *AutoScheduleRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *AutoScheduleRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *AutoScheduleRequest) GetDefaultDurationMs() int64 {
+	if x != nil {
+		return x.DefaultDurationMs
+	}
+	return 0
+}
+
+func (x *AutoScheduleRequest) GetDurationsMs() map[string]int64 {
+	if x != nil {
+		return x.DurationsMs
+	}
+	return nil
+}
+
+func (x *AutoScheduleRequest) GetWorkingHours() []*WorkingHours {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
+func (x *AutoScheduleRequest) GetBufferMs() int64 {
+	if x != nil {
+		return x.BufferMs
+	}
+	return 0
+}
+
+func (x *AutoScheduleRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+type AutoScheduledWork struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	WorkId string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id"`
+	// the DRAFT work holding the placement, accepted with SaveDraftAsRealWork
+	DraftWorkId   string `protobuf:"bytes,2,opt,name=draft_work_id,json=draftWorkId,proto3" json:"draft_work_id"`
+	Start         int64  `protobuf:"varint,3,opt,name=start,proto3" json:"start"`
+	End           int64  `protobuf:"varint,4,opt,name=end,proto3" json:"end"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutoScheduledWork) Reset() {
+	*x = AutoScheduledWork{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoScheduledWork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoScheduledWork) ProtoMessage() {}
+
+func (x *AutoScheduledWork) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoScheduledWork.ProtoReflect.Descriptor instead.
+func (*AutoScheduledWork) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AutoScheduledWork) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *AutoScheduledWork) GetDraftWorkId() string {
+	if x != nil {
+		return x.DraftWorkId
+	}
+	return ""
+}
+
+func (x *AutoScheduledWork) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *AutoScheduledWork) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type UnplacedWork struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkId        string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnplacedWork) Reset() {
+	*x = UnplacedWork{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnplacedWork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnplacedWork) ProtoMessage() {}
+
+func (x *UnplacedWork) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnplacedWork.ProtoReflect.Descriptor instead.
+func (*UnplacedWork) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnplacedWork) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *UnplacedWork) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AutoScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scheduled     []*AutoScheduledWork   `protobuf:"bytes,1,rep,name=scheduled,proto3" json:"scheduled"`
+	Unplaced      []*UnplacedWork        `protobuf:"bytes,2,rep,name=unplaced,proto3" json:"unplaced"`
+	Error         *common.Error          `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutoScheduleResponse) Reset() {
+	*x = AutoScheduleResponse{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoScheduleResponse) ProtoMessage() {}
+
+func (x *AutoScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoScheduleResponse.ProtoReflect.Descriptor instead.
+func (*AutoScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AutoScheduleResponse) GetScheduled() []*AutoScheduledWork {
+	if x != nil {
+		return x.Scheduled
+	}
+	return nil
+}
+
+func (x *AutoScheduleResponse) GetUnplaced() []*UnplacedWork {
+	if x != nil {
+		return x.Unplaced
+	}
+	return nil
+}
+
+func (x *AutoScheduleResponse) GetError() *common.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type WorkHistoryEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type"`
+	Field         string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field"`
+	From          *LabelInfo             `protobuf:"bytes,4,opt,name=from,proto3,oneof" json:"from"`
+	To            *LabelInfo             `protobuf:"bytes,5,opt,name=to,proto3,oneof" json:"to"`
+	ActorId       string                 `protobuf:"bytes,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id"`
+	Reason        *string                `protobuf:"bytes,7,opt,name=reason,proto3,oneof" json:"reason"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkHistoryEvent) Reset() {
+	*x = WorkHistoryEvent{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkHistoryEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkHistoryEvent) ProtoMessage() {}
+
+func (x *WorkHistoryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkHistoryEvent.ProtoReflect.Descriptor instead.
+func (*WorkHistoryEvent) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WorkHistoryEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkHistoryEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WorkHistoryEvent) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *WorkHistoryEvent) GetFrom() *LabelInfo {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *WorkHistoryEvent) GetTo() *LabelInfo {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *WorkHistoryEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *WorkHistoryEvent) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *WorkHistoryEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type GetWorkHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	WorkId        string                 `protobuf:"bytes,2,opt,name=work_id,json=workId,proto3" json:"work_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkHistoryRequest) Reset() {
+	*x = GetWorkHistoryRequest{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkHistoryRequest) ProtoMessage() {}
+
+func (x *GetWorkHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetWorkHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetWorkHistoryRequest) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+type GetWorkHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*WorkHistoryEvent    `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
+	Error         *common.Error          `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkHistoryResponse) Reset() {
+	*x = GetWorkHistoryResponse{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkHistoryResponse) ProtoMessage() {}
+
+func (x *GetWorkHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetWorkHistoryResponse) GetEvents() []*WorkHistoryEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetWorkHistoryResponse) GetError() *common.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type WorkLabelChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// same types as UpdateWorkLabelRequest.label_type
+	LabelType     int32  `protobuf:"varint,1,opt,name=label_type,json=labelType,proto3" json:"label_type"`
+	LabelId       string `protobuf:"bytes,2,opt,name=label_id,json=labelId,proto3" json:"label_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkLabelChange) Reset() {
+	*x = WorkLabelChange{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkLabelChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkLabelChange) ProtoMessage() {}
+
+func (x *WorkLabelChange) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkLabelChange.ProtoReflect.Descriptor instead.
+func (*WorkLabelChange) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WorkLabelChange) GetLabelType() int32 {
+	if x != nil {
+		return x.LabelType
+	}
+	return 0
+}
+
+func (x *WorkLabelChange) GetLabelId() string {
+	if x != nil {
+		return x.LabelId
+	}
+	return ""
+}
+
+type BulkWorkPatch struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Labels []*WorkLabelChange     `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels"`
+	// links the works to the goal, an empty goal_id unlinks them
+	GoalId *string `protobuf:"bytes,2,opt,name=goal_id,json=goalId,proto3,oneof" json:"goal_id"`
+	// moved by this many milliseconds, both the start and end dates
+	ShiftMs       int64 `protobuf:"varint,3,opt,name=shift_ms,json=shiftMs,proto3" json:"shift_ms"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkWorkPatch) Reset() {
+	*x = BulkWorkPatch{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkWorkPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkWorkPatch) ProtoMessage() {}
+
+func (x *BulkWorkPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkWorkPatch.ProtoReflect.Descriptor instead.
+func (*BulkWorkPatch) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BulkWorkPatch) GetLabels() []*WorkLabelChange {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *BulkWorkPatch) GetGoalId() string {
+	if x != nil && x.GoalId != nil {
+		return *x.GoalId
+	}
+	return ""
+}
+
+func (x *BulkWorkPatch) GetShiftMs() int64 {
+	if x != nil {
+		return x.ShiftMs
+	}
+	return 0
+}
+
+type BulkWorkResult struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WorkId    string                 `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id"`
+	IsSuccess bool                   `protobuf:"varint,2,opt,name=is_success,json=isSuccess,proto3" json:"is_success"`
+	// set when the work was not changed, a work of another user is not found
+	Error         *common.Error `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkWorkResult) Reset() {
+	*x = BulkWorkResult{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkWorkResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkWorkResult) ProtoMessage() {}
+
+func (x *BulkWorkResult) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkWorkResult.ProtoReflect.Descriptor instead.
+func (*BulkWorkResult) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BulkWorkResult) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
+
+func (x *BulkWorkResult) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
+func (x *BulkWorkResult) GetError() *common.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type BulkUpdateWorksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	// at most 200 works, duplicates are ignored
+	WorkIds       []string       `protobuf:"bytes,2,rep,name=work_ids,json=workIds,proto3" json:"work_ids"`
+	Patch         *BulkWorkPatch `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateWorksRequest) Reset() {
+	*x = BulkUpdateWorksRequest{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateWorksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateWorksRequest) ProtoMessage() {}
+
+func (x *BulkUpdateWorksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateWorksRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateWorksRequest) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BulkUpdateWorksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BulkUpdateWorksRequest) GetWorkIds() []string {
+	if x != nil {
+		return x.WorkIds
+	}
+	return nil
+}
+
+func (x *BulkUpdateWorksRequest) GetPatch() *BulkWorkPatch {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+type BulkUpdateWorksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess     bool                   `protobuf:"varint,1,opt,name=is_success,json=isSuccess,proto3" json:"is_success"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	UpdatedCount  int32                  `protobuf:"varint,3,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count"`
+	Results       []*BulkWorkResult      `protobuf:"bytes,4,rep,name=results,proto3" json:"results"`
+	Error         *common.Error          `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateWorksResponse) Reset() {
+	*x = BulkUpdateWorksResponse{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateWorksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateWorksResponse) ProtoMessage() {}
+
+func (x *BulkUpdateWorksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateWorksResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateWorksResponse) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BulkUpdateWorksResponse) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
+func (x *BulkUpdateWorksResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BulkUpdateWorksResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *BulkUpdateWorksResponse) GetResults() []*BulkWorkResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkUpdateWorksResponse) GetError() *common.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type BulkDeleteWorksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	// at most 200 works, duplicates are ignored
+	WorkIds       []string `protobuf:"bytes,2,rep,name=work_ids,json=workIds,proto3" json:"work_ids"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkDeleteWorksRequest) Reset() {
+	*x = BulkDeleteWorksRequest{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteWorksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteWorksRequest) ProtoMessage() {}
+
+func (x *BulkDeleteWorksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteWorksRequest.ProtoReflect.Descriptor instead.
+func (*BulkDeleteWorksRequest) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BulkDeleteWorksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BulkDeleteWorksRequest) GetWorkIds() []string {
+	if x != nil {
+		return x.WorkIds
+	}
+	return nil
+}
+
+type BulkDeleteWorksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess     bool                   `protobuf:"varint,1,opt,name=is_success,json=isSuccess,proto3" json:"is_success"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	DeletedCount  int32                  `protobuf:"varint,3,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count"`
+	Results       []*BulkWorkResult      `protobuf:"bytes,4,rep,name=results,proto3" json:"results"`
+	Error         *common.Error          `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkDeleteWorksResponse) Reset() {
+	*x = BulkDeleteWorksResponse{}
+	mi := &file_personal_schedule_service_work_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteWorksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteWorksResponse) ProtoMessage() {}
+
+func (x *BulkDeleteWorksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_personal_schedule_service_work_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteWorksResponse.ProtoReflect.Descriptor instead.
+func (*BulkDeleteWorksResponse) Descriptor() ([]byte, []int) {
+	return file_personal_schedule_service_work_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BulkDeleteWorksResponse) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
+func (x *BulkDeleteWorksResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BulkDeleteWorksResponse) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+func (x *BulkDeleteWorksResponse) GetResults() []*BulkWorkResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkDeleteWorksResponse) GetError() *common.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+var File_personal_schedule_service_work_proto protoreflect.FileDescriptor
+
+const file_personal_schedule_service_work_proto_rawDesc = "" +
+	"\n" +
+	"$personal_schedule_service/work.proto\x12\x11personal_schedule\x1a/personal_schedule_service/common.schedule.proto\x1a\x12common/error.proto\x1a\x13common/common.proto\x1a\x17common/pagination.proto\"\xee\a\n" +
+	"\x11UpsertWorkRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x13\n" +
+	"\x02id\x18\x02 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x122\n" +
+	"\x12short_descriptions\x18\x04 \x01(\tH\x01R\x11shortDescriptions\x88\x01\x01\x126\n" +
+	"\x14detailed_description\x18\x05 \x01(\tH\x02R\x13detailedDescription\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\x03H\x03R\tstartDate\x88\x01\x01\x12\x19\n" +
+	"\bend_date\x18\a \x01(\x03R\aendDate\x12\x1b\n" +
+	"\tstatus_id\x18\b \x01(\tR\bstatusId\x12#\n" +
+	"\rdifficulty_id\x18\t \x01(\tR\fdifficultyId\x12\x1f\n" +
+	"\vpriority_id\x18\n" +
+	" \x01(\tR\n" +
+	"priorityId\x12\x17\n" +
+	"\atype_id\x18\v \x01(\tR\x06typeId\x12\x1f\n" +
+	"\vcategory_id\x18\f \x01(\tR\n" +
+	"categoryId\x12\x1e\n" +
+	"\bdraft_id\x18\r \x01(\tH\x04R\adraftId\x88\x01\x01\x12\x1c\n" +
+	"\agoal_id\x18\x0e \x01(\tH\x05R\x06goalId\x88\x01\x01\x12>\n" +
+	"\tsub_tasks\x18\x0f \x03(\v2!.personal_schedule.SubTaskPayloadR\bsubTasks\x12I\n" +
+	"\rnotifications\x18\x10 \x03(\v2#.personal_schedule.WorkNotificationR\rnotifications\x12$\n" +
+	"\vupdate_type\x18\x11 \x01(\x05H\x06R\n" +
+	"updateType\x88\x01\x01\x12/\n" +
+	"\x11repeat_start_date\x18\x12 \x01(\x03H\aR\x0frepeatStartDate\x88\x01\x01\x12+\n" +
+	"\x0frepeat_end_date\x18\x13 \x01(\x03H\bR\rrepeatEndDate\x88\x01\x01\x12F\n" +
+	"\n" +
+	"recurrence\x18\x14 \x01(\v2!.personal_schedule.RecurrenceRuleH\tR\n" +
+	"recurrence\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x15 \x03(\tR\x04tagsB\x05\n" +
+	"\x03_idB\x15\n" +
+	"\x13_short_descriptionsB\x17\n" +
+	"\x15_detailed_descriptionB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_draft_idB\n" +
+	"\n" +
+	"\b_goal_idB\x0e\n" +
+	"\f_update_typeB\x14\n" +
+	"\x12_repeat_start_dateB\x12\n" +
+	"\x10_repeat_end_dateB\r\n" +
+	"\v_recurrence\"\xc4\x01\n" +
+	"\x12UpsertWorkResponse\x12\x1d\n" +
+	"\n" +
+	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x05error\x18\x04 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01\x12A\n" +
+	"\tconflicts\x18\x05 \x03(\v2#.personal_schedule.ScheduleConflictR\tconflictsB\b\n" +
+	"\x06_error\"\xb2\x05\n" +
+	"\x0fGetWorksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\tfrom_date\x18\x02 \x01(\x03H\x00R\bfromDate\x88\x01\x01\x12\x1c\n" +
+	"\ato_date\x18\x03 \x01(\x03H\x01R\x06toDate\x88\x01\x01\x12\x1b\n" +
+	"\x06search\x18\x04 \x01(\tH\x02R\x06search\x88\x01\x01\x12 \n" +
+	"\tstatus_id\x18\x06 \x01(\tH\x03R\bstatusId\x88\x01\x01\x12(\n" +
+	"\rdifficulty_id\x18\a \x01(\tH\x04R\fdifficultyId\x88\x01\x01\x12$\n" +
+	"\vpriority_id\x18\b \x01(\tH\x05R\n" +
+	"priorityId\x88\x01\x01\x12\x1c\n" +
+	"\atype_id\x18\t \x01(\tH\x06R\x06typeId\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\n" +
+	" \x01(\tH\aR\n" +
+	"categoryId\x88\x01\x01\x120\n" +
+	"\n" +
+	"page_query\x18\v \x01(\v2\x11.common.PageQueryR\tpageQuery\x129\n" +
+	"\bsort_key\x18\f \x01(\x0e2\x1e.personal_schedule.WorkSortKeyR\asortKey\x12'\n" +
+	"\x0fsort_descending\x18\r \x01(\bR\x0esortDescending\x12\x1b\n" +
+	"\x06cursor\x18\x0e \x01(\tH\bR\x06cursor\x88\x01\x01\x12!\n" +
+	"\finclude_tags\x18\x0f \x03(\tR\vincludeTags\x12!\n" +
+	"\fexclude_tags\x18\x10 \x03(\tR\vexcludeTagsB\f\n" +
+	"\n" +
+	"_from_dateB\n" +
+	"\n" +
+	"\b_to_dateB\t\n" +
+	"\a_searchB\f\n" +
+	"\n" +
+	"_status_idB\x10\n" +
+	"\x0e_difficulty_idB\x0e\n" +
+	"\f_priority_idB\n" +
+	"\n" +
+	"\b_type_idB\x0e\n" +
+	"\f_category_idB\t\n" +
+	"\a_cursor\"\xfb\x01\n" +
+	"\x10GetWorksResponse\x12-\n" +
+	"\x05works\x18\x01 \x03(\v2\x17.personal_schedule.WorkR\x05works\x12\x1f\n" +
+	"\vtotal_works\x18\x02 \x01(\x05R\n" +
+	"totalWorks\x12(\n" +
+	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01\x12-\n" +
+	"\tpage_info\x18\x04 \x01(\v2\x10.common.PageInfoR\bpageInfo\x12$\n" +
+	"\vnext_cursor\x18\x05 \x01(\tH\x01R\n" +
+	"nextCursor\x88\x01\x01B\b\n" +
+	"\x06_errorB\x0e\n" +
+	"\f_next_cursor\"B\n" +
+	"\x0eGetWorkRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\awork_id\x18\x02 \x01(\tR\x06workId\"x\n" +
+	"\x0fGetWorkResponse\x121\n" +
+	"\x04work\x18\x01 \x01(\v2\x1d.personal_schedule.WorkDetailR\x04work\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"E\n" +
+	"\x11DeleteWorkRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\awork_id\x18\x02 \x01(\tR\x06workId\"\x8d\x01\n" +
+	"\x12DeleteWorkResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01\x12\x1d\n" +
+	"\amessage\x18\x03 \x01(\tH\x01R\amessage\x88\x01\x01B\b\n" +
+	"\x06_errorB\n" +
+	"\n" +
+	"\b_message\"t\n" +
+	"\x17GetRecoveryWorksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vtarget_date\x18\x02 \x01(\x03R\n" +
+	"targetDate\x12\x1f\n" +
+	"\vsource_date\x18\x03 \x01(\x03R\n" +
+	"sourceDate\"\xca\x01\n" +
+	"\x18GetRecoveryWorksResponse\x12\x1d\n" +
+	"\n" +
+	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01\x12A\n" +
+	"\tconflicts\x18\x04 \x03(\v2#.personal_schedule.ScheduleConflictR\tconflictsB\b\n" +
+	"\x06_error\"\x84\x01\n" +
+	"\x16UpdateWorkLabelRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\awork_id\x18\x02 \x01(\tR\x06workId\x12\x1d\n" +
+	"\n" +
+	"label_type\x18\x03 \x01(\x05R\tlabelType\x12\x19\n" +
+	"\blabel_id\x18\x04 \x01(\tR\alabelId\"\x86\x01\n" +
+	"\x17UpdateWorkLabelResponse\x12\x1d\n" +
+	"\n" +
+	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"5\n" +
+	"\x1aSaveDraftAsRealWorkRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xcd\x01\n" +
+	"\x1bSaveDraftAsRealWorkResponse\x12\x1d\n" +
+	"\n" +
+	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01\x12A\n" +
+	"\tconflicts\x18\x04 \x03(\v2#.personal_schedule.ScheduleConflictR\tconflictsB\b\n" +
+	"\x06_error\"5\n" +
+	"\x1aDeleteAllDraftWorksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x8a\x01\n" +
+	"\x1bDeleteAllDraftWorksResponse\x12\x1d\n" +
+	"\n" +
+	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"\x9b\x01\n" +
+	"\x18GenerateWorksByAIRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aprompts\x18\x02 \x03(\tR\aprompts\x12\x1d\n" +
+	"\n" +
+	"local_date\x18\x03 \x01(\tR\tlocalDate\x12-\n" +
+	"\x12additional_context\x18\x04 \x01(\tR\x11additionalContext\"6\n" +
+	"\fTimeInterval\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x03R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x03R\x03end\"\xa3\x01\n" +
+	"\x11CandidateInterval\x12\x1a\n" +
+	"\x06ref_id\x18\x01 \x01(\tH\x00R\x05refId\x88\x01\x01\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x03R\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\x03R\x03end\x12+\n" +
+	"\x0fexclude_work_id\x18\x04 \x01(\tH\x01R\rexcludeWorkId\x88\x01\x01B\t\n" +
+	"\a_ref_idB\x12\n" +
+	"\x10_exclude_work_id\"\xa5\x01\n" +
+	"\x0fConflictingWork\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\x03R\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\x03R\aendDate\x12$\n" +
+	"\vrepeated_id\x18\x05 \x01(\tH\x00R\n" +
+	"repeatedId\x88\x01\x01B\x0e\n" +
+	"\f_repeated_id\"\xdb\x01\n" +
+	"\x10ScheduleConflict\x12B\n" +
+	"\tcandidate\x18\x01 \x01(\v2$.personal_schedule.CandidateIntervalR\tcandidate\x12@\n" +
+	"\tconflicts\x18\x02 \x03(\v2\".personal_schedule.ConflictingWorkR\tconflicts\x12A\n" +
+	"\vsuggestions\x18\x03 \x03(\v2\x1f.personal_schedule.TimeIntervalR\vsuggestions\"\xc0\x01\n" +
+	"\x1dCheckScheduleConflictsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12D\n" +
+	"\n" +
+	"candidates\x18\x02 \x03(\v2$.personal_schedule.CandidateIntervalR\n" +
+	"candidates\x12,\n" +
+	"\x0fmax_suggestions\x18\x03 \x01(\x05H\x00R\x0emaxSuggestions\x88\x01\x01B\x12\n" +
+	"\x10_max_suggestions\"\xb8\x01\n" +
+	"\x1eCheckScheduleConflictsResponse\x12=\n" +
+	"\aresults\x18\x01 \x03(\v2#.personal_schedule.ScheduleConflictR\aresults\x12#\n" +
+	"\rhas_conflicts\x18\x02 \x01(\bR\fhasConflicts\x12(\n" +
+	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"\xe3\x02\n" +
+	"\x13FindFreeTimeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\x03R\n" +
+	"durationMs\x12D\n" +
+	"\rworking_hours\x18\x05 \x03(\v2\x1f.personal_schedule.WorkingHoursR\fworkingHours\x12\x1b\n" +
+	"\tbuffer_ms\x18\x06 \x01(\x03R\bbufferMs\x12 \n" +
+	"\ttime_zone\x18\a \x01(\tH\x00R\btimeZone\x88\x01\x01\x126\n" +
+	"\x05order\x18\b \x01(\x0e2 .personal_schedule.FreeTimeOrderR\x05order\x12\x19\n" +
+	"\x05limit\x18\t \x01(\x05H\x01R\x05limit\x88\x01\x01B\f\n" +
+	"\n" +
+	"_time_zoneB\b\n" +
+	"\x06_limit\"W\n" +
+	"\fFreeInterval\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x03R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x03R\x03end\x12\x1f\n" +
+	"\vduration_ms\x18\x03 \x01(\x03R\n" +
+	"durationMs\"\x89\x01\n" +
+	"\x14FindFreeTimeResponse\x12=\n" +
+	"\tintervals\x18\x01 \x03(\v2\x1f.personal_schedule.FreeIntervalR\tintervals\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"\xcc\x03\n" +
+	"\x13AutoScheduleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bwork_ids\x18\x02 \x03(\tR\aworkIds\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\x03R\x02to\x12.\n" +
+	"\x13default_duration_ms\x18\x05 \x01(\x03R\x11defaultDurationMs\x12Z\n" +
+	"\fdurations_ms\x18\x06 \x03(\v27.personal_schedule.AutoScheduleRequest.DurationsMsEntryR\vdurationsMs\x12D\n" +
+	"\rworking_hours\x18\a \x03(\v2\x1f.personal_schedule.WorkingHoursR\fworkingHours\x12\x1b\n" +
+	"\tbuffer_ms\x18\b \x01(\x03R\bbufferMs\x12 \n" +
+	"\ttime_zone\x18\t \x01(\tH\x00R\btimeZone\x88\x01\x01\x1a>\n" +
+	"\x10DurationsMsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\f\n" +
+	"\n" +
+	"_time_zone\"x\n" +
+	"\x11AutoScheduledWork\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\"\n" +
+	"\rdraft_work_id\x18\x02 \x01(\tR\vdraftWorkId\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\x03R\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\x03R\x03end\"?\n" +
+	"\fUnplacedWork\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xcb\x01\n" +
+	"\x14AutoScheduleResponse\x12B\n" +
+	"\tscheduled\x18\x01 \x03(\v2$.personal_schedule.AutoScheduledWorkR\tscheduled\x12;\n" +
+	"\bunplaced\x18\x02 \x03(\v2\x1f.personal_schedule.UnplacedWorkR\bunplaced\x12(\n" +
+	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"\xb3\x02\n" +
+	"\x10WorkHistoryEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x125\n" +
+	"\x04from\x18\x04 \x01(\v2\x1c.personal_schedule.LabelInfoH\x00R\x04from\x88\x01\x01\x121\n" +
+	"\x02to\x18\x05 \x01(\v2\x1c.personal_schedule.LabelInfoH\x01R\x02to\x88\x01\x01\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\tR\aactorId\x12\x1b\n" +
+	"\x06reason\x18\a \x01(\tH\x02R\x06reason\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAtB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_toB\t\n" +
+	"\a_reason\"I\n" +
+	"\x15GetWorkHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\awork_id\x18\x02 \x01(\tR\x06workId\"\x89\x01\n" +
+	"\x16GetWorkHistoryResponse\x12;\n" +
+	"\x06events\x18\x01 \x03(\v2#.personal_schedule.WorkHistoryEventR\x06events\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"K\n" +
+	"\x0fWorkLabelChange\x12\x1d\n" +
+	"\n" +
+	"label_type\x18\x01 \x01(\x05R\tlabelType\x12\x19\n" +
+	"\blabel_id\x18\x02 \x01(\tR\alabelId\"\x90\x01\n" +
+	"\rBulkWorkPatch\x12:\n" +
+	"\x06labels\x18\x01 \x03(\v2\".personal_schedule.WorkLabelChangeR\x06labels\x12\x1c\n" +
+	"\agoal_id\x18\x02 \x01(\tH\x00R\x06goalId\x88\x01\x01\x12\x19\n" +
+	"\bshift_ms\x18\x03 \x01(\x03R\ashiftMsB\n" +
+	"\n" +
+	"\b_goal_id\"|\n" +
+	"\x0eBulkWorkResult\x12\x17\n" +
+	"\awork_id\x18\x01 \x01(\tR\x06workId\x12\x1d\n" +
+	"\n" +
+	"is_success\x18\x02 \x01(\bR\tisSuccess\x12(\n" +
+	"\x05error\x18\x03 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"\x84\x01\n" +
+	"\x16BulkUpdateWorksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bwork_ids\x18\x02 \x03(\tR\aworkIds\x126\n" +
+	"\x05patch\x18\x03 \x01(\v2 .personal_schedule.BulkWorkPatchR\x05patch\"\xe8\x01\n" +
+	"\x17BulkUpdateWorksResponse\x12\x1d\n" +
+	"\n" +
+	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12#\n" +
+	"\rupdated_count\x18\x03 \x01(\x05R\fupdatedCount\x12;\n" +
+	"\aresults\x18\x04 \x03(\v2!.personal_schedule.BulkWorkResultR\aresults\x12(\n" +
+	"\x05error\x18\x05 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"L\n" +
+	"\x16BulkDeleteWorksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bwork_ids\x18\x02 \x03(\tR\aworkIds\"\xe8\x01\n" +
+	"\x17BulkDeleteWorksResponse\x12\x1d\n" +
+	"\n" +
+	"is_success\x18\x01 \x01(\bR\tisSuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12#\n" +
+	"\rdeleted_count\x18\x03 \x01(\x05R\fdeletedCount\x12;\n" +
+	"\aresults\x18\x04 \x03(\v2!.personal_schedule.BulkWorkResultR\aresults\x12(\n" +
+	"\x05error\x18\x05 \x01(\v2\r.common.ErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error*J\n" +
+	"\rFreeTimeOrder\x12\x1c\n" +
+	"\x18FREE_TIME_ORDER_EARLIEST\x10\x00\x12\x1b\n" +
+	"\x17FREE_TIME_ORDER_LONGEST\x10\x01*\x87\x01\n" +
+	"\vWorkSortKey\x12\x1a\n" +
+	"\x16WORK_SORT_KEY_END_DATE\x10\x00\x12\x1c\n" +
+	"\x18WORK_SORT_KEY_START_DATE\x10\x01\x12\x1a\n" +
+	"\x16WORK_SORT_KEY_PRIORITY\x10\x02\x12\"\n" +
+	"\x1eWORK_SORT_KEY_LAST_MODIFIED_AT\x10\x032\x82\f\n" +
 	"\vWorkService\x12Y\n" +
 	"\n" +
 	"UpsertWork\x12$.personal_schedule.UpsertWorkRequest\x1a%.personal_schedule.UpsertWorkResponse\x12S\n" +
@@ -1329,7 +3262,13 @@ const file_personal_schedule_service_work_proto_rawDesc = "" +
 	"\x0fUpdateWorkLabel\x12).personal_schedule.UpdateWorkLabelRequest\x1a*.personal_schedule.UpdateWorkLabelResponse\x12t\n" +
 	"\x13SaveDraftAsRealWork\x12-.personal_schedule.SaveDraftAsRealWorkRequest\x1a..personal_schedule.SaveDraftAsRealWorkResponse\x12t\n" +
 	"\x13DeleteAllDraftWorks\x12-.personal_schedule.DeleteAllDraftWorksRequest\x1a..personal_schedule.DeleteAllDraftWorksResponse\x12W\n" +
-	"\x11GenerateWorksByAI\x12+.personal_schedule.GenerateWorksByAIRequest\x1a\x15.common.EmptyResponseB\x19Z\x17proto/personal_scheduleb\x06proto3"
+	"\x11GenerateWorksByAI\x12+.personal_schedule.GenerateWorksByAIRequest\x1a\x15.common.EmptyResponse\x12e\n" +
+	"\x0eGetWorkHistory\x12(.personal_schedule.GetWorkHistoryRequest\x1a).personal_schedule.GetWorkHistoryResponse\x12}\n" +
+	"\x16CheckScheduleConflicts\x120.personal_schedule.CheckScheduleConflictsRequest\x1a1.personal_schedule.CheckScheduleConflictsResponse\x12_\n" +
+	"\fFindFreeTime\x12&.personal_schedule.FindFreeTimeRequest\x1a'.personal_schedule.FindFreeTimeResponse\x12_\n" +
+	"\fAutoSchedule\x12&.personal_schedule.AutoScheduleRequest\x1a'.personal_schedule.AutoScheduleResponse\x12h\n" +
+	"\x0fBulkUpdateWorks\x12).personal_schedule.BulkUpdateWorksRequest\x1a*.personal_schedule.BulkUpdateWorksResponse\x12h\n" +
+	"\x0fBulkDeleteWorks\x12).personal_schedule.BulkDeleteWorksRequest\x1a*.personal_schedule.BulkDeleteWorksResponseB\x19Z\x17proto/personal_scheduleb\x06proto3"
 
 var (
 	file_personal_schedule_service_work_proto_rawDescOnce sync.Once
@@ -1343,68 +3282,145 @@ func file_personal_schedule_service_work_proto_rawDescGZIP() []byte {
 	return file_personal_schedule_service_work_proto_rawDescData
 }
 
-var file_personal_schedule_service_work_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_personal_schedule_service_work_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_personal_schedule_service_work_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_personal_schedule_service_work_proto_goTypes = []any{
-	(*UpsertWorkRequest)(nil),           // 0: personal_schedule.UpsertWorkRequest
-	(*UpsertWorkResponse)(nil),          // 1: personal_schedule.UpsertWorkResponse
-	(*GetWorksRequest)(nil),             // 2: personal_schedule.GetWorksRequest
-	(*GetWorksResponse)(nil),            // 3: personal_schedule.GetWorksResponse
-	(*GetWorkRequest)(nil),              // 4: personal_schedule.GetWorkRequest
-	(*GetWorkResponse)(nil),             // 5: personal_schedule.GetWorkResponse
-	(*DeleteWorkRequest)(nil),           // 6: personal_schedule.DeleteWorkRequest
-	(*DeleteWorkResponse)(nil),          // 7: personal_schedule.DeleteWorkResponse
-	(*GetRecoveryWorksRequest)(nil),     // 8: personal_schedule.GetRecoveryWorksRequest
-	(*GetRecoveryWorksResponse)(nil),    // 9: personal_schedule.GetRecoveryWorksResponse
-	(*UpdateWorkLabelRequest)(nil),      // 10: personal_schedule.UpdateWorkLabelRequest
-	(*UpdateWorkLabelResponse)(nil),     // 11: personal_schedule.UpdateWorkLabelResponse
-	(*SaveDraftAsRealWorkRequest)(nil),  // 12: personal_schedule.SaveDraftAsRealWorkRequest
-	(*SaveDraftAsRealWorkResponse)(nil), // 13: personal_schedule.SaveDraftAsRealWorkResponse
-	(*DeleteAllDraftWorksRequest)(nil),  // 14: personal_schedule.DeleteAllDraftWorksRequest
-	(*DeleteAllDraftWorksResponse)(nil), // 15: personal_schedule.DeleteAllDraftWorksResponse
-	(*GenerateWorksByAIRequest)(nil),    // 16: personal_schedule.GenerateWorksByAIRequest
-	(*SubTaskPayload)(nil),              // 17: personal_schedule.SubTaskPayload
-	(*WorkNotification)(nil),            // 18: personal_schedule.WorkNotification
-	(*common.Error)(nil),                // 19: common.Error
-	(*Work)(nil),                        // 20: personal_schedule.Work
-	(*WorkDetail)(nil),                  // 21: personal_schedule.WorkDetail
-	(*common.EmptyResponse)(nil),        // 22: common.EmptyResponse
+	(FreeTimeOrder)(0),                     // 0: personal_schedule.FreeTimeOrder
+	(WorkSortKey)(0),                       // 1: personal_schedule.WorkSortKey
+	(*UpsertWorkRequest)(nil),              // 2: personal_schedule.UpsertWorkRequest
+	(*UpsertWorkResponse)(nil),             // 3: personal_schedule.UpsertWorkResponse
+	(*GetWorksRequest)(nil),                // 4: personal_schedule.GetWorksRequest
+	(*GetWorksResponse)(nil),               // 5: personal_schedule.GetWorksResponse
+	(*GetWorkRequest)(nil),                 // 6: personal_schedule.GetWorkRequest
+	(*GetWorkResponse)(nil),                // 7: personal_schedule.GetWorkResponse
+	(*DeleteWorkRequest)(nil),              // 8: personal_schedule.DeleteWorkRequest
+	(*DeleteWorkResponse)(nil),             // 9: personal_schedule.DeleteWorkResponse
+	(*GetRecoveryWorksRequest)(nil),        // 10: personal_schedule.GetRecoveryWorksRequest
+	(*GetRecoveryWorksResponse)(nil),       // 11: personal_schedule.GetRecoveryWorksResponse
+	(*UpdateWorkLabelRequest)(nil),         // 12: personal_schedule.UpdateWorkLabelRequest
+	(*UpdateWorkLabelResponse)(nil),        // 13: personal_schedule.UpdateWorkLabelResponse
+	(*SaveDraftAsRealWorkRequest)(nil),     // 14: personal_schedule.SaveDraftAsRealWorkRequest
+	(*SaveDraftAsRealWorkResponse)(nil),    // 15: personal_schedule.SaveDraftAsRealWorkResponse
+	(*DeleteAllDraftWorksRequest)(nil),     // 16: personal_schedule.DeleteAllDraftWorksRequest
+	(*DeleteAllDraftWorksResponse)(nil),    // 17: personal_schedule.DeleteAllDraftWorksResponse
+	(*GenerateWorksByAIRequest)(nil),       // 18: personal_schedule.GenerateWorksByAIRequest
+	(*TimeInterval)(nil),                   // 19: personal_schedule.TimeInterval
+	(*CandidateInterval)(nil),              // 20: personal_schedule.CandidateInterval
+	(*ConflictingWork)(nil),                // 21: personal_schedule.ConflictingWork
+	(*ScheduleConflict)(nil),               // 22: personal_schedule.ScheduleConflict
+	(*CheckScheduleConflictsRequest)(nil),  // 23: personal_schedule.CheckScheduleConflictsRequest
+	(*CheckScheduleConflictsResponse)(nil), // 24: personal_schedule.CheckScheduleConflictsResponse
+	(*FindFreeTimeRequest)(nil),            // 25: personal_schedule.FindFreeTimeRequest
+	(*FreeInterval)(nil),                   // 26: personal_schedule.FreeInterval
+	(*FindFreeTimeResponse)(nil),           // 27: personal_schedule.FindFreeTimeResponse
+	(*AutoScheduleRequest)(nil),            // 28: personal_schedule.AutoScheduleRequest
+	(*AutoScheduledWork)(nil),              // 29: personal_schedule.AutoScheduledWork
+	(*UnplacedWork)(nil),                   // 30: personal_schedule.UnplacedWork
+	(*AutoScheduleResponse)(nil),           // 31: personal_schedule.AutoScheduleResponse
+	(*WorkHistoryEvent)(nil),               // 32: personal_schedule.WorkHistoryEvent
+	(*GetWorkHistoryRequest)(nil),          // 33: personal_schedule.GetWorkHistoryRequest
+	(*GetWorkHistoryResponse)(nil),         // 34: personal_schedule.GetWorkHistoryResponse
+	(*WorkLabelChange)(nil),                // 35: personal_schedule.WorkLabelChange
+	(*BulkWorkPatch)(nil),                  // 36: personal_schedule.BulkWorkPatch
+	(*BulkWorkResult)(nil),                 // 37: personal_schedule.BulkWorkResult
+	(*BulkUpdateWorksRequest)(nil),         // 38: personal_schedule.BulkUpdateWorksRequest
+	(*BulkUpdateWorksResponse)(nil),        // 39: personal_schedule.BulkUpdateWorksResponse
+	(*BulkDeleteWorksRequest)(nil),         // 40: personal_schedule.BulkDeleteWorksRequest
+	(*BulkDeleteWorksResponse)(nil),        // 41: personal_schedule.BulkDeleteWorksResponse
+	nil,                                    // 42: personal_schedule.AutoScheduleRequest.DurationsMsEntry
+	(*SubTaskPayload)(nil),                 // 43: personal_schedule.SubTaskPayload
+	(*WorkNotification)(nil),               // 44: personal_schedule.WorkNotification
+	(*RecurrenceRule)(nil),                 // 45: personal_schedule.RecurrenceRule
+	(*common.Error)(nil),                   // 46: common.Error
+	(*common.PageQuery)(nil),               // 47: common.PageQuery
+	(*Work)(nil),                           // 48: personal_schedule.Work
+	(*common.PageInfo)(nil),                // 49: common.PageInfo
+	(*WorkDetail)(nil),                     // 50: personal_schedule.WorkDetail
+	(*WorkingHours)(nil),                   // 51: personal_schedule.WorkingHours
+	(*LabelInfo)(nil),                      // 52: personal_schedule.LabelInfo
+	(*common.EmptyResponse)(nil),           // 53: common.EmptyResponse
 }
 var file_personal_schedule_service_work_proto_depIdxs = []int32{
-	17, // 0: personal_schedule.UpsertWorkRequest.sub_tasks:type_name -> personal_schedule.SubTaskPayload
-	18, // 1: personal_schedule.UpsertWorkRequest.notifications:type_name -> personal_schedule.WorkNotification
-	19, // 2: personal_schedule.UpsertWorkResponse.error:type_name -> common.Error
-	20, // 3: personal_schedule.GetWorksResponse.works:type_name -> personal_schedule.Work
-	19, // 4: personal_schedule.GetWorksResponse.error:type_name -> common.Error
-	21, // 5: personal_schedule.GetWorkResponse.work:type_name -> personal_schedule.WorkDetail
-	19, // 6: personal_schedule.GetWorkResponse.error:type_name -> common.Error
-	19, // 7: personal_schedule.DeleteWorkResponse.error:type_name -> common.Error
-	19, // 8: personal_schedule.GetRecoveryWorksResponse.error:type_name -> common.Error
-	19, // 9: personal_schedule.UpdateWorkLabelResponse.error:type_name -> common.Error
-	19, // 10: personal_schedule.SaveDraftAsRealWorkResponse.error:type_name -> common.Error
-	19, // 11: personal_schedule.DeleteAllDraftWorksResponse.error:type_name -> common.Error
-	0,  // 12: personal_schedule.WorkService.UpsertWork:input_type -> personal_schedule.UpsertWorkRequest
-	2,  // 13: personal_schedule.WorkService.GetWorks:input_type -> personal_schedule.GetWorksRequest
-	4,  // 14: personal_schedule.WorkService.GetWork:input_type -> personal_schedule.GetWorkRequest
-	6,  // 15: personal_schedule.WorkService.DeleteWork:input_type -> personal_schedule.DeleteWorkRequest
-	8,  // 16: personal_schedule.WorkService.GetRecoveryWorks:input_type -> personal_schedule.GetRecoveryWorksRequest
-	10, // 17: personal_schedule.WorkService.UpdateWorkLabel:input_type -> personal_schedule.UpdateWorkLabelRequest
-	12, // 18: personal_schedule.WorkService.SaveDraftAsRealWork:input_type -> personal_schedule.SaveDraftAsRealWorkRequest
-	14, // 19: personal_schedule.WorkService.DeleteAllDraftWorks:input_type -> personal_schedule.DeleteAllDraftWorksRequest
-	16, // 20: personal_schedule.WorkService.GenerateWorksByAI:input_type -> personal_schedule.GenerateWorksByAIRequest
-	1,  // 21: personal_schedule.WorkService.UpsertWork:output_type -> personal_schedule.UpsertWorkResponse
-	3,  // 22: personal_schedule.WorkService.GetWorks:output_type -> personal_schedule.GetWorksResponse
-	5,  // 23: personal_schedule.WorkService.GetWork:output_type -> personal_schedule.GetWorkResponse
-	7,  // 24: personal_schedule.WorkService.DeleteWork:output_type -> personal_schedule.DeleteWorkResponse
-	9,  // 25: personal_schedule.WorkService.GetRecoveryWorks:output_type -> personal_schedule.GetRecoveryWorksResponse
-	11, // 26: personal_schedule.WorkService.UpdateWorkLabel:output_type -> personal_schedule.UpdateWorkLabelResponse
-	13, // 27: personal_schedule.WorkService.SaveDraftAsRealWork:output_type -> personal_schedule.SaveDraftAsRealWorkResponse
-	15, // 28: personal_schedule.WorkService.DeleteAllDraftWorks:output_type -> personal_schedule.DeleteAllDraftWorksResponse
-	22, // 29: personal_schedule.WorkService.GenerateWorksByAI:output_type -> common.EmptyResponse
-	21, // [21:30] is the sub-list for method output_type
-	12, // [12:21] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	43, // 0: personal_schedule.UpsertWorkRequest.sub_tasks:type_name -> personal_schedule.SubTaskPayload
+	44, // 1: personal_schedule.UpsertWorkRequest.notifications:type_name -> personal_schedule.WorkNotification
+	45, // 2: personal_schedule.UpsertWorkRequest.recurrence:type_name -> personal_schedule.RecurrenceRule
+	46, // 3: personal_schedule.UpsertWorkResponse.error:type_name -> common.Error
+	22, // 4: personal_schedule.UpsertWorkResponse.conflicts:type_name -> personal_schedule.ScheduleConflict
+	47, // 5: personal_schedule.GetWorksRequest.page_query:type_name -> common.PageQuery
+	1,  // 6: personal_schedule.GetWorksRequest.sort_key:type_name -> personal_schedule.WorkSortKey
+	48, // 7: personal_schedule.GetWorksResponse.works:type_name -> personal_schedule.Work
+	46, // 8: personal_schedule.GetWorksResponse.error:type_name -> common.Error
+	49, // 9: personal_schedule.GetWorksResponse.page_info:type_name -> common.PageInfo
+	50, // 10: personal_schedule.GetWorkResponse.work:type_name -> personal_schedule.WorkDetail
+	46, // 11: personal_schedule.GetWorkResponse.error:type_name -> common.Error
+	46, // 12: personal_schedule.DeleteWorkResponse.error:type_name -> common.Error
+	46, // 13: personal_schedule.GetRecoveryWorksResponse.error:type_name -> common.Error
+	22, // 14: personal_schedule.GetRecoveryWorksResponse.conflicts:type_name -> personal_schedule.ScheduleConflict
+	46, // 15: personal_schedule.UpdateWorkLabelResponse.error:type_name -> common.Error
+	46, // 16: personal_schedule.SaveDraftAsRealWorkResponse.error:type_name -> common.Error
+	22, // 17: personal_schedule.SaveDraftAsRealWorkResponse.conflicts:type_name -> personal_schedule.ScheduleConflict
+	46, // 18: personal_schedule.DeleteAllDraftWorksResponse.error:type_name -> common.Error
+	20, // 19: personal_schedule.ScheduleConflict.candidate:type_name -> personal_schedule.CandidateInterval
+	21, // 20: personal_schedule.ScheduleConflict.conflicts:type_name -> personal_schedule.ConflictingWork
+	19, // 21: personal_schedule.ScheduleConflict.suggestions:type_name -> personal_schedule.TimeInterval
+	20, // 22: personal_schedule.CheckScheduleConflictsRequest.candidates:type_name -> personal_schedule.CandidateInterval
+	22, // 23: personal_schedule.CheckScheduleConflictsResponse.results:type_name -> personal_schedule.ScheduleConflict
+	46, // 24: personal_schedule.CheckScheduleConflictsResponse.error:type_name -> common.Error
+	51, // 25: personal_schedule.FindFreeTimeRequest.working_hours:type_name -> personal_schedule.WorkingHours
+	0,  // 26: personal_schedule.FindFreeTimeRequest.order:type_name -> personal_schedule.FreeTimeOrder
+	26, // 27: personal_schedule.FindFreeTimeResponse.intervals:type_name -> personal_schedule.FreeInterval
+	46, // 28: personal_schedule.FindFreeTimeResponse.error:type_name -> common.Error
+	42, // 29: personal_schedule.AutoScheduleRequest.durations_ms:type_name -> personal_schedule.AutoScheduleRequest.DurationsMsEntry
+	51, // 30: personal_schedule.AutoScheduleRequest.working_hours:type_name -> personal_schedule.WorkingHours
+	29, // 31: personal_schedule.AutoScheduleResponse.scheduled:type_name -> personal_schedule.AutoScheduledWork
+	30, // 32: personal_schedule.AutoScheduleResponse.unplaced:type_name -> personal_schedule.UnplacedWork
+	46, // 33: personal_schedule.AutoScheduleResponse.error:type_name -> common.Error
+	52, // 34: personal_schedule.WorkHistoryEvent.from:type_name -> personal_schedule.LabelInfo
+	52, // 35: personal_schedule.WorkHistoryEvent.to:type_name -> personal_schedule.LabelInfo
+	32, // 36: personal_schedule.GetWorkHistoryResponse.events:type_name -> personal_schedule.WorkHistoryEvent
+	46, // 37: personal_schedule.GetWorkHistoryResponse.error:type_name -> common.Error
+	35, // 38: personal_schedule.BulkWorkPatch.labels:type_name -> personal_schedule.WorkLabelChange
+	46, // 39: personal_schedule.BulkWorkResult.error:type_name -> common.Error
+	36, // 40: personal_schedule.BulkUpdateWorksRequest.patch:type_name -> personal_schedule.BulkWorkPatch
+	37, // 41: personal_schedule.BulkUpdateWorksResponse.results:type_name -> personal_schedule.BulkWorkResult
+	46, // 42: personal_schedule.BulkUpdateWorksResponse.error:type_name -> common.Error
+	37, // 43: personal_schedule.BulkDeleteWorksResponse.results:type_name -> personal_schedule.BulkWorkResult
+	46, // 44: personal_schedule.BulkDeleteWorksResponse.error:type_name -> common.Error
+	2,  // 45: personal_schedule.WorkService.UpsertWork:input_type -> personal_schedule.UpsertWorkRequest
+	4,  // 46: personal_schedule.WorkService.GetWorks:input_type -> personal_schedule.GetWorksRequest
+	6,  // 47: personal_schedule.WorkService.GetWork:input_type -> personal_schedule.GetWorkRequest
+	8,  // 48: personal_schedule.WorkService.DeleteWork:input_type -> personal_schedule.DeleteWorkRequest
+	10, // 49: personal_schedule.WorkService.GetRecoveryWorks:input_type -> personal_schedule.GetRecoveryWorksRequest
+	12, // 50: personal_schedule.WorkService.UpdateWorkLabel:input_type -> personal_schedule.UpdateWorkLabelRequest
+	14, // 51: personal_schedule.WorkService.SaveDraftAsRealWork:input_type -> personal_schedule.SaveDraftAsRealWorkRequest
+	16, // 52: personal_schedule.WorkService.DeleteAllDraftWorks:input_type -> personal_schedule.DeleteAllDraftWorksRequest
+	18, // 53: personal_schedule.WorkService.GenerateWorksByAI:input_type -> personal_schedule.GenerateWorksByAIRequest
+	33, // 54: personal_schedule.WorkService.GetWorkHistory:input_type -> personal_schedule.GetWorkHistoryRequest
+	23, // 55: personal_schedule.WorkService.CheckScheduleConflicts:input_type -> personal_schedule.CheckScheduleConflictsRequest
+	25, // 56: personal_schedule.WorkService.FindFreeTime:input_type -> personal_schedule.FindFreeTimeRequest
+	28, // 57: personal_schedule.WorkService.AutoSchedule:input_type -> personal_schedule.AutoScheduleRequest
+	38, // 58: personal_schedule.WorkService.BulkUpdateWorks:input_type -> personal_schedule.BulkUpdateWorksRequest
+	40, // 59: personal_schedule.WorkService.BulkDeleteWorks:input_type -> personal_schedule.BulkDeleteWorksRequest
+	3,  // 60: personal_schedule.WorkService.UpsertWork:output_type -> personal_schedule.UpsertWorkResponse
+	5,  // 61: personal_schedule.WorkService.GetWorks:output_type -> personal_schedule.GetWorksResponse
+	7,  // 62: personal_schedule.WorkService.GetWork:output_type -> personal_schedule.GetWorkResponse
+	9,  // 63: personal_schedule.WorkService.DeleteWork:output_type -> personal_schedule.DeleteWorkResponse
+	11, // 64: personal_schedule.WorkService.GetRecoveryWorks:output_type -> personal_schedule.GetRecoveryWorksResponse
+	13, // 65: personal_schedule.WorkService.UpdateWorkLabel:output_type -> personal_schedule.UpdateWorkLabelResponse
+	15, // 66: personal_schedule.WorkService.SaveDraftAsRealWork:output_type -> personal_schedule.SaveDraftAsRealWorkResponse
+	17, // 67: personal_schedule.WorkService.DeleteAllDraftWorks:output_type -> personal_schedule.DeleteAllDraftWorksResponse
+	53, // 68: personal_schedule.WorkService.GenerateWorksByAI:output_type -> common.EmptyResponse
+	34, // 69: personal_schedule.WorkService.GetWorkHistory:output_type -> personal_schedule.GetWorkHistoryResponse
+	24, // 70: personal_schedule.WorkService.CheckScheduleConflicts:output_type -> personal_schedule.CheckScheduleConflictsResponse
+	27, // 71: personal_schedule.WorkService.FindFreeTime:output_type -> personal_schedule.FindFreeTimeResponse
+	31, // 72: personal_schedule.WorkService.AutoSchedule:output_type -> personal_schedule.AutoScheduleResponse
+	39, // 73: personal_schedule.WorkService.BulkUpdateWorks:output_type -> personal_schedule.BulkUpdateWorksResponse
+	41, // 74: personal_schedule.WorkService.BulkDeleteWorks:output_type -> personal_schedule.BulkDeleteWorksResponse
+	60, // [60:75] is the sub-list for method output_type
+	45, // [45:60] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_personal_schedule_service_work_proto_init() }
@@ -1423,18 +3439,33 @@ func file_personal_schedule_service_work_proto_init() {
 	file_personal_schedule_service_work_proto_msgTypes[11].OneofWrappers = []any{}
 	file_personal_schedule_service_work_proto_msgTypes[13].OneofWrappers = []any{}
 	file_personal_schedule_service_work_proto_msgTypes[15].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[18].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[19].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[21].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[22].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[23].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[25].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[26].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[29].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[30].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[32].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[34].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[35].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[37].OneofWrappers = []any{}
+	file_personal_schedule_service_work_proto_msgTypes[39].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_personal_schedule_service_work_proto_rawDesc), len(file_personal_schedule_service_work_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   17,
+			NumEnums:      2,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_personal_schedule_service_work_proto_goTypes,
 		DependencyIndexes: file_personal_schedule_service_work_proto_depIdxs,
+		EnumInfos:         file_personal_schedule_service_work_proto_enumTypes,
 		MessageInfos:      file_personal_schedule_service_work_proto_msgTypes,
 	}.Build()
 	File_personal_schedule_service_work_proto = out.File
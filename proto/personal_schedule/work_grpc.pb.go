@@ -20,15 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WorkService_UpsertWork_FullMethodName          = "/personal_schedule.WorkService/UpsertWork"
-	WorkService_GetWorks_FullMethodName            = "/personal_schedule.WorkService/GetWorks"
-	WorkService_GetWork_FullMethodName             = "/personal_schedule.WorkService/GetWork"
-	WorkService_DeleteWork_FullMethodName          = "/personal_schedule.WorkService/DeleteWork"
-	WorkService_GetRecoveryWorks_FullMethodName    = "/personal_schedule.WorkService/GetRecoveryWorks"
-	WorkService_UpdateWorkLabel_FullMethodName     = "/personal_schedule.WorkService/UpdateWorkLabel"
-	WorkService_SaveDraftAsRealWork_FullMethodName = "/personal_schedule.WorkService/SaveDraftAsRealWork"
-	WorkService_DeleteAllDraftWorks_FullMethodName = "/personal_schedule.WorkService/DeleteAllDraftWorks"
-	WorkService_GenerateWorksByAI_FullMethodName   = "/personal_schedule.WorkService/GenerateWorksByAI"
+	WorkService_UpsertWork_FullMethodName             = "/personal_schedule.WorkService/UpsertWork"
+	WorkService_GetWorks_FullMethodName               = "/personal_schedule.WorkService/GetWorks"
+	WorkService_GetWork_FullMethodName                = "/personal_schedule.WorkService/GetWork"
+	WorkService_DeleteWork_FullMethodName             = "/personal_schedule.WorkService/DeleteWork"
+	WorkService_GetRecoveryWorks_FullMethodName       = "/personal_schedule.WorkService/GetRecoveryWorks"
+	WorkService_UpdateWorkLabel_FullMethodName        = "/personal_schedule.WorkService/UpdateWorkLabel"
+	WorkService_SaveDraftAsRealWork_FullMethodName    = "/personal_schedule.WorkService/SaveDraftAsRealWork"
+	WorkService_DeleteAllDraftWorks_FullMethodName    = "/personal_schedule.WorkService/DeleteAllDraftWorks"
+	WorkService_GenerateWorksByAI_FullMethodName      = "/personal_schedule.WorkService/GenerateWorksByAI"
+	WorkService_GetWorkHistory_FullMethodName         = "/personal_schedule.WorkService/GetWorkHistory"
+	WorkService_CheckScheduleConflicts_FullMethodName = "/personal_schedule.WorkService/CheckScheduleConflicts"
+	WorkService_FindFreeTime_FullMethodName           = "/personal_schedule.WorkService/FindFreeTime"
+	WorkService_AutoSchedule_FullMethodName           = "/personal_schedule.WorkService/AutoSchedule"
+	WorkService_BulkUpdateWorks_FullMethodName        = "/personal_schedule.WorkService/BulkUpdateWorks"
+	WorkService_BulkDeleteWorks_FullMethodName        = "/personal_schedule.WorkService/BulkDeleteWorks"
 )
 
 // WorkServiceClient is the client API for WorkService service.
@@ -44,6 +50,14 @@ type WorkServiceClient interface {
 	SaveDraftAsRealWork(ctx context.Context, in *SaveDraftAsRealWorkRequest, opts ...grpc.CallOption) (*SaveDraftAsRealWorkResponse, error)
 	DeleteAllDraftWorks(ctx context.Context, in *DeleteAllDraftWorksRequest, opts ...grpc.CallOption) (*DeleteAllDraftWorksResponse, error)
 	GenerateWorksByAI(ctx context.Context, in *GenerateWorksByAIRequest, opts ...grpc.CallOption) (*common.EmptyResponse, error)
+	GetWorkHistory(ctx context.Context, in *GetWorkHistoryRequest, opts ...grpc.CallOption) (*GetWorkHistoryResponse, error)
+	CheckScheduleConflicts(ctx context.Context, in *CheckScheduleConflictsRequest, opts ...grpc.CallOption) (*CheckScheduleConflictsResponse, error)
+	FindFreeTime(ctx context.Context, in *FindFreeTimeRequest, opts ...grpc.CallOption) (*FindFreeTimeResponse, error)
+	AutoSchedule(ctx context.Context, in *AutoScheduleRequest, opts ...grpc.CallOption) (*AutoScheduleResponse, error)
+	// applies the patch to every work of the user in one bulk write, with a result per work
+	BulkUpdateWorks(ctx context.Context, in *BulkUpdateWorksRequest, opts ...grpc.CallOption) (*BulkUpdateWorksResponse, error)
+	// deletes every work of the user with its sub tasks in one bulk write, with a result per work
+	BulkDeleteWorks(ctx context.Context, in *BulkDeleteWorksRequest, opts ...grpc.CallOption) (*BulkDeleteWorksResponse, error)
 }
 
 type workServiceClient struct {
@@ -144,6 +158,66 @@ func (c *workServiceClient) GenerateWorksByAI(ctx context.Context, in *GenerateW
 	return out, nil
 }
 
+func (c *workServiceClient) GetWorkHistory(ctx context.Context, in *GetWorkHistoryRequest, opts ...grpc.CallOption) (*GetWorkHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkHistoryResponse)
+	err := c.cc.Invoke(ctx, WorkService_GetWorkHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workServiceClient) CheckScheduleConflicts(ctx context.Context, in *CheckScheduleConflictsRequest, opts ...grpc.CallOption) (*CheckScheduleConflictsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckScheduleConflictsResponse)
+	err := c.cc.Invoke(ctx, WorkService_CheckScheduleConflicts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workServiceClient) FindFreeTime(ctx context.Context, in *FindFreeTimeRequest, opts ...grpc.CallOption) (*FindFreeTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindFreeTimeResponse)
+	err := c.cc.Invoke(ctx, WorkService_FindFreeTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workServiceClient) AutoSchedule(ctx context.Context, in *AutoScheduleRequest, opts ...grpc.CallOption) (*AutoScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutoScheduleResponse)
+	err := c.cc.Invoke(ctx, WorkService_AutoSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workServiceClient) BulkUpdateWorks(ctx context.Context, in *BulkUpdateWorksRequest, opts ...grpc.CallOption) (*BulkUpdateWorksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateWorksResponse)
+	err := c.cc.Invoke(ctx, WorkService_BulkUpdateWorks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workServiceClient) BulkDeleteWorks(ctx context.Context, in *BulkDeleteWorksRequest, opts ...grpc.CallOption) (*BulkDeleteWorksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkDeleteWorksResponse)
+	err := c.cc.Invoke(ctx, WorkService_BulkDeleteWorks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkServiceServer is the server API for WorkService service.
 // All implementations must embed UnimplementedWorkServiceServer
 // for forward compatibility.
@@ -157,6 +231,14 @@ type WorkServiceServer interface {
 	SaveDraftAsRealWork(context.Context, *SaveDraftAsRealWorkRequest) (*SaveDraftAsRealWorkResponse, error)
 	DeleteAllDraftWorks(context.Context, *DeleteAllDraftWorksRequest) (*DeleteAllDraftWorksResponse, error)
 	GenerateWorksByAI(context.Context, *GenerateWorksByAIRequest) (*common.EmptyResponse, error)
+	GetWorkHistory(context.Context, *GetWorkHistoryRequest) (*GetWorkHistoryResponse, error)
+	CheckScheduleConflicts(context.Context, *CheckScheduleConflictsRequest) (*CheckScheduleConflictsResponse, error)
+	FindFreeTime(context.Context, *FindFreeTimeRequest) (*FindFreeTimeResponse, error)
+	AutoSchedule(context.Context, *AutoScheduleRequest) (*AutoScheduleResponse, error)
+	// applies the patch to every work of the user in one bulk write, with a result per work
+	BulkUpdateWorks(context.Context, *BulkUpdateWorksRequest) (*BulkUpdateWorksResponse, error)
+	// deletes every work of the user with its sub tasks in one bulk write, with a result per work
+	BulkDeleteWorks(context.Context, *BulkDeleteWorksRequest) (*BulkDeleteWorksResponse, error)
 	mustEmbedUnimplementedWorkServiceServer()
 }
 
@@ -194,6 +276,24 @@ func (UnimplementedWorkServiceServer) DeleteAllDraftWorks(context.Context, *Dele
 func (UnimplementedWorkServiceServer) GenerateWorksByAI(context.Context, *GenerateWorksByAIRequest) (*common.EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateWorksByAI not implemented")
 }
+func (UnimplementedWorkServiceServer) GetWorkHistory(context.Context, *GetWorkHistoryRequest) (*GetWorkHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkHistory not implemented")
+}
+func (UnimplementedWorkServiceServer) CheckScheduleConflicts(context.Context, *CheckScheduleConflictsRequest) (*CheckScheduleConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckScheduleConflicts not implemented")
+}
+func (UnimplementedWorkServiceServer) FindFreeTime(context.Context, *FindFreeTimeRequest) (*FindFreeTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindFreeTime not implemented")
+}
+func (UnimplementedWorkServiceServer) AutoSchedule(context.Context, *AutoScheduleRequest) (*AutoScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoSchedule not implemented")
+}
+func (UnimplementedWorkServiceServer) BulkUpdateWorks(context.Context, *BulkUpdateWorksRequest) (*BulkUpdateWorksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateWorks not implemented")
+}
+func (UnimplementedWorkServiceServer) BulkDeleteWorks(context.Context, *BulkDeleteWorksRequest) (*BulkDeleteWorksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeleteWorks not implemented")
+}
 func (UnimplementedWorkServiceServer) mustEmbedUnimplementedWorkServiceServer() {}
 func (UnimplementedWorkServiceServer) testEmbeddedByValue()                     {}
 
@@ -377,6 +477,114 @@ func _WorkService_GenerateWorksByAI_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkService_GetWorkHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkServiceServer).GetWorkHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkService_GetWorkHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkServiceServer).GetWorkHistory(ctx, req.(*GetWorkHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkService_CheckScheduleConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckScheduleConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkServiceServer).CheckScheduleConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkService_CheckScheduleConflicts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkServiceServer).CheckScheduleConflicts(ctx, req.(*CheckScheduleConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkService_FindFreeTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindFreeTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkServiceServer).FindFreeTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkService_FindFreeTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkServiceServer).FindFreeTime(ctx, req.(*FindFreeTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkService_AutoSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkServiceServer).AutoSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkService_AutoSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkServiceServer).AutoSchedule(ctx, req.(*AutoScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkService_BulkUpdateWorks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateWorksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkServiceServer).BulkUpdateWorks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkService_BulkUpdateWorks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkServiceServer).BulkUpdateWorks(ctx, req.(*BulkUpdateWorksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkService_BulkDeleteWorks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteWorksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkServiceServer).BulkDeleteWorks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkService_BulkDeleteWorks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkServiceServer).BulkDeleteWorks(ctx, req.(*BulkDeleteWorksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkService_ServiceDesc is the grpc.ServiceDesc for WorkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -420,6 +628,30 @@ var WorkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateWorksByAI",
 			Handler:    _WorkService_GenerateWorksByAI_Handler,
 		},
+		{
+			MethodName: "GetWorkHistory",
+			Handler:    _WorkService_GetWorkHistory_Handler,
+		},
+		{
+			MethodName: "CheckScheduleConflicts",
+			Handler:    _WorkService_CheckScheduleConflicts_Handler,
+		},
+		{
+			MethodName: "FindFreeTime",
+			Handler:    _WorkService_FindFreeTime_Handler,
+		},
+		{
+			MethodName: "AutoSchedule",
+			Handler:    _WorkService_AutoSchedule_Handler,
+		},
+		{
+			MethodName: "BulkUpdateWorks",
+			Handler:    _WorkService_BulkUpdateWorks_Handler,
+		},
+		{
+			MethodName: "BulkDeleteWorks",
+			Handler:    _WorkService_BulkDeleteWorks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "personal_schedule_service/work.proto",